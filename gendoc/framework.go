@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkResource converts the schema of the resource implemented with the Plugin Framework to the sdk
+// one, so it's documented the same way as the sdk resources
+func frameworkResource(r fwresource.Resource) *schema.Resource {
+	resp := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		message("[FAIL!]get schema of the framework resource failed: %v", resp.Diagnostics)
+		return nil
+	}
+
+	return &schema.Resource{Schema: frameworkSchemas(resp.Schema.Attributes, resp.Schema.Blocks)}
+}
+
+func frameworkSchemas(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) map[string]*schema.Schema {
+	schemas := make(map[string]*schema.Schema, len(attributes)+len(blocks))
+	for name, attribute := range attributes {
+		if name == "id" {
+			// the id attribute is documented for all resources
+			continue
+		}
+		schemas[name] = frameworkAttributeSchema(attribute)
+	}
+
+	for name, block := range blocks {
+		v := &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: block.GetDescription(),
+			Deprecated:  block.GetDeprecationMessage(),
+		}
+		switch b := block.(type) {
+		case fwschema.ListNestedBlock:
+			v.Elem = &schema.Resource{Schema: frameworkSchemas(b.NestedObject.Attributes, b.NestedObject.Blocks)}
+		case fwschema.SetNestedBlock:
+			v.Type = schema.TypeSet
+			v.Elem = &schema.Resource{Schema: frameworkSchemas(b.NestedObject.Attributes, b.NestedObject.Blocks)}
+		case fwschema.SingleNestedBlock:
+			v.MaxItems = 1
+			v.Elem = &schema.Resource{Schema: frameworkSchemas(b.Attributes, b.Blocks)}
+		}
+		schemas[name] = v
+	}
+	return schemas
+}
+
+func frameworkAttributeSchema(attribute fwschema.Attribute) *schema.Schema {
+	v := &schema.Schema{
+		Required:    attribute.IsRequired(),
+		Optional:    attribute.IsOptional(),
+		Computed:    attribute.IsComputed(),
+		Sensitive:   attribute.IsSensitive(),
+		Description: attribute.GetDescription(),
+		Deprecated:  attribute.GetDeprecationMessage(),
+	}
+
+	// all the RequiresReplace plan modifiers share the same description
+	requiresReplace := stringplanmodifier.RequiresReplace().Description(context.Background())
+	var descriptions []string
+	switch a := attribute.(type) {
+	case fwschema.StringAttribute:
+		v.Type = schema.TypeString
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.BoolAttribute:
+		v.Type = schema.TypeBool
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.Int64Attribute:
+		v.Type = schema.TypeInt
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.Float64Attribute:
+		v.Type = schema.TypeFloat
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.ListAttribute:
+		v.Type = schema.TypeList
+		v.Elem = &schema.Schema{Type: schema.TypeString}
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.SetAttribute:
+		v.Type = schema.TypeSet
+		v.Elem = &schema.Schema{Type: schema.TypeString}
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	case fwschema.MapAttribute:
+		v.Type = schema.TypeMap
+		v.Elem = &schema.Schema{Type: schema.TypeString}
+		descriptions = planModifierDescriptions(a.PlanModifiers)
+	default:
+		v.Type = schema.TypeString
+	}
+
+	for _, description := range descriptions {
+		if description == requiresReplace {
+			v.ForceNew = true
+		}
+	}
+	return v
+}
+
+func planModifierDescriptions[T interface{ Description(context.Context) string }](modifiers []T) []string {
+	descriptions := make([]string, 0, len(modifiers))
+	for _, m := range modifiers {
+		descriptions = append(descriptions, m.Description(context.Background()))
+	}
+	return descriptions
+}
@@ -3,6 +3,9 @@ package main
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestSformatHCL(t *testing.T) {
@@ -96,3 +99,24 @@ func TestContainsBigSymbol(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTimeouts(t *testing.T) {
+	timeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(30 * time.Minute),
+		Update: schema.DefaultTimeout(6 * time.Hour),
+		Delete: schema.DefaultTimeout(90 * time.Second),
+	}
+	expected := []string{
+		"* `create` - (Defaults to `30m`) Used when creating the resource.",
+		"* `update` - (Defaults to `6h`) Used when updating the resource.",
+		"* `delete` - (Defaults to `1m30s`) Used when deleting the resource.",
+	}
+
+	res := getTimeouts(timeouts)
+	if strings.Join(res, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expect %v, got %v", expected, res)
+	}
+	if res := getTimeouts(nil); len(res) != 0 {
+		t.Errorf("expect no timeouts, got %v", res)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -35,6 +36,9 @@ var (
 
 func main() {
 	provider := cloud.Provider()
+	for name, resource := range cloud.FrameworkResources() {
+		provider.ResourcesMap[name] = frameworkResource(resource)
+	}
 	vProvider := runtime.FuncForPC(reflect.ValueOf(cloud.Provider).Pointer())
 
 	filename, _ := vProvider.FileLine(0)
@@ -130,6 +134,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 		"description":       "",
 		"description_short": "",
 		"import":            "",
+		"timeouts":          "",
 	}
 
 	filename := fmt.Sprintf("%s_%s_%s.go", dtype, cloudMarkShort, data["resource"])
@@ -276,6 +281,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	if dtype == "resource" {
 		idAttribute := "* `id` - ID of the resource.\n"
 		data["attributes"] = idAttribute + data["attributes"]
+		data["timeouts"] = strings.Join(getTimeouts(resource.Timeouts), "\n")
 	}
 
 	filename = filepath.Join(docRoot, dtype[:1], fmt.Sprintf("%s.html.markdown", data["resource"]))
@@ -334,6 +340,41 @@ func getAttributes(step int, k string, v *schema.Schema) []string {
 	return attributes
 }
 
+// getTimeouts get the configurable timeouts of resource
+func getTimeouts(timeouts *schema.ResourceTimeout) []string {
+	var res []string
+	if timeouts == nil {
+		return res
+	}
+
+	for _, v := range []struct {
+		name    string
+		timeout *time.Duration
+	}{
+		{"create", timeouts.Create},
+		{"read", timeouts.Read},
+		{"update", timeouts.Update},
+		{"delete", timeouts.Delete},
+	} {
+		if v.timeout != nil {
+			res = append(res, fmt.Sprintf("* `%s` - (Defaults to `%s`) Used when %sing the resource.", v.name, formatDuration(*v.timeout), strings.TrimSuffix(v.name, "e")))
+		}
+	}
+	return res
+}
+
+// formatDuration format duration without zero units, e.g. 30m instead of 30m0s
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
+
 // getFileDescription get description from go file
 func getFileDescription(fname string) (string, error) {
 	fset := token.NewFileSet()
@@ -30,7 +30,13 @@ In addition to all arguments above, the following attributes are exported:
 
 {{.attributes}}
 {{end}}
-{{if ne .import ""}}
+{{if ne .timeouts ""}}
+## Timeouts
+
+The ` + "`timeouts`" + ` block allows you to specify [timeouts](https://www.terraform.io/language/resources/syntax#operation-timeouts) for certain actions:
+
+{{.timeouts}}
+{{end}}{{if ne .import ""}}
 ## Import
 
 {{.import}}
@@ -224,3 +224,87 @@ desc: this is a description`
 	assert.Equalf(t, reflect.TypeOf(yaml1).String(), "map[interface {}]interface {}", "")
 	assert.Equalf(t, yaml1["name"], "test-name", "")
 }
+
+func TestCidrOverlapped(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.1.0/24", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.1.0.0/16", false},
+		{"172.16.0.0/16", "192.168.0.0/16", false},
+	}
+	for _, tt := range tests {
+		overlapped, err := cidrOverlapped(tt.a, tt.b)
+		assert.Nil(t, err)
+		assert.Equalf(t, tt.expected, overlapped, "%s and %s", tt.a, tt.b)
+	}
+
+	_, err := cidrOverlapped("10.0.0.0", "10.0.0.0/16")
+	assert.NotNil(t, err)
+}
+
+func TestCidrContained(t *testing.T) {
+	tests := []struct {
+		outer, inner string
+		expected     bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.1.0/24", "10.0.0.0/16", false},
+		{"10.0.0.0/16", "10.1.0.0/24", false},
+	}
+	for _, tt := range tests {
+		contained, err := cidrContained(tt.outer, tt.inner)
+		assert.Nil(t, err)
+		assert.Equalf(t, tt.expected, contained, "%s in %s", tt.inner, tt.outer)
+	}
+}
+
+func TestParseTagResourceName(t *testing.T) {
+	name, err := ParseTagResourceName("qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-12345678")
+	assert.Nil(t, err)
+	assert.Equal(t, TagResourceName{
+		ServiceType:  "cvm",
+		Region:       "ap-guangzhou",
+		Account:      "uin/100000000001",
+		ResourceType: "instance",
+		ResourceId:   "ins-12345678",
+	}, name)
+
+	name, err = ParseTagResourceName(BuildTagResourceName("cos", "prefix", "ap-guangzhou", "1250000000/bucket-1250000000"))
+	assert.Nil(t, err)
+	assert.Equal(t, "prefix", name.ResourceType)
+	assert.Equal(t, "1250000000/bucket-1250000000", name.ResourceId)
+
+	_, err = ParseTagResourceName("cvm:ap-guangzhou:instance/ins-12345678")
+	assert.NotNil(t, err)
+}
+
+func TestMatchTagResourceName(t *testing.T) {
+	name := "qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-12345678"
+	tests := []struct {
+		prefix   string
+		expected bool
+	}{
+		{BuildTagResourceName("cvm", "instance", "ap-guangzhou", ""), true},
+		{BuildTagResourceName("cvm", "instance", "ap-guangzhou", "ins-1234"), true},
+		{BuildTagResourceName("cvm", "instance", "", ""), true},
+		{"qcs::cvm:ap-guangzhou:uin/100000000001:", true},
+		{"qcs::cvm:ap-", true},
+		{BuildTagResourceName("cvm", "instance", "ap-shanghai", ""), false},
+		{BuildTagResourceName("cbs", "disk", "ap-guangzhou", ""), false},
+		{BuildTagResourceName("cv", "instance", "ap-guangzhou", ""), false},
+		{"qcs::cvm:ap-guangzhou:uin/100000000002:instance/", false},
+	}
+	for _, tt := range tests {
+		assert.Equalf(t, tt.expected, MatchTagResourceName(tt.prefix, name), "%s", tt.prefix)
+	}
+
+	// the name without uin matches the prefix of any account
+	name = BuildTagResourceName("cvm", "instance", "ap-guangzhou", "ins-12345678")
+	assert.True(t, MatchTagResourceName("qcs::cvm:ap-guangzhou:uin/100000000001:instance/", name))
+	assert.False(t, MatchTagResourceName("qcs::cvm:ap-guangzhou:uid/100000000001:instance/", name))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"os/user"
 	"reflect"
@@ -15,11 +16,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentyun/cos-go-sdk-v5"
 	"gopkg.in/yaml.v2"
 )
@@ -29,9 +33,8 @@ const COMMA_SP = ","
 
 var contextNil context.Context = nil
 
-type contextLogId string
-
-const logIdKey = contextLogId("logId")
+// logIdKey is shared with the API requests, whose log entries are correlated with the log id
+const logIdKey = connectivity.LogIdKey
 
 const (
 	PROVIDER_READ_RETRY_TIMEOUT  = "TENCENTCLOUD_READ_RETRY_TIMEOUT"
@@ -168,23 +171,211 @@ func getLogId(ctx context.Context) string {
 
 // logElapsed log func elapsed time, using in defer
 func logElapsed(mark ...string) func() {
+	return logElapsedContext(context.Background(), mark...)
+}
+
+// logElapsedContext is logElapsed of the context-aware CRUD, the entry is written with the logger of ctx
+func logElapsedContext(ctx context.Context, mark ...string) func() {
 	startAt := time.Now()
 	return func() {
-		log.Printf("[DEBUG] [ELAPSED] %s elapsed %d ms\n", strings.Join(mark, " "), int64(time.Since(startAt)/time.Millisecond))
+		fields := map[string]interface{}{
+			"elapsed_ms": int64(time.Since(startAt) / time.Millisecond),
+		}
+		// the mark of resources and data sources is like `resource.tencentcloud_instance.read`
+		if items := strings.Split(strings.Join(mark, " "), "."); len(items) == 3 && strings.HasPrefix(items[1], "tencentcloud_") {
+			fields[connectivity.LogFieldResourceType] = items[1]
+			fields[connectivity.LogFieldOperation] = items[2]
+		}
+		tflog.Debug(connectivity.LogContext(ctx), fmt.Sprintf("[ELAPSED] %s", strings.Join(mark, " ")), fields)
 	}
 }
 
 // for Provider produced inconsistent result after apply
 func inconsistentCheck(d *schema.ResourceData, meta interface{}) func() {
+	return inconsistentCheckContext(context.Background(), d, meta)
+}
+
+// inconsistentCheckContext is inconsistentCheck of the context-aware CRUD, the entry is written with the logger of ctx
+func inconsistentCheckContext(ctx context.Context, d *schema.ResourceData, meta interface{}) func() {
 	oldJson, _ := json.Marshal(d.State())
 	return func() {
 		newJson, _ := json.Marshal(d.State())
 		if !reflect.DeepEqual(oldJson, newJson) {
-			log.Printf("[Resource id %s data changes after reading old:%s, new:%s", d.Id(), oldJson, newJson)
+			tflog.Warn(connectivity.LogContext(ctx), "resource data changes after reading", map[string]interface{}{
+				connectivity.LogFieldResourceId: d.Id(),
+				"old_state":                     string(oldJson),
+				"new_state":                     string(newJson),
+			})
+		}
+	}
+}
+
+// resourceLogFields returns the fields of the resource operation, which are added to the log entries
+func resourceLogFields(resourceType, operation string, d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		connectivity.LogFieldResourceType: resourceType,
+		connectivity.LogFieldOperation:    operation,
+		connectivity.LogFieldResourceId:   d.Id(),
+	}
+}
+
+// resourceLogMeta returns the meta whose API requests are logged with the fields of the resource operation,
+// meta is returned as is if it's not configured
+func resourceLogMeta(meta interface{}, fields map[string]interface{}) interface{} {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return meta
+	}
+	return &TencentCloudClient{
+		apiV3Conn:   client.apiV3Conn.WithLogFields(fields),
+		defaultTags: client.defaultTags,
+		ignoreTags:  client.ignoreTags,
+	}
+}
+
+// addResourceLogFields makes the API requests of the resources and data sources logged with the resource type,
+// the operation and the resource id, whether their CRUD are context-aware or not. It's added before the other
+// wrappers of the CRUD, e.g. addRegionOverride, so the meta of the region is logged with the fields too.
+func addResourceLogFields(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		logFieldsResource(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		logFieldsResource(name, r)
+	}
+}
+
+func logFieldsResource(name string, r *schema.Resource) {
+	r.Create = logFieldsFunc(name, "create", r.Create)
+	r.Read = logFieldsFunc(name, "read", r.Read)
+	r.Update = logFieldsFunc(name, "update", r.Update)
+	r.Delete = logFieldsFunc(name, "delete", r.Delete)
+	r.CreateContext = logFieldsContextFunc(name, "create", r.CreateContext)
+	r.ReadContext = logFieldsContextFunc(name, "read", r.ReadContext)
+	r.UpdateContext = logFieldsContextFunc(name, "update", r.UpdateContext)
+	r.DeleteContext = logFieldsContextFunc(name, "delete", r.DeleteContext)
+	r.CreateWithoutTimeout = logFieldsContextFunc(name, "create", r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = logFieldsContextFunc(name, "read", r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = logFieldsContextFunc(name, "update", r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = logFieldsContextFunc(name, "delete", r.DeleteWithoutTimeout)
+
+	if r.Importer != nil {
+		r.Importer = logFieldsImporter(name, r.Importer)
+	}
+}
+
+func logFieldsFunc(name, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, resourceLogMeta(meta, resourceLogFields(name, operation, d)))
+	}
+}
+
+// logFieldsContextFunc adds the fields to ctx too, so the log entries written with ctx carry them
+func logFieldsContextFunc(name, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		fields := resourceLogFields(name, operation, d)
+		return f(connectivity.WithLogFields(ctx, fields), d, resourceLogMeta(meta, fields))
+	}
+}
+
+func logFieldsImporter(name string, importer *schema.ResourceImporter) *schema.ResourceImporter {
+	if importer.StateContext != nil {
+		stateContext := importer.StateContext
+		return &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				fields := resourceLogFields(name, "import", d)
+				return stateContext(connectivity.WithLogFields(ctx, fields), d, resourceLogMeta(meta, fields))
+			},
+		}
+	}
+	if importer.State != nil {
+		state := importer.State
+		return &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return state(d, resourceLogMeta(meta, resourceLogFields(name, "import", d)))
+			},
+		}
+	}
+	return importer
+}
+
+// addTerraformContext marks the context given to the context-aware CRUD of the resources and data sources,
+// so that the provider logger of the Terraform request is kept in their log entries, see connectivity.TerraformContext.
+func addTerraformContext(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		terraformContextResource(r)
+	}
+	for _, r := range p.DataSourcesMap {
+		terraformContextResource(r)
+	}
+}
+
+func terraformContextResource(r *schema.Resource) {
+	r.CreateContext = terraformContextFunc(r.CreateContext)
+	r.ReadContext = terraformContextFunc(r.ReadContext)
+	r.UpdateContext = terraformContextFunc(r.UpdateContext)
+	r.DeleteContext = terraformContextFunc(r.DeleteContext)
+	r.CreateWithoutTimeout = terraformContextFunc(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = terraformContextFunc(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = terraformContextFunc(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = terraformContextFunc(r.DeleteWithoutTimeout)
+}
+
+func terraformContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(connectivity.TerraformContext(ctx), d, meta)
+	}
+}
+
+// customizeDiffAll returns a CustomizeDiffFunc which runs the funcs in order, and stops at the first error
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
 		}
+		return nil
 	}
 }
 
+// cidrOverlapped returns whether the two network segments have any address in common
+func cidrOverlapped(a, b string) (bool, error) {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP), nil
+}
+
+// cidrContained returns whether the network segment inner is entirely in the network segment outer
+func cidrContained(outer, inner string) (bool, error) {
+	_, outerNet, err := net.ParseCIDR(outer)
+	if err != nil {
+		return false, err
+	}
+	_, innerNet, err := net.ParseCIDR(inner)
+	if err != nil {
+		return false, err
+	}
+	outerOnes, _ := outerNet.Mask.Size()
+	innerOnes, _ := innerNet.Mask.Size()
+	return outerNet.Contains(innerNet.IP) && outerOnes <= innerOnes, nil
+}
+
 // retryError returns retry error
 func retryError(err error, additionRetryableError ...string) *resource.RetryError {
 	switch realErr := errors.Cause(err).(type) {
@@ -219,28 +410,35 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 }
 
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
-// `f` is retried until `timeout` expires.
+// `f` is retried with the backoff of the provider `retry` block until `timeout` expires.
 func RetryWithContext(
 	ctx context.Context,
+	retryer *connectivity.Retryer,
 	timeout time.Duration,
 	f func(context.Context) (interface{}, error),
 	additionRetryableError ...string) (interface{}, error) {
-	var output interface{}
-
-	retryErr := resource.Retry(timeout, func() *resource.RetryError {
-		var err error
-		output, err = f(ctx)
+	if retryer == nil {
+		retryer = connectivity.NewRetryer(connectivity.DefaultRetryPolicy)
+	}
+	deadline := retryer.Clock().Now().Add(timeout)
 
-		if err != nil {
-			return retryError(err, additionRetryableError...)
+	for attempt := 1; ; attempt++ {
+		output, err := f(ctx)
+		if err == nil {
+			return output, nil
+		}
+		if retryErr := retryError(err, additionRetryableError...); !retryErr.Retryable {
+			return nil, err
 		}
-		return nil
 
-	})
-	if retryErr != nil {
-		return nil, retryErr
+		delay := retryer.Backoff(attempt, "")
+		if retryer.Clock().Now().Add(delay).After(deadline) {
+			return nil, fmt.Errorf("timeout while waiting for retrying: %s", err.Error())
+		}
+		if sleepErr := retryer.Clock().Sleep(ctx, delay); sleepErr != nil {
+			return nil, err
+		}
 	}
-	return output, nil
 }
 
 // isCosExpectedError returns whether error is expected error when using COS SDK
@@ -395,6 +593,61 @@ func BuildTagResourceName(serviceType, resourceType, region, id string) string {
 	}
 }
 
+// TagResourceName is the parsed six-segment name of a resource, see BuildTagResourceName.
+type TagResourceName struct {
+	ProjectId    string
+	ServiceType  string
+	Region       string
+	Account      string
+	ResourceType string
+	ResourceId   string
+}
+
+// ParseTagResourceName parses the six-segment name of a resource, the resource segment is split into
+// the resource type and id by the first `/`.
+func ParseTagResourceName(name string) (parsed TagResourceName, err error) {
+	items := strings.SplitN(name, ":", 6)
+	if len(items) != 6 || items[0] != "qcs" {
+		err = fmt.Errorf("resource name %s is not in the format `qcs:project_id:service_type:region:account:resource`", name)
+		return
+	}
+	parsed = TagResourceName{
+		ProjectId:   items[1],
+		ServiceType: items[2],
+		Region:      items[3],
+		Account:     items[4],
+	}
+	if resource := strings.SplitN(items[5], "/", 2); len(resource) == 2 {
+		parsed.ResourceType, parsed.ResourceId = resource[0], resource[1]
+	} else {
+		parsed.ResourceId = items[5]
+	}
+	return
+}
+
+// MatchTagResourceName returns whether the resource name starts with the prefix. A six-segment prefix built by
+// BuildTagResourceName is matched segment by segment, the empty segments and the account without uin match
+// any value, e.g. `qcs::cvm::uin/:instance/` matches the instances of all the regions. The name whose account
+// is without uin, as the one built by BuildTagResourceName, matches the prefix of any account.
+func MatchTagResourceName(prefix, name string) bool {
+	prefixItems := strings.SplitN(prefix, ":", 6)
+	if len(prefixItems) != 6 {
+		return strings.HasPrefix(name, prefix)
+	}
+	items := strings.SplitN(name, ":", 6)
+	if len(items) != 6 || items[0] != prefixItems[0] {
+		return false
+	}
+	for i := 1; i < 4; i++ {
+		if prefixItems[i] != "" && prefixItems[i] != items[i] {
+			return false
+		}
+	}
+	account := strings.HasPrefix(items[4], prefixItems[4]) ||
+		strings.HasSuffix(items[4], "/") && strings.HasPrefix(prefixItems[4], items[4])
+	return account && strings.HasPrefix(items[5], prefixItems[5])
+}
+
 // IsContains returns whether value is within array
 func IsContains(array interface{}, value interface{}) bool {
 	vv := reflect.ValueOf(array)
@@ -1,11 +1,12 @@
 package connectivity
 
 import (
-	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	dlc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dlc/v20210125"
@@ -90,6 +91,7 @@ import (
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wss/v20180426"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const (
@@ -99,10 +101,23 @@ const (
 
 // TencentCloudClient is client for all TencentCloud service
 type TencentCloudClient struct {
-	Credential *common.Credential
-	Region     string
-	Protocol   string
-	Domain     string
+	Credential  common.CredentialIface
+	Region      string
+	Protocol    string
+	Domain      string
+	Retryer     *Retryer
+	RateLimiter *ratelimit.Limiter
+	Endpoints   map[string]string
+	Transport   *http.Transport
+	Redactor    *Redactor
+	Recorder    *Recorder
+	// LogFields are added to the log entries of the API requests, see WithLogFields
+	LogFields map[string]interface{}
+
+	// root is the client of the provider region which creates me by WithRegion, it is nil for the root itself
+	root        *TencentCloudClient
+	regionsOnce sync.Once
+	regions     *regionClients
 
 	cosConn            *s3.S3
 	tencentCosConn     *cos.Client
@@ -180,8 +195,75 @@ type TencentCloudClient struct {
 	wedataConn         *wedata.Client
 }
 
-// NewClientProfile returns a new ClientProfile
-func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfile {
+// WithLogFields returns the client whose API requests are logged with the fields, e.g. the resource and
+// operation sending them, which shares the credential, transport, retryer, rate limiter, endpoints and
+// recorder with me. The SDK clients are not shared, so it should only live as long as the operation.
+func (me *TencentCloudClient) WithLogFields(fields map[string]interface{}) *TencentCloudClient {
+	root := me
+	if me.root != nil {
+		root = me.root
+	}
+	return &TencentCloudClient{
+		Credential:  me.Credential,
+		Region:      me.Region,
+		Protocol:    me.Protocol,
+		Domain:      me.Domain,
+		Retryer:     me.Retryer,
+		RateLimiter: me.RateLimiter,
+		Endpoints:   me.Endpoints,
+		Transport:   me.Transport,
+		Redactor:    me.Redactor,
+		Recorder:    me.Recorder,
+		LogFields:   fields,
+		root:        root,
+	}
+}
+
+// httpTransport returns the transport shared by all the clients
+func (me *TencentCloudClient) httpTransport() http.RoundTripper {
+	if me.Transport == nil {
+		return http.DefaultTransport
+	}
+	return me.Transport
+}
+
+// cosTransport returns the transport of COS requests to the url, which signs each request with one
+// snapshot of the credential. The path of the url is the bucket of the path-style requests.
+func (me *TencentCloudClient) cosTransport(u *url.URL) http.RoundTripper {
+	return &cosCredentialTransport{
+		credential: me.Credential,
+		pathPrefix: u.Path,
+		transport:  me.httpTransport(),
+	}
+}
+
+// roundTripper returns the transport of API requests to the service, which limits, logs and retries
+// the requests, and records or replays them if the Recorder is set. The service is passed by the
+// clients, as the host of an overridden endpoint doesn't tell it.
+func (me *TencentCloudClient) roundTripper(service string) http.RoundTripper {
+	next := me.httpTransport()
+	if me.Recorder != nil {
+		next = me.Recorder.RoundTripper(next, service)
+	}
+	var transport http.RoundTripper = &LogRoundTripper{Transport: next, Redactor: me.Redactor, Service: service, Fields: me.LogFields}
+	if me.RateLimiter != nil {
+		transport = &RateLimitRoundTripper{
+			Limiter: me.RateLimiter,
+			Service: service,
+			Next:    transport,
+		}
+	}
+	if me.Retryer != nil {
+		transport = &RetryRoundTripper{
+			Retryer: me.Retryer,
+			Next:    transport,
+		}
+	}
+	return transport
+}
+
+// NewClientProfile returns a new ClientProfile of the service
+func (me *TencentCloudClient) NewClientProfile(service string, timeout int) *profile.ClientProfile {
 	cpf := profile.NewClientProfile()
 
 	// all request use method POST
@@ -194,12 +276,19 @@ func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfi
 	cpf.HttpProfile.RootDomain = me.Domain
 	// default language
 	cpf.Language = "en-US"
+	// endpoint of the service
+	if scheme, host := me.endpoint(service); host != "" {
+		cpf.HttpProfile.Endpoint = host
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = strings.ToUpper(scheme)
+		}
+	}
 
 	return cpf
 }
 
-// NewClientIntlProfile returns a new ClientProfile
-func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.ClientProfile {
+// NewClientIntlProfile returns a new ClientProfile of the service
+func (me *TencentCloudClient) NewClientIntlProfile(service string, timeout int) *intlProfile.ClientProfile {
 	cpf := intlProfile.NewClientProfile()
 
 	// all request use method POST
@@ -212,6 +301,13 @@ func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.Cli
 	cpf.HttpProfile.RootDomain = me.Domain
 	// default language
 	cpf.Language = "en-US"
+	// endpoint of the service
+	if scheme, host := me.endpoint(service); host != "" {
+		cpf.HttpProfile.Endpoint = host
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = strings.ToUpper(scheme)
+		}
+	}
 
 	return cpf
 }
@@ -225,18 +321,20 @@ func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
 		if service == endpoints.S3ServiceID {
 			return endpoints.ResolvedEndpoint{
-				URL:           fmt.Sprintf("https://cos.%s.myqcloud.com", region),
+				URL:           me.cosURL("cos", "").String(),
 				SigningRegion: region,
 			}, nil
 		}
 		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 	}
 
-	creds := credentials.NewStaticCredentials(me.Credential.SecretId, me.Credential.SecretKey, me.Credential.Token)
+	creds := credentials.NewCredentials(&awsCredentialProvider{credential: me.Credential})
 	sess := session.Must(session.NewSession(&aws.Config{
+		HTTPClient:       &http.Client{Transport: me.httpTransport()},
 		Credentials:      creds,
 		Region:           aws.String(me.Region),
 		EndpointResolver: endpoints.ResolverFunc(resolver),
+		S3ForcePathStyle: aws.Bool(me.cosPathStyle("cos")),
 	}))
 
 	return s3.New(sess)
@@ -244,7 +342,7 @@ func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 
 // UseTencentCosClient tencent cloud own client for service instead of aws
 func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("cos", bucket)
 
 	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL == u {
 		return me.tencentCosConn
@@ -255,12 +353,8 @@ func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
 	}
 
 	me.tencentCosConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.cosTransport(u),
 	})
 
 	return me.tencentCosConn
@@ -272,9 +366,9 @@ func (me *TencentCloudClient) UseMysqlClient() *cdb.Client {
 		return me.mysqlConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdb", 300)
 	me.mysqlConn, _ = cdb.NewClient(me.Credential, me.Region, cpf)
-	me.mysqlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mysqlConn.WithHttpTransport(me.roundTripper("cdb"))
 
 	return me.mysqlConn
 }
@@ -285,9 +379,9 @@ func (me *TencentCloudClient) UseRedisClient() *redis.Client {
 		return me.redisConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("redis", 300)
 	me.redisConn, _ = redis.NewClient(me.Credential, me.Region, cpf)
-	me.redisConn.WithHttpTransport(&LogRoundTripper{})
+	me.redisConn.WithHttpTransport(me.roundTripper("redis"))
 
 	return me.redisConn
 }
@@ -298,9 +392,9 @@ func (me *TencentCloudClient) UseAsClient() *as.Client {
 		return me.asConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("as", 300)
 	me.asConn, _ = as.NewClient(me.Credential, me.Region, cpf)
-	me.asConn.WithHttpTransport(&LogRoundTripper{})
+	me.asConn.WithHttpTransport(me.roundTripper("as"))
 
 	return me.asConn
 }
@@ -311,9 +405,9 @@ func (me *TencentCloudClient) UseVpcClient() *vpc.Client {
 		return me.vpcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("vpc", 300)
 	me.vpcConn, _ = vpc.NewClient(me.Credential, me.Region, cpf)
-	me.vpcConn.WithHttpTransport(&LogRoundTripper{})
+	me.vpcConn.WithHttpTransport(me.roundTripper("vpc"))
 
 	return me.vpcConn
 }
@@ -325,9 +419,9 @@ func (me *TencentCloudClient) UseCbsClient() *cbs.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.NewClientProfile("cbs", reqTimeout)
 	me.cbsConn, _ = cbs.NewClient(me.Credential, me.Region, cpf)
-	me.cbsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cbsConn.WithHttpTransport(me.roundTripper("cbs"))
 
 	return me.cbsConn
 }
@@ -338,9 +432,9 @@ func (me *TencentCloudClient) UseDcClient() *dc.Client {
 		return me.dcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dc", 300)
 	me.dcConn, _ = dc.NewClient(me.Credential, me.Region, cpf)
-	me.dcConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcConn.WithHttpTransport(me.roundTripper("dc"))
 
 	return me.dcConn
 }
@@ -351,9 +445,9 @@ func (me *TencentCloudClient) UseMongodbClient() *mongodb.Client {
 		return me.mongodbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mongodb", 300)
 	me.mongodbConn, _ = mongodb.NewClient(me.Credential, me.Region, cpf)
-	me.mongodbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mongodbConn.WithHttpTransport(me.roundTripper("mongodb"))
 
 	return me.mongodbConn
 }
@@ -364,9 +458,9 @@ func (me *TencentCloudClient) UseClbClient() *clb.Client {
 		return me.clbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("clb", 300)
 	me.clbConn, _ = clb.NewClient(me.Credential, me.Region, cpf)
-	me.clbConn.WithHttpTransport(&LogRoundTripper{})
+	me.clbConn.WithHttpTransport(me.roundTripper("clb"))
 
 	return me.clbConn
 }
@@ -378,9 +472,9 @@ func (me *TencentCloudClient) UseCvmClient() *cvm.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.NewClientProfile("cvm", reqTimeout)
 	me.cvmConn, _ = cvm.NewClient(me.Credential, me.Region, cpf)
-	me.cvmConn.WithHttpTransport(&LogRoundTripper{})
+	me.cvmConn.WithHttpTransport(me.roundTripper("cvm"))
 
 	return me.cvmConn
 }
@@ -391,9 +485,9 @@ func (me *TencentCloudClient) UseTagClient() *tag.Client {
 		return me.tagConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tag", 300)
 	me.tagConn, _ = tag.NewClient(me.Credential, me.Region, cpf)
-	me.tagConn.WithHttpTransport(&LogRoundTripper{})
+	me.tagConn.WithHttpTransport(me.roundTripper("tag"))
 
 	return me.tagConn
 }
@@ -404,9 +498,9 @@ func (me *TencentCloudClient) UseTkeClient() *tke.Client {
 		return me.tkeConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tke", 300)
 	me.tkeConn, _ = tke.NewClient(me.Credential, me.Region, cpf)
-	me.tkeConn.WithHttpTransport(&LogRoundTripper{})
+	me.tkeConn.WithHttpTransport(me.roundTripper("tke"))
 
 	return me.tkeConn
 }
@@ -417,9 +511,9 @@ func (me *TencentCloudClient) UseTdmqClient() *tdmq.Client {
 		return me.tdmqConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tdmq", 300)
 	me.tdmqConn, _ = tdmq.NewClient(me.Credential, me.Region, cpf)
-	me.tdmqConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdmqConn.WithHttpTransport(me.roundTripper("tdmq"))
 
 	return me.tdmqConn
 }
@@ -430,9 +524,9 @@ func (me *TencentCloudClient) UseGaapClient() *gaap.Client {
 		return me.gaapConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("gaap", 300)
 	me.gaapConn, _ = gaap.NewClient(me.Credential, me.Region, cpf)
-	me.gaapConn.WithHttpTransport(&LogRoundTripper{})
+	me.gaapConn.WithHttpTransport(me.roundTripper("gaap"))
 
 	return me.gaapConn
 }
@@ -443,9 +537,11 @@ func (me *TencentCloudClient) UseSslClient() *ssl.Client {
 		return me.sslConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.sslConn, _ = ssl.NewClient(me.Credential, me.Region, cpf)
-	me.sslConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("wss", 300)
+	// ssl.NewClient only accepts the static *common.Credential
+	me.sslConn = &ssl.Client{}
+	me.sslConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.sslConn.WithHttpTransport(me.roundTripper("wss"))
 
 	return me.sslConn
 }
@@ -456,9 +552,9 @@ func (me *TencentCloudClient) UseCamClient() *cam.Client {
 		return me.camConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cam", 300)
 	me.camConn, _ = cam.NewClient(me.Credential, me.Region, cpf)
-	me.camConn.WithHttpTransport(&LogRoundTripper{})
+	me.camConn.WithHttpTransport(me.roundTripper("cam"))
 
 	return me.camConn
 }
@@ -472,9 +568,9 @@ func (me *TencentCloudClient) UseStsClient() *sts.Client {
 		}
 	*/
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sts", 300)
 	me.stsConn, _ = sts.NewClient(me.Credential, me.Region, cpf)
-	me.stsConn.WithHttpTransport(&LogRoundTripper{})
+	me.stsConn.WithHttpTransport(me.roundTripper("sts"))
 
 	return me.stsConn
 }
@@ -485,9 +581,9 @@ func (me *TencentCloudClient) UseCfsClient() *cfs.Client {
 		return me.cfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cfs", 300)
 	me.cfsConn, _ = cfs.NewClient(me.Credential, me.Region, cpf)
-	me.cfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cfsConn.WithHttpTransport(me.roundTripper("cfs"))
 
 	return me.cfsConn
 }
@@ -498,9 +594,9 @@ func (me *TencentCloudClient) UseScfClient() *scf.Client {
 		return me.scfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("scf", 300)
 	me.scfConn, _ = scf.NewClient(me.Credential, me.Region, cpf)
-	me.scfConn.WithHttpTransport(&LogRoundTripper{})
+	me.scfConn.WithHttpTransport(me.roundTripper("scf"))
 
 	return me.scfConn
 }
@@ -511,9 +607,11 @@ func (me *TencentCloudClient) UseTcaplusClient() *tcaplusdb.Client {
 		return me.tcaplusConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.tcaplusConn, _ = tcaplusdb.NewClient(me.Credential, me.Region, cpf)
-	me.tcaplusConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("tcaplusdb", 300)
+	// tcaplusdb.NewClient only accepts the static *common.Credential
+	me.tcaplusConn = &tcaplusdb.Client{}
+	me.tcaplusConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.tcaplusConn.WithHttpTransport(me.roundTripper("tcaplusdb"))
 
 	return me.tcaplusConn
 }
@@ -524,9 +622,9 @@ func (me *TencentCloudClient) UseDayuClient() *dayu.Client {
 		return me.dayuConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dayu", 300)
 	me.dayuConn, _ = dayu.NewClient(me.Credential, me.Region, cpf)
-	me.dayuConn.WithHttpTransport(&LogRoundTripper{})
+	me.dayuConn.WithHttpTransport(me.roundTripper("dayu"))
 
 	return me.dayuConn
 }
@@ -537,9 +635,9 @@ func (me *TencentCloudClient) UseCdnClient() *cdn.Client {
 		return me.cdnConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdn", 300)
 	me.cdnConn, _ = cdn.NewClient(me.Credential, me.Region, cpf)
-	me.cdnConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdnConn.WithHttpTransport(me.roundTripper("cdn"))
 
 	return me.cdnConn
 }
@@ -550,9 +648,9 @@ func (me *TencentCloudClient) UseMonitorClient() *monitor.Client {
 		return me.monitorConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("monitor", 300)
 	me.monitorConn, _ = monitor.NewClient(me.Credential, me.Region, cpf)
-	me.monitorConn.WithHttpTransport(&LogRoundTripper{})
+	me.monitorConn.WithHttpTransport(me.roundTripper("monitor"))
 
 	return me.monitorConn
 }
@@ -563,10 +661,10 @@ func (me *TencentCloudClient) UseEsClient() *es.Client {
 		return me.esConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("es", 300)
 	cpf.Language = "zh-CN"
 	me.esConn, _ = es.NewClient(me.Credential, me.Region, cpf)
-	me.esConn.WithHttpTransport(&LogRoundTripper{})
+	me.esConn.WithHttpTransport(me.roundTripper("es"))
 
 	return me.esConn
 }
@@ -577,9 +675,9 @@ func (me *TencentCloudClient) UsePostgresqlClient() *postgre.Client {
 		return me.postgreConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("postgres", 300)
 	me.postgreConn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgreConn.WithHttpTransport(&LogRoundTripper{})
+	me.postgreConn.WithHttpTransport(me.roundTripper("postgres"))
 
 	return me.postgreConn
 }
@@ -590,9 +688,9 @@ func (me *TencentCloudClient) UseSqlserverClient() *sqlserver.Client {
 		return me.sqlserverConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sqlserver", 300)
 	me.sqlserverConn, _ = sqlserver.NewClient(me.Credential, me.Region, cpf)
-	me.sqlserverConn.WithHttpTransport(&LogRoundTripper{})
+	me.sqlserverConn.WithHttpTransport(me.roundTripper("sqlserver"))
 
 	return me.sqlserverConn
 }
@@ -603,9 +701,9 @@ func (me *TencentCloudClient) UseCkafkaClient() *ckafka.Client {
 		return me.ckafkaConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ckafka", 300)
 	me.ckafkaConn, _ = ckafka.NewClient(me.Credential, me.Region, cpf)
-	me.ckafkaConn.WithHttpTransport(&LogRoundTripper{})
+	me.ckafkaConn.WithHttpTransport(me.roundTripper("ckafka"))
 
 	return me.ckafkaConn
 }
@@ -616,9 +714,9 @@ func (me *TencentCloudClient) UseAuditClient() *audit.Client {
 		return me.auditConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cloudaudit", 300)
 	me.auditConn, _ = audit.NewClient(me.Credential, me.Region, cpf)
-	me.auditConn.WithHttpTransport(&LogRoundTripper{})
+	me.auditConn.WithHttpTransport(me.roundTripper("cloudaudit"))
 
 	return me.auditConn
 }
@@ -629,9 +727,9 @@ func (me *TencentCloudClient) UseCynosdbClient() *cynosdb.Client {
 		return me.cynosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cynosdb", 300)
 	me.cynosConn, _ = cynosdb.NewClient(me.Credential, me.Region, cpf)
-	me.cynosConn.WithHttpTransport(&LogRoundTripper{})
+	me.cynosConn.WithHttpTransport(me.roundTripper("cynosdb"))
 
 	return me.cynosConn
 }
@@ -642,9 +740,11 @@ func (me *TencentCloudClient) UseVodClient() *vod.Client {
 		return me.vodConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.vodConn, _ = vod.NewClient(me.Credential, me.Region, cpf)
-	me.vodConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("vod", 300)
+	// vod.NewClient only accepts the static *common.Credential
+	me.vodConn = &vod.Client{}
+	me.vodConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.vodConn.WithHttpTransport(me.roundTripper("vod"))
 
 	return me.vodConn
 }
@@ -655,9 +755,9 @@ func (me *TencentCloudClient) UseAPIGatewayClient() *apigateway.Client {
 		return me.apiGatewayConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("apigateway", 300)
 	me.apiGatewayConn, _ = apigateway.NewClient(me.Credential, me.Region, cpf)
-	me.apiGatewayConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiGatewayConn.WithHttpTransport(me.roundTripper("apigateway"))
 
 	return me.apiGatewayConn
 }
@@ -668,9 +768,9 @@ func (me *TencentCloudClient) UseTCRClient() *tcr.Client {
 		return me.tcrConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tcr", 300)
 	me.tcrConn, _ = tcr.NewClient(me.Credential, me.Region, cpf)
-	me.tcrConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcrConn.WithHttpTransport(me.roundTripper("tcr"))
 
 	return me.tcrConn
 }
@@ -681,9 +781,9 @@ func (me *TencentCloudClient) UseSSLCertificateClient() *sslCertificate.Client {
 		return me.sslCertificateConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ssl", 300)
 	me.sslCertificateConn, _ = sslCertificate.NewClient(me.Credential, me.Region, cpf)
-	me.sslCertificateConn.WithHttpTransport(&LogRoundTripper{})
+	me.sslCertificateConn.WithHttpTransport(me.roundTripper("ssl"))
 
 	return me.sslCertificateConn
 }
@@ -694,9 +794,9 @@ func (me *TencentCloudClient) UseKmsClient() *kms.Client {
 		return me.kmsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("kms", 300)
 	me.kmsConn, _ = kms.NewClient(me.Credential, me.Region, cpf)
-	me.kmsConn.WithHttpTransport(&LogRoundTripper{})
+	me.kmsConn.WithHttpTransport(me.roundTripper("kms"))
 
 	return me.kmsConn
 }
@@ -707,9 +807,9 @@ func (me *TencentCloudClient) UseSsmClient() *ssm.Client {
 		return me.ssmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ssm", 300)
 	me.ssmConn, _ = ssm.NewClient(me.Credential, me.Region, cpf)
-	me.ssmConn.WithHttpTransport(&LogRoundTripper{})
+	me.ssmConn.WithHttpTransport(me.roundTripper("ssm"))
 
 	return me.ssmConn
 }
@@ -719,9 +819,9 @@ func (me *TencentCloudClient) UseApiClient() *api.Client {
 	if me.apiConn != nil {
 		return me.apiConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("api", 300)
 	me.apiConn, _ = api.NewClient(me.Credential, me.Region, cpf)
-	me.apiConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiConn.WithHttpTransport(me.roundTripper("api"))
 
 	return me.apiConn
 }
@@ -731,9 +831,9 @@ func (me *TencentCloudClient) UseEmrClient() *emr.Client {
 	if me.emrConn != nil {
 		return me.emrConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("emr", 300)
 	me.emrConn, _ = emr.NewClient(me.Credential, me.Region, cpf)
-	me.emrConn.WithHttpTransport(&LogRoundTripper{})
+	me.emrConn.WithHttpTransport(me.roundTripper("emr"))
 
 	return me.emrConn
 }
@@ -743,9 +843,9 @@ func (me *TencentCloudClient) UseClsClient() *cls.Client {
 	if me.clsConn != nil {
 		return me.clsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cls", 300)
 	me.clsConn, _ = cls.NewClient(me.Credential, me.Region, cpf)
-	me.clsConn.WithHttpTransport(&LogRoundTripper{})
+	me.clsConn.WithHttpTransport(me.roundTripper("cls"))
 
 	return me.clsConn
 }
@@ -755,9 +855,9 @@ func (me *TencentCloudClient) UseLighthouseClient() *lighthouse.Client {
 	if me.lighthouseConn != nil {
 		return me.lighthouseConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("lighthouse", 300)
 	me.lighthouseConn, _ = lighthouse.NewClient(me.Credential, me.Region, cpf)
-	me.lighthouseConn.WithHttpTransport(&LogRoundTripper{})
+	me.lighthouseConn.WithHttpTransport(me.roundTripper("lighthouse"))
 
 	return me.lighthouseConn
 }
@@ -767,9 +867,9 @@ func (me *TencentCloudClient) UseDnsPodClient() *dnspod.Client {
 	if me.dnsPodConn != nil {
 		return me.dnsPodConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dnspod", 300)
 	me.dnsPodConn, _ = dnspod.NewClient(me.Credential, me.Region, cpf)
-	me.dnsPodConn.WithHttpTransport(&LogRoundTripper{})
+	me.dnsPodConn.WithHttpTransport(me.roundTripper("dnspod"))
 
 	return me.dnsPodConn
 }
@@ -779,9 +879,9 @@ func (me *TencentCloudClient) UsePrivateDnsClient() *privatedns.Client {
 	if me.privateDnsConn != nil {
 		return me.privateDnsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("privatedns", 300)
 	me.privateDnsConn, _ = privatedns.NewClient(me.Credential, me.Region, cpf)
-	me.privateDnsConn.WithHttpTransport(&LogRoundTripper{})
+	me.privateDnsConn.WithHttpTransport(me.roundTripper("privatedns"))
 
 	return me.privateDnsConn
 }
@@ -791,9 +891,9 @@ func (me *TencentCloudClient) UseDomainClient() *domain.Client {
 	if me.domainConn != nil {
 		return me.domainConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("domain", 300)
 	me.domainConn, _ = domain.NewClient(me.Credential, me.Region, cpf)
-	me.domainConn.WithHttpTransport(&LogRoundTripper{})
+	me.domainConn.WithHttpTransport(me.roundTripper("domain"))
 
 	return me.domainConn
 }
@@ -804,9 +904,9 @@ func (me *TencentCloudClient) UseAntiddosClient() *antiddos.Client {
 		return me.antiddosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("antiddos", 300)
 	me.antiddosConn, _ = antiddos.NewClient(me.Credential, me.Region, cpf)
-	me.antiddosConn.WithHttpTransport(&LogRoundTripper{})
+	me.antiddosConn.WithHttpTransport(me.roundTripper("antiddos"))
 
 	return me.antiddosConn
 }
@@ -817,9 +917,9 @@ func (me *TencentCloudClient) UseTemClient() *tem.Client {
 		return me.temConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tem", 300)
 	me.temConn, _ = tem.NewClient(me.Credential, me.Region, cpf)
-	me.temConn.WithHttpTransport(&LogRoundTripper{})
+	me.temConn.WithHttpTransport(me.roundTripper("tem"))
 
 	return me.temConn
 }
@@ -830,9 +930,9 @@ func (me *TencentCloudClient) UseTeoClient() *teo.Client {
 		return me.teoConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("teo", 300)
 	me.teoConn, _ = teo.NewClient(me.Credential, me.Region, cpf)
-	me.teoConn.WithHttpTransport(&LogRoundTripper{})
+	me.teoConn.WithHttpTransport(me.roundTripper("teo"))
 
 	return me.teoConn
 }
@@ -843,9 +943,9 @@ func (me *TencentCloudClient) UseTcmClient() *tcm.Client {
 		return me.tcmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tcm", 300)
 	me.tcmConn, _ = tcm.NewClient(me.Credential, me.Region, cpf)
-	me.tcmConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcmConn.WithHttpTransport(me.roundTripper("tcm"))
 
 	return me.tcmConn
 }
@@ -856,9 +956,9 @@ func (me *TencentCloudClient) UseCssClient() *css.Client {
 		return me.cssConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("live", 300)
 	me.cssConn, _ = css.NewClient(me.Credential, me.Region, cpf)
-	me.cssConn.WithHttpTransport(&LogRoundTripper{})
+	me.cssConn.WithHttpTransport(me.roundTripper("live"))
 
 	return me.cssConn
 }
@@ -869,9 +969,9 @@ func (me *TencentCloudClient) UseSesClient() *ses.Client {
 		return me.sesConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ses", 300)
 	me.sesConn, _ = ses.NewClient(me.Credential, me.Region, cpf)
-	me.sesConn.WithHttpTransport(&LogRoundTripper{})
+	me.sesConn.WithHttpTransport(me.roundTripper("ses"))
 
 	return me.sesConn
 }
@@ -882,9 +982,9 @@ func (me *TencentCloudClient) UseDcdbClient() *dcdb.Client {
 		return me.dcdbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dcdb", 300)
 	me.dcdbConn, _ = dcdb.NewClient(me.Credential, me.Region, cpf)
-	me.dcdbConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcdbConn.WithHttpTransport(me.roundTripper("dcdb"))
 
 	return me.dcdbConn
 }
@@ -895,9 +995,9 @@ func (me *TencentCloudClient) UseSmsClient() *sms.Client {
 		return me.smsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sms", 300)
 	me.smsConn, _ = sms.NewClient(me.Credential, me.Region, cpf)
-	me.smsConn.WithHttpTransport(&LogRoundTripper{})
+	me.smsConn.WithHttpTransport(me.roundTripper("sms"))
 
 	return me.smsConn
 }
@@ -908,9 +1008,9 @@ func (me *TencentCloudClient) UseCatClient() *cat.Client {
 		return me.catConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cat", 300)
 	me.catConn, _ = cat.NewClient(me.Credential, me.Region, cpf)
-	me.catConn.WithHttpTransport(&LogRoundTripper{})
+	me.catConn.WithHttpTransport(me.roundTripper("cat"))
 
 	return me.catConn
 }
@@ -921,9 +1021,9 @@ func (me *TencentCloudClient) UseMariadbClient() *mariadb.Client {
 		return me.mariadbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mariadb", 300)
 	me.mariadbConn, _ = mariadb.NewClient(me.Credential, me.Region, cpf)
-	me.mariadbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mariadbConn.WithHttpTransport(me.roundTripper("mariadb"))
 
 	return me.mariadbConn
 }
@@ -934,9 +1034,9 @@ func (me *TencentCloudClient) UsePtsClient() *pts.Client {
 		return me.ptsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("pts", 300)
 	me.ptsConn, _ = pts.NewClient(me.Credential, me.Region, cpf)
-	me.ptsConn.WithHttpTransport(&LogRoundTripper{})
+	me.ptsConn.WithHttpTransport(me.roundTripper("pts"))
 
 	return me.ptsConn
 }
@@ -947,9 +1047,9 @@ func (me *TencentCloudClient) UseTatClient() *tat.Client {
 		return me.tatConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tat", 300)
 	me.tatConn, _ = tat.NewClient(me.Credential, me.Region, cpf)
-	me.tatConn.WithHttpTransport(&LogRoundTripper{})
+	me.tatConn.WithHttpTransport(me.roundTripper("tat"))
 
 	return me.tatConn
 }
@@ -960,9 +1060,9 @@ func (me *TencentCloudClient) UseOrganizationClient() *organization.Client {
 		return me.organizationConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("organization", 300)
 	me.organizationConn, _ = organization.NewClient(me.Credential, me.Region, cpf)
-	me.organizationConn.WithHttpTransport(&LogRoundTripper{})
+	me.organizationConn.WithHttpTransport(me.roundTripper("organization"))
 
 	return me.organizationConn
 }
@@ -973,9 +1073,9 @@ func (me *TencentCloudClient) UseTdcpgClient() *tdcpg.Client {
 		return me.tdcpgConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tdcpg", 300)
 	me.tdcpgConn, _ = tdcpg.NewClient(me.Credential, me.Region, cpf)
-	me.tdcpgConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdcpgConn.WithHttpTransport(me.roundTripper("tdcpg"))
 
 	return me.tdcpgConn
 }
@@ -986,10 +1086,10 @@ func (me *TencentCloudClient) UseDbbrainClient() *dbbrain.Client {
 		return me.dbbrainConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dbbrain", 300)
 	cpf.Language = "zh-CN"
 	me.dbbrainConn, _ = dbbrain.NewClient(me.Credential, me.Region, cpf)
-	me.dbbrainConn.WithHttpTransport(&LogRoundTripper{})
+	me.dbbrainConn.WithHttpTransport(me.roundTripper("dbbrain"))
 
 	return me.dbbrainConn
 }
@@ -1000,9 +1100,9 @@ func (me *TencentCloudClient) UseRumClient() *rum.Client {
 		return me.rumConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("rum", 300)
 	me.rumConn, _ = rum.NewClient(me.Credential, me.Region, cpf)
-	me.rumConn.WithHttpTransport(&LogRoundTripper{})
+	me.rumConn.WithHttpTransport(me.roundTripper("rum"))
 
 	return me.rumConn
 }
@@ -1013,16 +1113,16 @@ func (me *TencentCloudClient) UseDtsClient() *dts.Client {
 		return me.dtsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dts", 300)
 	me.dtsConn, _ = dts.NewClient(me.Credential, me.Region, cpf)
-	me.dtsConn.WithHttpTransport(&LogRoundTripper{})
+	me.dtsConn.WithHttpTransport(me.roundTripper("dts"))
 
 	return me.dtsConn
 }
 
 // UseCosBatchClient returns ci client for service
 func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos-control.%s.myqcloud.com", uin, me.Region))
+	u := me.cosURL("cos_control", uin)
 
 	if me.cosBatchConn != nil && me.cosBatchConn.BaseURL.BatchURL == u {
 		return me.cosBatchConn
@@ -1033,12 +1133,8 @@ func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 	}
 
 	me.cosBatchConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.cosTransport(u),
 	})
 
 	return me.cosBatchConn
@@ -1046,7 +1142,7 @@ func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 
 // UseCiClient returns ci client for service
 func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.ci.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("ci", bucket)
 
 	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
 		return me.ciConn
@@ -1057,12 +1153,8 @@ func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
 	}
 
 	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.cosTransport(u),
 	})
 
 	return me.ciConn
@@ -1070,7 +1162,7 @@ func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
 
 // UsePicClient returns pic client for service
 func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.pic.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("pic", bucket)
 
 	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
 		return me.ciConn
@@ -1081,12 +1173,8 @@ func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
 	}
 
 	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.cosTransport(u),
 	})
 
 	return me.ciConn
@@ -1098,10 +1186,10 @@ func (me *TencentCloudClient) UseTsfClient() *tsf.Client {
 		return me.tsfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tsf", 300)
 	cpf.Language = "zh-CN"
 	me.tsfConn, _ = tsf.NewClient(me.Credential, me.Region, cpf)
-	me.tsfConn.WithHttpTransport(&LogRoundTripper{})
+	me.tsfConn.WithHttpTransport(me.roundTripper("tsf"))
 
 	return me.tsfConn
 }
@@ -1112,10 +1200,10 @@ func (me *TencentCloudClient) UseMpsClient() *mps.Client {
 		return me.mpsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mps", 300)
 	cpf.Language = "zh-CN"
 	me.mpsConn, _ = mps.NewClient(me.Credential, me.Region, cpf)
-	me.mpsConn.WithHttpTransport(&LogRoundTripper{})
+	me.mpsConn.WithHttpTransport(me.roundTripper("mps"))
 
 	return me.mpsConn
 }
@@ -1126,9 +1214,9 @@ func (me *TencentCloudClient) UseCwpClient() *cwp.Client {
 		return me.cwpConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cwp", 300)
 	me.cwpConn, _ = cwp.NewClient(me.Credential, me.Region, cpf)
-	me.cwpConn.WithHttpTransport(&LogRoundTripper{})
+	me.cwpConn.WithHttpTransport(me.roundTripper("cwp"))
 
 	return me.cwpConn
 }
@@ -1139,10 +1227,10 @@ func (me *TencentCloudClient) UseChdfsClient() *chdfs.Client {
 		return me.chdfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("chdfs", 300)
 	cpf.Language = "zh-CN"
 	me.chdfsConn, _ = chdfs.NewClient(me.Credential, me.Region, cpf)
-	me.chdfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.chdfsConn.WithHttpTransport(me.roundTripper("chdfs"))
 
 	return me.chdfsConn
 }
@@ -1153,10 +1241,10 @@ func (me *TencentCloudClient) UseMdlClient() *mdl.Client {
 		return me.mdlConn
 	}
 
-	cpf := me.NewClientIntlProfile(300)
+	cpf := me.NewClientIntlProfile("mdl", 300)
 	cpf.Language = "zh-CN"
 	me.mdlConn, _ = mdl.NewClient(me.Credential, me.Region, cpf)
-	me.mdlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mdlConn.WithHttpTransport(me.roundTripper("mdl"))
 
 	return me.mdlConn
 }
@@ -1167,10 +1255,10 @@ func (me *TencentCloudClient) UseApmClient() *apm.Client {
 		return me.apmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("apm", 300)
 	cpf.Language = "zh-CN"
 	me.apmConn, _ = apm.NewClient(me.Credential, me.Region, cpf)
-	me.apmConn.WithHttpTransport(&LogRoundTripper{})
+	me.apmConn.WithHttpTransport(me.roundTripper("apm"))
 
 	return me.apmConn
 }
@@ -1181,10 +1269,10 @@ func (me *TencentCloudClient) UseCiamClient() *ciam.Client {
 		return me.ciamConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ciam", 300)
 	cpf.Language = "zh-CN"
 	me.ciamConn, _ = ciam.NewClient(me.Credential, me.Region, cpf)
-	me.ciamConn.WithHttpTransport(&LogRoundTripper{})
+	me.ciamConn.WithHttpTransport(me.roundTripper("ciam"))
 
 	return me.ciamConn
 }
@@ -1195,10 +1283,10 @@ func (me *TencentCloudClient) UseTseClient() *tse.Client {
 		return me.tseConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tse", 300)
 	cpf.Language = "zh-CN"
 	me.tseConn, _ = tse.NewClient(me.Credential, me.Region, cpf)
-	me.tseConn.WithHttpTransport(&LogRoundTripper{})
+	me.tseConn.WithHttpTransport(me.roundTripper("tse"))
 
 	return me.tseConn
 }
@@ -1209,10 +1297,10 @@ func (me *TencentCloudClient) UseCdwchClient() *cdwch.Client {
 		return me.cdwchConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdwch", 300)
 	cpf.Language = "zh-CN"
 	me.cdwchConn, _ = cdwch.NewClient(me.Credential, me.Region, cpf)
-	me.cdwchConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdwchConn.WithHttpTransport(me.roundTripper("cdwch"))
 
 	return me.cdwchConn
 }
@@ -1223,10 +1311,10 @@ func (me *TencentCloudClient) UseEbClient() *eb.Client {
 		return me.ebConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("eb", 300)
 	cpf.Language = "zh-CN"
 	me.ebConn, _ = eb.NewClient(me.Credential, me.Region, cpf)
-	me.ebConn.WithHttpTransport(&LogRoundTripper{})
+	me.ebConn.WithHttpTransport(me.roundTripper("eb"))
 
 	return me.ebConn
 }
@@ -1237,10 +1325,10 @@ func (me *TencentCloudClient) UseDlcClient() *dlc.Client {
 		return me.dlcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dlc", 300)
 	cpf.Language = "zh-CN"
 	me.dlcConn, _ = dlc.NewClient(me.Credential, me.Region, cpf)
-	me.dlcConn.WithHttpTransport(&LogRoundTripper{})
+	me.dlcConn.WithHttpTransport(me.roundTripper("dlc"))
 
 	return me.dlcConn
 }
@@ -1251,10 +1339,10 @@ func (me *TencentCloudClient) UseWedataClient() *wedata.Client {
 		return me.wedataConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("wedata", 300)
 	cpf.Language = "zh-CN"
 	me.wedataConn, _ = wedata.NewClient(me.Credential, me.Region, cpf)
-	me.wedataConn.WithHttpTransport(&LogRoundTripper{})
+	me.wedataConn.WithHttpTransport(me.roundTripper("wedata"))
 
 	return me.wedataConn
 }
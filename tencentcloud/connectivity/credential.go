@@ -0,0 +1,226 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+const (
+	// DefaultCvmMetadataEndpoint is the metadata service of CVM
+	DefaultCvmMetadataEndpoint = "http://metadata.tencentyun.com/latest/meta-data/"
+
+	// credentialRefreshAdvance refresh credential before it really expires,
+	// so the requests signed with the old one are still valid
+	credentialRefreshAdvance = 5 * time.Minute
+
+	cvmMetadataTimeout = 10 * time.Second
+
+	// cosAuthExpire is the valid duration of the COS signature, same as the SDK
+	cosAuthExpire = time.Hour
+)
+
+// TemporaryCredential is a credential with expired time
+type TemporaryCredential struct {
+	SecretId    string
+	SecretKey   string
+	Token       string
+	ExpiredTime time.Time
+}
+
+// CredentialFetcher fetches a new temporary credential
+type CredentialFetcher func() (*TemporaryCredential, error)
+
+// RefreshCredential implements common.CredentialIface, it refreshes itself by the fetcher before expired.
+// All the SDK clients share one RefreshCredential, so they pick up the new token without being rebuilt.
+type RefreshCredential struct {
+	name    string
+	fetcher CredentialFetcher
+	now     func() time.Time
+
+	mu      sync.Mutex
+	current *TemporaryCredential
+}
+
+var _ common.CredentialIface = &RefreshCredential{}
+
+// NewRefreshCredential returns a RefreshCredential, the first credential is fetched immediately
+func NewRefreshCredential(name string, fetcher CredentialFetcher) (*RefreshCredential, error) {
+	c := &RefreshCredential{
+		name:    name,
+		fetcher: fetcher,
+		now:     time.Now,
+	}
+
+	current, err := fetcher()
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s credential failed: %s", name, err.Error())
+	}
+	c.current = current
+
+	return c, nil
+}
+
+// GetCredential returns the secret id, secret key and token of one credential. The SDK calls it once
+// per signing, so it's the only place the credential is refreshed ahead of its expired time, and a
+// request is never signed with the parts of two credentials.
+func (c *RefreshCredential) GetCredential() (string, string, string) {
+	current := c.get(credentialRefreshAdvance)
+	return current.SecretId, current.SecretKey, current.Token
+}
+
+// GetSecretId returns the secret id of current credential
+func (c *RefreshCredential) GetSecretId() string {
+	return c.get(0).SecretId
+}
+
+// GetSecretKey returns the secret key of current credential
+func (c *RefreshCredential) GetSecretKey() string {
+	return c.get(0).SecretKey
+}
+
+// GetToken returns the token of current credential
+func (c *RefreshCredential) GetToken() string {
+	return c.get(0).Token
+}
+
+// ExpiredTime returns the expired time of current credential
+func (c *RefreshCredential) ExpiredTime() time.Time {
+	return c.get(0).ExpiredTime
+}
+
+// get returns current credential, which is refreshed if it expires within advance. The single field
+// getters pass 0, they only refresh the credential which has really expired and can't sign anything,
+// otherwise they may switch the credential between the fields read for one request.
+func (c *RefreshCredential) get(advance time.Duration) *TemporaryCredential {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.now().Add(advance).Before(c.current.ExpiredTime) {
+		return c.current
+	}
+
+	current, err := c.fetcher()
+	if err != nil {
+		// keep using the old one, the request will fail with a clear auth error if it really expired
+		log.Printf("[CRITAL] refresh %s credential failed: %s", c.name, err.Error())
+		return c.current
+	}
+	log.Printf("[DEBUG] %s credential refreshed, expired at %s", c.name, current.ExpiredTime.Format(time.RFC3339))
+	c.current = current
+
+	return c.current
+}
+
+type cvmRoleCredentialResponse struct {
+	TmpSecretId  string `json:"TmpSecretId"`
+	TmpSecretKey string `json:"TmpSecretKey"`
+	ExpiredTime  int64  `json:"ExpiredTime"`
+	Token        string `json:"Token"`
+	Code         string `json:"Code"`
+}
+
+// NewCvmRoleCredential returns a credential of the CAM role bound to current CVM, which is
+// refreshed from the metadata service `<endpoint>cam/security-credentials/<roleName>`.
+func NewCvmRoleCredential(endpoint, roleName string) (*RefreshCredential, error) {
+	if endpoint == "" {
+		endpoint = DefaultCvmMetadataEndpoint
+	}
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	url := fmt.Sprintf("%scam/security-credentials/%s", endpoint, roleName)
+	client := &http.Client{Timeout: cvmMetadataTimeout}
+
+	fetcher := func() (*TemporaryCredential, error) {
+		response, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("cam role %s is not bound to current cvm", roleName)
+		}
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("get cam role %s from metadata failed, status: %d, body: %s", roleName, response.StatusCode, body)
+		}
+
+		var result cvmRoleCredentialResponse
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("parse cam role %s from metadata failed: %s", roleName, err.Error())
+		}
+		if result.Code != "Success" {
+			return nil, fmt.Errorf("get cam role %s from metadata failed, code: %s", roleName, result.Code)
+		}
+
+		return &TemporaryCredential{
+			SecretId:    result.TmpSecretId,
+			SecretKey:   result.TmpSecretKey,
+			Token:       result.Token,
+			ExpiredTime: time.Unix(result.ExpiredTime, 0),
+		}, nil
+	}
+
+	return NewRefreshCredential("cvm role "+roleName, fetcher)
+}
+
+// awsCredentialProvider adapts the credential to the aws sdk which the s3 compatible COS client uses
+type awsCredentialProvider struct {
+	credential common.CredentialIface
+}
+
+func (p *awsCredentialProvider) Retrieve() (credentials.Value, error) {
+	secretId, secretKey, token := p.credential.GetCredential()
+	return credentials.Value{
+		AccessKeyID:     secretId,
+		SecretAccessKey: secretKey,
+		SessionToken:    token,
+		ProviderName:    "TencentCloudProvider",
+	}, nil
+}
+
+// IsExpired always asks aws sdk to retrieve again, the credential caches itself
+func (p *awsCredentialProvider) IsExpired() bool {
+	return true
+}
+
+// cosCredentialTransport signs the COS requests with one snapshot of the credential. The
+// cos.CredentialTransport reads the secret id, key and token by three getters, which neither
+// refresh the credential ahead of its expired time nor read them from the same credential.
+type cosCredentialTransport struct {
+	credential common.CredentialIface
+	// pathPrefix is the bucket of the path-style requests, e.g. `/bucket-1250000000`, the SDK resolves
+	// the object path against the root of the base url so it's added here
+	pathPrefix string
+	transport  http.RoundTripper
+}
+
+func (t *cosCredentialTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	secretId, secretKey, token := t.credential.GetCredential()
+
+	// the request must not be modified by the RoundTripper
+	request = request.Clone(request.Context())
+	if t.pathPrefix != "" {
+		request.URL.Path = t.pathPrefix + request.URL.Path
+		if request.URL.RawPath != "" {
+			request.URL.RawPath = t.pathPrefix + request.URL.RawPath
+		}
+	}
+	cos.AddAuthorizationHeader(secretId, secretKey, token, request, cos.NewAuthTime(cosAuthExpire))
+
+	return t.transport.RoundTrip(request)
+}
@@ -0,0 +1,249 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+func newTestMetadataServer(t *testing.T, roleName string, expiredTime time.Time, fail *int32) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/meta-data/cam/security-credentials/"+roleName {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if fail != nil && atomic.LoadInt32(fail) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		n := atomic.AddInt32(&count, 1)
+		fmt.Fprintf(w, `{"TmpSecretId":"id-%d","TmpSecretKey":"key-%d","Token":"token-%d","ExpiredTime":%d,"Expiration":"","Code":"Success"}`,
+			n, n, n, expiredTime.Unix())
+	}))
+	t.Cleanup(server.Close)
+	return server, &count
+}
+
+func TestCvmRoleCredential(t *testing.T) {
+	now := time.Now()
+	var fail int32
+	server, count := newTestMetadataServer(t, "terraform", now.Add(time.Hour), &fail)
+
+	credential, err := NewCvmRoleCredential(server.URL+"/meta-data", "terraform")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if credential.GetSecretId() != "id-1" || credential.GetSecretKey() != "key-1" || credential.GetToken() != "token-1" {
+		t.Fatalf("unexpected credential: %s, %s, %s", credential.GetSecretId(), credential.GetSecretKey(), credential.GetToken())
+	}
+	if atomic.LoadInt32(count) != 1 {
+		t.Fatalf("credential should be cached before expired, fetched %d times", *count)
+	}
+
+	// within the refresh advance of expired time, the single field getters keep the credential in use
+	credential.now = func() time.Time { return now.Add(time.Hour - credentialRefreshAdvance + time.Second) }
+	if credential.GetToken() != "token-1" || credential.GetSecretId() != "id-1" {
+		t.Fatalf("credential should not be switched by the single field getters, got token %s", credential.GetToken())
+	}
+	if id, key, token := credential.GetCredential(); id != "id-2" || key != "key-2" || token != "token-2" {
+		t.Fatalf("credential should be refreshed, got %s, %s, %s", id, key, token)
+	}
+
+	// keep the old one when refresh failed
+	atomic.StoreInt32(&fail, 1)
+	credential.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if _, _, token := credential.GetCredential(); token != "token-2" {
+		t.Fatalf("credential should be kept when refresh failed, got token %s", token)
+	}
+
+	atomic.StoreInt32(&fail, 0)
+	if credential.GetToken() != "token-3" {
+		t.Fatalf("expired credential should be refreshed after metadata recovered, got token %s", credential.GetToken())
+	}
+}
+
+func TestRefreshCredentialSnapshot(t *testing.T) {
+	now := time.Now()
+	var fetched int32
+	credential, err := NewRefreshCredential("snapshot", func() (*TemporaryCredential, error) {
+		n := atomic.AddInt32(&fetched, 1)
+		return &TemporaryCredential{
+			SecretId:    fmt.Sprintf("id-%d", n),
+			SecretKey:   fmt.Sprintf("key-%d", n),
+			Token:       fmt.Sprintf("token-%d", n),
+			ExpiredTime: now.Add(time.Hour),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// every signing refreshes the credential, concurrently with the others
+	credential.now = func() time.Time { return now.Add(time.Hour) }
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				id, key, token := credential.GetCredential()
+				if strings.TrimPrefix(id, "id-") != strings.TrimPrefix(key, "key-") || strings.TrimPrefix(id, "id-") != strings.TrimPrefix(token, "token-") {
+					t.Errorf("credential is mixed: %s, %s, %s", id, key, token)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+type cosVerifyTransport func(request *http.Request) error
+
+func (f cosVerifyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := f(request); err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    request,
+	}, nil
+}
+
+func TestCosCredentialTransportRefresh(t *testing.T) {
+	now := time.Now()
+	var fetched int32
+	credential, err := NewRefreshCredential("cos", func() (*TemporaryCredential, error) {
+		n := atomic.AddInt32(&fetched, 1)
+		return &TemporaryCredential{
+			SecretId:    fmt.Sprintf("id-%d", n),
+			SecretKey:   fmt.Sprintf("key-%d", n),
+			Token:       fmt.Sprintf("token-%d", n),
+			ExpiredTime: now.Add(time.Hour),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// the credential expires within the refresh advance, so every COS request refreshes it
+	credential.now = func() time.Time { return now.Add(time.Hour) }
+
+	// sign the request again with the credential named by q-ak, it must match if the secret key and
+	// token belong to the same credential
+	verify := func(request *http.Request) error {
+		params := make(map[string]string)
+		for _, pair := range strings.Split(request.Header.Get("Authorization"), "&") {
+			if kv := strings.SplitN(pair, "=", 2); len(kv) == 2 {
+				params[kv[0]] = kv[1]
+			}
+		}
+		n := strings.TrimPrefix(params["q-ak"], "id-")
+		if token := request.Header.Get("x-cos-security-token"); token != "token-"+n {
+			return fmt.Errorf("token %s is not the one of id-%s", token, n)
+		}
+		signTime := strings.Split(params["q-sign-time"], ";")
+		start, _ := strconv.ParseInt(signTime[0], 10, 64)
+		end, _ := strconv.ParseInt(signTime[1], 10, 64)
+		authTime := &cos.AuthTime{
+			SignStartTime: time.Unix(start, 0),
+			SignEndTime:   time.Unix(end, 0),
+			KeyStartTime:  time.Unix(start, 0),
+			KeyEndTime:    time.Unix(end, 0),
+		}
+
+		expected := request.Clone(request.Context())
+		expected.Header.Del("Authorization")
+		expected.Header.Del("x-cos-security-token")
+		cos.AddAuthorizationHeader("id-"+n, "key-"+n, "token-"+n, expected, authTime)
+		if expected.Header.Get("Authorization") != request.Header.Get("Authorization") {
+			return fmt.Errorf("request is not signed by key-%s", n)
+		}
+		return nil
+	}
+
+	bucketURL, _ := url.Parse("https://example-1250000000.cos.ap-guangzhou.myqcloud.com")
+	client := cos.NewClient(&cos.BaseURL{BucketURL: bucketURL}, &http.Client{
+		Transport: &cosCredentialTransport{credential: credential, transport: cosVerifyTransport(verify)},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := client.Object.Head(context.Background(), "object", nil); err != nil {
+					t.Errorf("head object failed: %s", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the first credential is fetched by NewRefreshCredential, then one refresh per request
+	if n := atomic.LoadInt32(&fetched); n != 101 {
+		t.Fatalf("expect 101 fetches, got %d", n)
+	}
+}
+
+func TestCvmRoleCredentialNotBound(t *testing.T) {
+	server, _ := newTestMetadataServer(t, "terraform", time.Now().Add(time.Hour), nil)
+
+	if _, err := NewCvmRoleCredential(server.URL+"/meta-data/", "other"); err == nil {
+		t.Fatalf("expect error when the role is not bound")
+	}
+}
+
+func TestRefreshCredentialChain(t *testing.T) {
+	now := time.Now()
+	source, err := NewRefreshCredential("source", func() (*TemporaryCredential, error) {
+		return &TemporaryCredential{SecretId: "source-id", SecretKey: "source-key", ExpiredTime: now.Add(time.Hour)}, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var fetched int
+	assumed, err := NewRefreshCredential("assumed", func() (*TemporaryCredential, error) {
+		fetched++
+		// the source credential is used to sign the refreshing request
+		return &TemporaryCredential{
+			SecretId:    fmt.Sprintf("%s-assumed-%d", source.GetSecretId(), fetched),
+			ExpiredTime: now.Add(time.Duration(fetched) * time.Hour),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	assumed.now = func() time.Time { return now.Add(30 * time.Minute) }
+	if id := assumed.GetSecretId(); id != "source-id-assumed-1" {
+		t.Fatalf("unexpected secret id: %s", id)
+	}
+	assumed.now = func() time.Time { return now.Add(time.Hour) }
+	if id := assumed.GetSecretId(); id != "source-id-assumed-2" {
+		t.Fatalf("unexpected secret id: %s", id)
+	}
+	if expired := assumed.ExpiredTime(); !expired.Equal(now.Add(2 * time.Hour)) {
+		t.Fatalf("unexpected expired time: %s", expired)
+	}
+
+	if _, err = NewRefreshCredential("failed", func() (*TemporaryCredential, error) {
+		return nil, fmt.Errorf("AuthFailure.TokenFailure")
+	}); err == nil {
+		t.Fatalf("expect error when the first fetch failed")
+	}
+}
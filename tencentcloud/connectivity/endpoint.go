@@ -0,0 +1,74 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// EndpointServices are the services whose endpoint can be overridden by the provider `endpoints` block,
+// `cos_control`, `ci` and `pic` are the COS batch, CI and picture processing endpoints.
+var EndpointServices = []string{
+	"antiddos", "api", "apigateway", "apm", "as", "cam", "cat", "cbs", "cdb", "cdn", "cdwch", "cfs", "chdfs",
+	"ci", "ciam", "ckafka", "clb", "cloudaudit", "cls", "cos", "cos_control", "cvm", "cwp", "cynosdb", "dayu",
+	"dbbrain", "dc", "dcdb", "dlc", "dnspod", "domain", "dts", "eb", "emr", "es", "gaap", "kms", "lighthouse",
+	"live", "mariadb", "mdl", "mongodb", "monitor", "mps", "organization", "pic", "postgres", "privatedns",
+	"pts", "redis", "rum", "scf", "ses", "sms", "sqlserver", "ssl", "ssm", "sts", "tag", "tat", "tcaplusdb",
+	"tcm", "tcr", "tdcpg", "tdmq", "tem", "teo", "tke", "tse", "tsf", "vod", "vpc", "wedata", "wss",
+}
+
+// cosBucketPlaceholder is replaced by the bucket, or the uin of `cos_control`, in the host of the
+// overridden COS endpoints, e.g. `{bucket}.cos-internal.ap-guangzhou.tencentcos.cn`
+const cosBucketPlaceholder = "{bucket}"
+
+// endpoint returns the scheme and host of the overridden endpoint of service, the endpoint is either
+// a host, e.g. `cvm.internal.tencentcloudapi.com`, or an url, e.g. `http://127.0.0.1:8080`.
+func (me *TencentCloudClient) endpoint(service string) (scheme, host string) {
+	value := strings.TrimSuffix(me.Endpoints[service], "/")
+	if value == "" {
+		return
+	}
+	// not parsed by url.Parse, which rejects the `{bucket}` in host
+	if i := strings.Index(value, "://"); i >= 0 {
+		scheme, value = value[:i], value[i+len("://"):]
+	}
+	return scheme, strings.SplitN(value, "/", 2)[0]
+}
+
+// cosURL returns the url of COS services, which is `https://<prefix>.<service>.<region>.myqcloud.com`
+// by default, the prefix is the bucket or the uin. The overridden endpoint puts the prefix at the
+// `{bucket}` of its host, or in the path if there is no `{bucket}`, e.g. `http://127.0.0.1:9000/<prefix>`,
+// as the prefix can't be added to the host of an IP or a local stand-in.
+func (me *TencentCloudClient) cosURL(service, prefix string) *url.URL {
+	scheme, host := me.endpoint(service)
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	if host == "" {
+		host = fmt.Sprintf("%s.%s.myqcloud.com", strings.Replace(service, "_", "-", -1), me.Region)
+		if prefix != "" {
+			host = prefix + "." + host
+		}
+		return &url.URL{Scheme: scheme, Host: host}
+	}
+
+	if strings.Contains(host, cosBucketPlaceholder) {
+		if prefix == "" {
+			host = strings.Replace(host, cosBucketPlaceholder+".", "", 1)
+		}
+		return &url.URL{Scheme: scheme, Host: strings.Replace(host, cosBucketPlaceholder, prefix, 1)}
+	}
+
+	u := &url.URL{Scheme: scheme, Host: host}
+	if prefix != "" {
+		u.Path = "/" + prefix
+	}
+	return u
+}
+
+// cosPathStyle returns whether the COS requests of service put the bucket in the path
+func (me *TencentCloudClient) cosPathStyle(service string) bool {
+	_, host := me.endpoint(service)
+	return host != "" && !strings.Contains(host, cosBucketPlaceholder)
+}
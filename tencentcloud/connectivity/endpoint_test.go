@@ -0,0 +1,105 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func TestCosURL(t *testing.T) {
+	client := &TencentCloudClient{
+		Region: "ap-guangzhou",
+		Endpoints: map[string]string{
+			"cos": "http://127.0.0.1:9000/",
+			"ci":  "{bucket}.ci-internal.ap-guangzhou.tencentcos.cn",
+		},
+	}
+
+	for _, c := range []struct {
+		service, prefix, expected string
+	}{
+		{"cos", "", "http://127.0.0.1:9000"},
+		{"cos", "bucket-1250000000", "http://127.0.0.1:9000/bucket-1250000000"},
+		{"ci", "", "https://ci-internal.ap-guangzhou.tencentcos.cn"},
+		{"ci", "bucket-1250000000", "https://bucket-1250000000.ci-internal.ap-guangzhou.tencentcos.cn"},
+		{"pic", "bucket-1250000000", "https://bucket-1250000000.pic.ap-guangzhou.myqcloud.com"},
+		{"cos_control", "100000", "https://100000.cos-control.ap-guangzhou.myqcloud.com"},
+	} {
+		if u := client.cosURL(c.service, c.prefix).String(); u != c.expected {
+			t.Errorf("%s %s: expect %s, got %s", c.service, c.prefix, c.expected, u)
+		}
+	}
+}
+
+func TestCosEndpointOverride(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// signed by the COS SDK or the s3 compatible aws SDK
+		if auth := r.Header.Get("Authorization"); !strings.Contains(auth, "q-ak=id") && !strings.Contains(auth, "Credential=id/") {
+			t.Errorf("request is not signed: %s", auth)
+		}
+		paths = append(paths, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Endpoints:  map[string]string{"cos": server.URL},
+	}
+
+	// the bucket of the overridden endpoint without `{bucket}` is sent in the path
+	if _, err := client.UseTencentCosClient("bucket-1250000000").Object.Head(context.Background(), "dir/object", nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.UseCosClient().HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("bucket-1250000000"),
+		Key:    aws.String("dir/object"),
+	}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, path := range paths {
+		if path != "/bucket-1250000000/dir/object" {
+			t.Errorf("expect path-style request, got path %s", path)
+		}
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expect 2 requests, got %d", len(paths))
+	}
+}
+
+func TestEndpointOverride(t *testing.T) {
+	var action, host string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action = r.Header.Get("X-TC-Action")
+		host = r.Host
+		fmt.Fprint(w, `{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"1"}}`)
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"vpc": server.URL},
+	}
+
+	if cpf := client.NewClientProfile("cvm", 300); cpf.HttpProfile.Endpoint != "" || cpf.HttpProfile.Scheme != "HTTPS" {
+		t.Fatalf("unexpected endpoint of cvm: %s://%s", cpf.HttpProfile.Scheme, cpf.HttpProfile.Endpoint)
+	}
+
+	if _, err := client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if action != "DescribeVpcs" || "http://"+host != server.URL {
+		t.Fatalf("request should be sent to the overridden endpoint, got action %s, host %s", action, host)
+	}
+}
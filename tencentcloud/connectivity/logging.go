@@ -0,0 +1,109 @@
+package connectivity
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+const (
+	// LogName is the name of the provider logger
+	LogName = "tencentcloud"
+	// LogLevelEnv sets the level of the provider logger, TF_LOG_PROVIDER and TF_LOG are used if it is absent.
+	// The level of the subsystem of a service is set by the variable suffixed with the service,
+	// e.g. TF_LOG_PROVIDER_TENCENTCLOUD_CVM.
+	LogLevelEnv = "TF_LOG_PROVIDER_TENCENTCLOUD"
+)
+
+// the fields of the structured log entries
+const (
+	LogFieldLogId        = "log_id"
+	LogFieldService      = "tencentcloud_service"
+	LogFieldAction       = "tencentcloud_action"
+	LogFieldRegion       = "tencentcloud_region"
+	LogFieldHost         = "tencentcloud_host"
+	LogFieldRequestId    = "tencentcloud_request_id"
+	LogFieldErrorCode    = "tencentcloud_error_code"
+	LogFieldRequestBody  = "tencentcloud_request_body"
+	LogFieldResponseBody = "tencentcloud_response_body"
+	LogFieldLatency      = "latency_ms"
+	LogFieldResourceType = "tf_resource_type"
+	LogFieldResourceId   = "tf_resource_id"
+	LogFieldOperation    = "tf_operation"
+)
+
+type logIdContextKey string
+
+// LogIdKey is the context key of the log id, which correlates the API requests with the Terraform operation
+const LogIdKey = logIdContextKey("logId")
+
+// terraformContextKey marks the context given by Terraform, see TerraformContext
+const terraformContextKey = logIdContextKey("terraform")
+
+// TerraformContext marks ctx as the context of a Terraform request, which carries the provider logger set up
+// by the plugin server and its fields, e.g. `tf_req_id` and `tf_rpc`.
+func TerraformContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, terraformContextKey, true)
+}
+
+// LogContext returns ctx with the provider logger, which carries the fields set in ctx by WithLogFields
+// and the log id.
+//
+// The logger of the context marked by TerraformContext is kept, otherwise, e.g. the context of the resources
+// without the context-aware CRUD, the provider logger is created here.
+func LogContext(ctx context.Context) context.Context {
+	if terraform, _ := ctx.Value(terraformContextKey).(bool); !terraform {
+		ctx = tfsdklog.NewRootProviderLogger(ctx,
+			tfsdklog.WithLogName(LogName),
+			tfsdklog.WithLevel(envLogLevel(LogLevelEnv, "TF_LOG_PROVIDER", "TF_LOG")),
+			tfsdklog.WithoutLocation(),
+		)
+	}
+	if logId, ok := ctx.Value(LogIdKey).(string); ok {
+		ctx = tflog.SetField(ctx, LogFieldLogId, logId)
+	}
+	return ctx
+}
+
+// ServiceLogContext returns ctx with the provider logger and the subsystem logger of service,
+// the subsystem carries the fields of the provider logger.
+func ServiceLogContext(ctx context.Context, service string) context.Context {
+	return tflog.NewSubsystem(LogContext(ctx), service, tflog.WithLevel(serviceLogLevel(service)), tflog.WithRootFields())
+}
+
+// WithLogFields returns ctx with the fields, which are added to the log entries of the API requests sent with it
+func WithLogFields(ctx context.Context, fields map[string]interface{}) context.Context {
+	for key, value := range fields {
+		ctx = tflog.SetField(ctx, key, value)
+	}
+	return ctx
+}
+
+// envLogLevel returns the level of the first variable set, or hclog.Off if none of them are set
+func envLogLevel(names ...string) hclog.Level {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return hclog.LevelFromString(value)
+		}
+	}
+	return hclog.Off
+}
+
+// serviceLogLevel returns the level of the subsystem of service, once the level of any service is set,
+// the services without their own levels are turned off, so that only the traffic of them is logged.
+func serviceLogLevel(service string) hclog.Level {
+	if value := os.Getenv(LogLevelEnv + "_" + strings.ToUpper(service)); value != "" {
+		return hclog.LevelFromString(value)
+	}
+	for _, env := range os.Environ() {
+		if strings.HasPrefix(env, LogLevelEnv+"_") {
+			return hclog.Off
+		}
+	}
+	// inherits the level of the provider logger
+	return hclog.NoLevel
+}
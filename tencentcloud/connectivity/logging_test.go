@@ -0,0 +1,176 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// captureLog returns the context whose log entries are written to a file, and a func reading the entries
+func captureLog(t *testing.T) (context.Context, func() []map[string]interface{}) {
+	logPath := filepath.Join(t.TempDir(), "terraform.log")
+	t.Setenv("TF_LOG", "JSON")
+	t.Setenv("TF_LOG_PATH", logPath)
+
+	ctx := tfsdklog.RegisterTestSink(context.Background(), t)
+	return ctx, func() []map[string]interface{} {
+		content, err := ioutil.ReadFile(logPath)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		var entries []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+			if line == "" {
+				continue
+			}
+			entry := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("unexpected log line %s: %s", line, err)
+			}
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+}
+
+func TestServiceLogLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		service  string
+		expected hclog.Level
+	}{
+		{
+			name:     "not set",
+			service:  "cvm",
+			expected: hclog.NoLevel,
+		},
+		{
+			name:     "own level",
+			env:      map[string]string{LogLevelEnv + "_CVM": "trace"},
+			service:  "cvm",
+			expected: hclog.Trace,
+		},
+		{
+			name:     "other service set",
+			env:      map[string]string{LogLevelEnv + "_CVM": "trace"},
+			service:  "vpc",
+			expected: hclog.Off,
+		},
+		{
+			name:     "provider level",
+			env:      map[string]string{LogLevelEnv: "debug"},
+			service:  "vpc",
+			expected: hclog.NoLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			if level := serviceLogLevel(tt.service); level != tt.expected {
+				t.Errorf("expect %s, got %s", tt.expected, level)
+			}
+		})
+	}
+}
+
+func TestLogRoundTripperFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-TC-Action") == "TerminateInstances" {
+			fmt.Fprint(w, `{"Response":{"Error":{"Code":"InvalidInstanceId.NotFound","Message":"not found"},"RequestId":"2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":{"TotalCount":0,"RequestId":"1"}}`)
+	}))
+	defer server.Close()
+
+	ctx, entries := captureLog(t)
+	ctx = context.WithValue(ctx, LogIdKey, "1697500000000-1")
+	ctx = WithLogFields(ctx, map[string]interface{}{LogFieldResourceType: "tencentcloud_instance"})
+
+	for _, action := range []string{"DescribeInstances", "TerminateInstances"} {
+		request, _ := http.NewRequestWithContext(ctx, "POST", server.URL, strings.NewReader(`{"Limit":20}`))
+		// the headers are set by the keys which are not canonical, as the SDK does
+		request.Header["X-TC-Action"] = []string{action}
+		request.Header["X-TC-Region"] = []string{"ap-guangzhou"}
+		transport := &LogRoundTripper{Service: "cvm", Fields: map[string]interface{}{LogFieldOperation: "read"}}
+		response, err := transport.RoundTrip(request)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		_ = response.Body.Close()
+	}
+
+	logged := entries()
+	if len(logged) != 2 {
+		t.Fatalf("expect 2 log entries, got %v", logged)
+	}
+	expected := []map[string]interface{}{
+		{
+			"@level":             "debug",
+			"@module":            "tencentcloud.cvm",
+			LogFieldAction:       "DescribeInstances",
+			LogFieldRequestId:    "1",
+			LogFieldRegion:       "ap-guangzhou",
+			LogFieldService:      "cvm",
+			LogFieldLogId:        "1697500000000-1",
+			LogFieldResourceType: "tencentcloud_instance",
+			LogFieldOperation:    "read",
+			LogFieldRequestBody:  `{"Limit":20}`,
+			LogFieldResponseBody: `{"Response":{"TotalCount":0,"RequestId":"1"}}`,
+		},
+		{
+			"@level":          "warn",
+			LogFieldAction:    "TerminateInstances",
+			LogFieldRequestId: "2",
+			LogFieldErrorCode: "InvalidInstanceId.NotFound",
+			LogFieldLogId:     "1697500000000-1",
+		},
+	}
+	for i, fields := range expected {
+		for key, value := range fields {
+			if logged[i][key] != value {
+				t.Errorf("expect %s of entry %d to be %v, got %v", key, i, value, logged[i][key])
+			}
+		}
+		if _, ok := logged[i][LogFieldLatency]; !ok {
+			t.Errorf("expect %s in entry %d", LogFieldLatency, i)
+		}
+	}
+}
+
+func TestLogContextTerraform(t *testing.T) {
+	ctx, entries := captureLog(t)
+	// the provider logger set up by the plugin server
+	ctx = tfsdklog.NewRootProviderLogger(ctx, tfsdklog.WithLogName("terraform-provider"), tfsdklog.WithLevel(hclog.Trace))
+	ctx = context.WithValue(ctx, LogIdKey, "1697500000000-1")
+
+	tflog.Debug(LogContext(TerraformContext(ctx)), "terraform")
+	tflog.Debug(LogContext(ctx), "standalone")
+
+	logged := entries()
+	if len(logged) != 2 {
+		t.Fatalf("expect 2 log entries, got %v", logged)
+	}
+	for i, expected := range []interface{}{"terraform-provider", LogName} {
+		if logged[i]["@module"] != expected {
+			t.Errorf("expect @module of entry %d to be %v, got %v", i, expected, logged[i]["@module"])
+		}
+		if logged[i][LogFieldLogId] != "1697500000000-1" {
+			t.Errorf("expect %s of entry %d to be the log id, got %v", LogFieldLogId, i, logged[i][LogFieldLogId])
+		}
+	}
+}
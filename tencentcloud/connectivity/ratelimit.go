@@ -0,0 +1,47 @@
+package connectivity
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// RateLimitRoundTripper limits the API requests by (service, action) with the Limiter, and
+// feeds RequestLimitExceeded back to it.
+type RateLimitRoundTripper struct {
+	Limiter *ratelimit.Limiter
+	// Service is the service of the requests, e.g. `cvm`
+	Service string
+	Next    http.RoundTripper
+}
+
+func (me *RateLimitRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
+	service := me.Service
+	action := apiHeader(request, "X-TC-Action")
+
+	if errRet = me.Limiter.Wait(request.Context(), service, action); errRet != nil {
+		return
+	}
+
+	response, errRet = me.Next.RoundTrip(request)
+	if errRet != nil {
+		return
+	}
+
+	body, errRet := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if errRet != nil {
+		return
+	}
+	response.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	if strings.HasPrefix(parseErrorCode(body), codeRequestLimitExceeded) {
+		me.Limiter.Throttled(service, action)
+	} else {
+		me.Limiter.Succeeded(service, action)
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+func TestRateLimitRoundTripper(t *testing.T) {
+	limited := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limited {
+			fmt.Fprint(w, `{"Response":{"Error":{"Code":"RequestLimitExceeded.UinLimitExceeded","Message":"limited"},"RequestId":"1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":{"RequestId":"2"}}`)
+	}))
+	defer server.Close()
+
+	limiter := ratelimit.NewLimiter(20, nil)
+	transport := &RateLimitRoundTripper{Limiter: limiter, Service: "cvm", Next: http.DefaultTransport}
+
+	send := func() {
+		request, _ := http.NewRequest("POST", server.URL, strings.NewReader("{}"))
+		// the header is set by the key which is not canonical, as the SDK does
+		request.Header["X-TC-Action"] = []string{"DescribeInstances"}
+		if _, err := transport.RoundTrip(request); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	send()
+	if rate := limiter.Rate("cvm", "DescribeInstances"); rate != 25 {
+		t.Fatalf("expect rate of cvm.DescribeInstances halved to 25, got %f", rate)
+	}
+
+	limited = false
+	send()
+	if rate := limiter.Rate("cvm", "DescribeInstances"); rate <= 25 || rate >= 50 {
+		t.Fatalf("expect rate of cvm.DescribeInstances grows slowly, got %f", rate)
+	}
+}
@@ -0,0 +1,242 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecorderMode is the mode of Recorder
+type RecorderMode string
+
+const (
+	// RecorderModeRecord sends the API requests and records the exchanges to the cassette
+	RecorderModeRecord RecorderMode = "record"
+	// RecorderModeReplay replays the exchanges of the cassette without sending any request
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// replayNotFoundCode is the error code returned when no recorded exchange matches the request in replay
+const replayNotFoundCode = "ReplayError.InteractionNotFound"
+
+// Interaction is an API exchange recorded in the cassette
+type Interaction struct {
+	Service    string          `json:"service"`
+	Action     string          `json:"action"`
+	Region     string          `json:"region,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	StatusCode int             `json:"status_code"`
+	Response   json.RawMessage `json:"response,omitempty"`
+}
+
+// Cassette is the API exchanges of a test in the order they are sent
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder records the API exchanges to a cassette file, or replays them from it, it is shared by all
+// the clients of a provider.
+type Recorder struct {
+	Mode RecorderMode
+	Path string
+	// Redactor masks the secrets before they are written to the cassette, DefaultRedactor is used if it is nil
+	Redactor *Redactor
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder of the cassette file, which is loaded in RecorderModeReplay
+func NewRecorder(mode RecorderMode, path string) (*Recorder, error) {
+	recorder := &Recorder{Mode: mode, Path: path}
+
+	switch mode {
+	case RecorderModeRecord:
+	case RecorderModeReplay:
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read cassette %s failed: %s", path, err.Error())
+		}
+		if err := json.Unmarshal(content, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("parse cassette %s failed: %s", path, err.Error())
+		}
+		recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown recorder mode %s", mode)
+	}
+	return recorder, nil
+}
+
+// RoundTripper returns the transport which records the exchanges with service sent by next, or replays them
+func (me *Recorder) RoundTripper(next http.RoundTripper, service string) http.RoundTripper {
+	return &RecordRoundTripper{Recorder: me, Transport: next, Service: service}
+}
+
+// Save writes the recorded exchanges to the cassette file, it does nothing in RecorderModeReplay
+func (me *Recorder) Save() error {
+	if me.Mode != RecorderModeRecord {
+		return nil
+	}
+
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	content, err := json.MarshalIndent(me.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(me.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(me.Path, content, 0644)
+}
+
+func (me *Recorder) redactor() *Redactor {
+	if me.Redactor == nil {
+		return DefaultRedactor
+	}
+	return me.Redactor
+}
+
+func (me *Recorder) record(interaction *Interaction) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	me.cassette.Interactions = append(me.cassette.Interactions, interaction)
+}
+
+// replay returns the first exchange not replayed yet of the service and action, the one with the same
+// request is preferred, so the concurrent requests of the same action are told apart.
+func (me *Recorder) replay(service, action string, request []byte) *Interaction {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	found := -1
+	for i, interaction := range me.cassette.Interactions {
+		if me.replayed[i] || interaction.Service != service || interaction.Action != action {
+			continue
+		}
+		if found < 0 {
+			found = i
+		}
+		if jsonEqual(interaction.Request, request) {
+			found = i
+			break
+		}
+	}
+	if found < 0 {
+		return nil
+	}
+	me.replayed[found] = true
+	return me.cassette.Interactions[found]
+}
+
+// RecordRoundTripper records or replays the API exchanges with the Recorder
+type RecordRoundTripper struct {
+	Recorder *Recorder
+	// Transport sends the requests in RecorderModeRecord, http.DefaultTransport is used if it is nil
+	Transport http.RoundTripper
+	// Service is the service of the requests, e.g. `cvm`
+	Service string
+}
+
+func (me *RecordRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
+	var requestBody []byte
+	if request.Body != nil {
+		requestBody, errRet = ioutil.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if errRet != nil {
+			return
+		}
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(requestBody))
+	}
+
+	service := me.Service
+	action := apiHeader(request, "X-TC-Action")
+	redactor := me.Recorder.redactor()
+	requestBody = redactor.Redact(action, requestBody)
+
+	if me.Recorder.Mode == RecorderModeReplay {
+		interaction := me.Recorder.replay(service, action, requestBody)
+		if interaction == nil {
+			body := fmt.Sprintf(`{"Response":{"Error":{"Code":"%s","Message":"no recorded exchange of %s %s is left in %s"},"RequestId":"replay"}}`,
+				replayNotFoundCode, service, action, me.Recorder.Path)
+			return replayResponse(request, http.StatusOK, []byte(body)), nil
+		}
+		return replayResponse(request, interaction.StatusCode, rawBody(interaction.Response)), nil
+	}
+
+	transport := me.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	response, errRet = transport.RoundTrip(request)
+	if errRet != nil {
+		return
+	}
+	responseBody, errRet := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if errRet != nil {
+		return
+	}
+	response.Body = ioutil.NopCloser(bytes.NewBuffer(responseBody))
+
+	me.Recorder.record(&Interaction{
+		Service:    service,
+		Action:     action,
+		Region:     apiHeader(request, "X-TC-Region"),
+		Request:    rawJSON(requestBody),
+		StatusCode: response.StatusCode,
+		Response:   rawJSON(redactor.Redact(action, responseBody)),
+	})
+	return
+}
+
+func replayResponse(request *http.Request, statusCode int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewBuffer(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+// rawJSON returns the body as json.RawMessage, the body which is not JSON is kept as a JSON string
+func rawJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, body); err == nil {
+			return buf.Bytes()
+		}
+	}
+	quoted, _ := json.Marshal(string(body))
+	return quoted
+}
+
+// rawBody returns the body kept by rawJSON
+func rawBody(raw json.RawMessage) []byte {
+	var body string
+	if json.Unmarshal(raw, &body) == nil {
+		return []byte(body)
+	}
+	return rawJSON(raw)
+}
+
+// jsonEqual returns whether the recorded request is the same as the body
+func jsonEqual(recorded json.RawMessage, body []byte) bool {
+	return bytes.Equal(rawJSON(recorded), rawJSON(body))
+}
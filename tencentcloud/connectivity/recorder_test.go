@@ -0,0 +1,75 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"Response":{"Action":"%s","Request":%s,"RequestId":"1"}}`, r.Header.Get("X-TC-Action"), body)
+	}))
+	defer server.Close()
+
+	send := func(transport http.RoundTripper, action, body string) string {
+		request, _ := http.NewRequest("POST", server.URL, strings.NewReader(body))
+		// the header is set by the key which is not canonical, as the SDK does
+		request.Header["X-TC-Action"] = []string{action}
+		response, err := transport.RoundTrip(request)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		defer response.Body.Close()
+		content, _ := ioutil.ReadAll(response.Body)
+		return string(content)
+	}
+
+	cassette := filepath.Join(t.TempDir(), "cassettes", "TestRecorder.json")
+	recorder, err := NewRecorder(RecorderModeRecord, cassette)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	transport := recorder.RoundTripper(http.DefaultTransport, "vpc")
+	recorded := []string{
+		send(transport, "CreateVpc", `{"VpcName":"a"}`),
+		send(transport, "CreateVpc", `{"VpcName":"b"}`),
+		send(transport, "DescribeVpcs", `{}`),
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	server.Close()
+
+	replayer, err := NewRecorder(RecorderModeReplay, cassette)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	transport = replayer.RoundTripper(nil, "vpc")
+
+	// the exchange of the same request is replayed first, though it is not the first one of the action
+	if got := send(transport, "CreateVpc", `{"VpcName": "b"}`); got != recorded[1] {
+		t.Errorf("expect %s, got %s", recorded[1], got)
+	}
+	if got := send(transport, "DescribeVpcs", `{}`); got != recorded[2] {
+		t.Errorf("expect %s, got %s", recorded[2], got)
+	}
+	if got := send(transport, "CreateVpc", `{"VpcName":"c"}`); got != recorded[0] {
+		t.Errorf("expect %s, got %s", recorded[0], got)
+	}
+	if got := send(transport, "CreateVpc", `{"VpcName":"a"}`); !strings.Contains(got, replayNotFoundCode) {
+		t.Errorf("expect error %s when the exchanges are used up, got %s", replayNotFoundCode, got)
+	}
+
+	if _, err := NewRecorder(RecorderModeReplay, filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("expect error replaying the missing cassette")
+	}
+	if _, err := NewRecorder("unknown", cassette); err == nil {
+		t.Errorf("expect error of unknown mode")
+	}
+}
@@ -0,0 +1,166 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+const redactedValue = "******"
+
+// DefaultRedactFields are masked in the log wherever they are in the requests and responses,
+// a field is matched if its name ends with one of them, case-insensitively.
+var DefaultRedactFields = []string{
+	"Password",
+	"Passwd",
+	"SecretKey",
+	"SecretString",
+	"SecretBinary",
+	"PrivateKey",
+	"Kubeconfig",
+	"Credential",
+	"Credentials",
+	"AccessKey",
+}
+
+// secretTokens are masked only if the field name is exactly one of them, case-insensitively, as the
+// suffix `Token` is also of the fields which are not secrets, e.g. NextToken, ClientToken and PaginationToken.
+var secretTokens = map[string]bool{
+	"token":            true,
+	"sessiontoken":     true,
+	"securitytoken":    true,
+	"webidentitytoken": true,
+	"accesstoken":      true,
+	"refreshtoken":     true,
+	"authtoken":        true,
+	"apitoken":         true,
+}
+
+// sensitiveActions return secrets in the fields of their own names, all the values in the responses
+// of them are masked except the request id and the error.
+var sensitiveActions = map[string]bool{
+	// tke
+	"DescribeClusterKubeconfig": true,
+	"DescribeClusterSecurity":   true,
+	// ssm
+	"GetSecretValue":         true,
+	"GetSSHKeyPairValue":     true,
+	"DescribeRotationDetail": true,
+	// ssl
+	"DownloadCertificate": true,
+	// sts
+	"AssumeRole":                true,
+	"AssumeRoleWithWebIdentity": true,
+	"AssumeRoleWithSAML":        true,
+	"GetFederationToken":        true,
+	// cam
+	"CreateAccessKey": true,
+	"ListAccessKeys":  true,
+}
+
+// keptFields are never masked in the responses of sensitiveActions
+var keptFields = map[string]bool{
+	"RequestId": true,
+	"Code":      true,
+	"Message":   true,
+}
+
+// Redactor masks the secrets of the requests and responses in the log
+type Redactor struct {
+	fields []string
+}
+
+// DefaultRedactor masks DefaultRedactFields
+var DefaultRedactor = NewRedactor()
+
+// NewRedactor returns a Redactor which masks DefaultRedactFields and the extra fields
+func NewRedactor(extraFields ...string) *Redactor {
+	redactor := &Redactor{}
+	for _, field := range append(DefaultRedactFields, extraFields...) {
+		if field != "" {
+			redactor.fields = append(redactor.fields, strings.ToLower(field))
+		}
+	}
+	return redactor
+}
+
+// Redact returns the body of API request or response with the secrets masked,
+// the body which is not JSON is returned as it is.
+func (me *Redactor) Redact(action string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	// the numbers are kept as json.Number, the large ids and uins lose precision in float64
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return body
+	}
+
+	// only the responses of sensitiveActions are masked entirely, which are wrapped by `Response`
+	object, _ := value.(map[string]interface{})
+	_, isResponse := object["Response"]
+	value, redacted := me.redact(value, sensitiveActions[action] && isResponse)
+	if !redacted {
+		return body
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return []byte(redactedValue)
+	}
+	return result
+}
+
+func (me *Redactor) redact(value interface{}, all bool) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := false
+		for key, item := range v {
+			if me.sensitive(key) && item != nil {
+				v[key] = redactedValue
+				redacted = true
+				continue
+			}
+			if all && keptFields[key] {
+				continue
+			}
+			var itemRedacted bool
+			v[key], itemRedacted = me.redact(item, all)
+			redacted = redacted || itemRedacted
+		}
+		return v, redacted
+	case []interface{}:
+		redacted := false
+		for i, item := range v {
+			var itemRedacted bool
+			v[i], itemRedacted = me.redact(item, all)
+			redacted = redacted || itemRedacted
+		}
+		return v, redacted
+	case string:
+		if all && v != "" {
+			return redactedValue, true
+		}
+	}
+	return value, false
+}
+
+func (me *Redactor) sensitive(key string) bool {
+	key = strings.ToLower(key)
+	if secretTokens[key] {
+		return true
+	}
+	for _, field := range me.fields {
+		if strings.HasSuffix(key, field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package connectivity
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactorRedact(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []string
+		action   string
+		body     string
+		expected string
+	}{
+		{
+			name:     "empty",
+			action:   "RunInstances",
+			body:     ``,
+			expected: ``,
+		},
+		{
+			name:     "not json",
+			action:   "RunInstances",
+			body:     `<html>Bad Gateway</html>`,
+			expected: `<html>Bad Gateway</html>`,
+		},
+		{
+			name:     "nothing to redact",
+			action:   "DescribeInstances",
+			body:     `{"InstanceIds":["ins-1"],"Limit":20}`,
+			expected: `{"InstanceIds":["ins-1"],"Limit":20}`,
+		},
+		{
+			name:     "nested password",
+			action:   "RunInstances",
+			body:     `{"InstanceName":"web","LoginSettings":{"Password":"P@ssw0rd","KeyIds":null}}`,
+			expected: `{"InstanceName":"web","LoginSettings":{"KeyIds":null,"Password":"******"}}`,
+		},
+		{
+			name:     "suffix and case insensitive",
+			action:   "CreateDBInstanceHour",
+			body:     `{"RootPassword":"P@ssw0rd","Params":[{"Name":"a","ADMINPASSWORD":"x"}]}`,
+			expected: `{"Params":[{"ADMINPASSWORD":"******","Name":"a"}],"RootPassword":"******"}`,
+		},
+		{
+			name:     "tokens",
+			action:   "AssumeRoleWithWebIdentity",
+			body:     `{"WebIdentityToken":"jwt","SessionToken":"st","Token":"t","NextToken":"n","ClientToken":"c","PaginationToken":"p"}`,
+			expected: `{"ClientToken":"c","NextToken":"n","PaginationToken":"p","SessionToken":"******","Token":"******","WebIdentityToken":"******"}`,
+		},
+		{
+			name:     "object value",
+			action:   "DescribeSomething",
+			body:     `{"Response":{"Credentials":{"TmpSecretId":"id","TmpSecretKey":"key"},"RequestId":"1"}}`,
+			expected: `{"Response":{"Credentials":"******","RequestId":"1"}}`,
+		},
+		{
+			name:     "sensitive action response",
+			action:   "DescribeClusterKubeconfig",
+			body:     `{"Response":{"Config":"apiVersion: v1","Users":[{"Name":"admin"}],"RequestId":"1"}}`,
+			expected: `{"Response":{"Config":"******","RequestId":"1","Users":[{"Name":"******"}]}}`,
+		},
+		{
+			name:     "sensitive action error",
+			action:   "GetSecretValue",
+			body:     `{"Response":{"Error":{"Code":"ResourceNotFound","Message":"secret not found"},"RequestId":"1"}}`,
+			expected: `{"Response":{"Error":{"Code":"ResourceNotFound","Message":"secret not found"},"RequestId":"1"}}`,
+		},
+		{
+			name:     "sensitive action request",
+			action:   "GetSecretValue",
+			body:     `{"SecretName":"db","VersionId":"v1"}`,
+			expected: `{"SecretName":"db","VersionId":"v1"}`,
+		},
+		{
+			name:     "extra fields",
+			fields:   []string{"UserData"},
+			action:   "RunInstances",
+			body:     `{"UserData":"IyEvYmluL2Jhc2g=","InstanceName":"web"}`,
+			expected: `{"InstanceName":"web","UserData":"******"}`,
+		},
+		{
+			name:     "extra fields not configured",
+			action:   "RunInstances",
+			body:     `{"UserData":"IyEvYmluL2Jhc2g="}`,
+			expected: `{"UserData":"IyEvYmluL2Jhc2g="}`,
+		},
+		{
+			name:     "large numbers",
+			action:   "CreateUser",
+			body:     `{"OwnerUin":100012345678901234,"Password":"x","Ratio":0.5}`,
+			expected: `{"OwnerUin":100012345678901234,"Password":"******","Ratio":0.5}`,
+		},
+		{
+			name:     "trailing data",
+			action:   "RunInstances",
+			body:     `{"Password":"x"} tail`,
+			expected: `{"Password":"x"} tail`,
+		},
+		{
+			name:     "array body",
+			action:   "GetSecretValue",
+			body:     `[{"Password":"x"}]`,
+			expected: `[{"Password":"******"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := string(NewRedactor(tt.fields...).Redact(tt.action, []byte(tt.body))); result != tt.expected {
+				t.Errorf("expect %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLogRoundTripperRedact(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":{"UserName":"admin","CertificationAuthority":"-----BEGIN CERTIFICATE-----","RequestId":"1"}}`)
+	}))
+	defer server.Close()
+
+	ctx, entries := captureLog(t)
+
+	request, _ := http.NewRequestWithContext(ctx, "POST", server.URL, strings.NewReader(`{"ClusterId":"cls-1","Password":"P@ssw0rd"}`))
+	// the header is set by the key which is not canonical, as the SDK does
+	request.Header["X-TC-Action"] = []string{"DescribeClusterSecurity"}
+	response, err := (&LogRoundTripper{}).RoundTrip(request)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var body bytes.Buffer
+	_, _ = body.ReadFrom(response.Body)
+	if !strings.Contains(body.String(), "BEGIN CERTIFICATE") {
+		t.Errorf("the response should not be redacted, got %s", body.String())
+	}
+
+	logged := fmt.Sprint(entries())
+	if strings.Contains(logged, "P@ssw0rd") || strings.Contains(logged, "BEGIN CERTIFICATE") {
+		t.Errorf("the secrets should be redacted in the log, got %s", logged)
+	}
+	if !strings.Contains(logged, "cls-1") {
+		t.Errorf("the other fields should be kept in the log, got %s", logged)
+	}
+}
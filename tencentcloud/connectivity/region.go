@@ -0,0 +1,55 @@
+package connectivity
+
+import "sync"
+
+// regionClients caches the clients of the regions other than the one of the provider, which are
+// created by WithRegion and shared by all the resources targeting the same region.
+type regionClients struct {
+	mu      sync.Mutex
+	clients map[string]*TencentCloudClient
+}
+
+// WithRegion returns the client of region, which shares the credential, transport, retryer, rate limiter,
+// endpoints and recorder with me. The clients are cached by region, so the SDK clients of each region are
+// created once. me is returned if region is empty or the region of me.
+//
+// The overridden endpoints are shared too, so the services whose endpoint is overridden by an url of
+// a region keep sending requests to it.
+func (me *TencentCloudClient) WithRegion(region string) *TencentCloudClient {
+	if region == "" || region == me.Region {
+		return me
+	}
+
+	root := me
+	if me.root != nil {
+		root = me.root
+		if region == root.Region {
+			return root
+		}
+	}
+
+	root.regionsOnce.Do(func() {
+		root.regions = &regionClients{clients: make(map[string]*TencentCloudClient)}
+	})
+	root.regions.mu.Lock()
+	defer root.regions.mu.Unlock()
+
+	if client, ok := root.regions.clients[region]; ok {
+		return client
+	}
+	client := &TencentCloudClient{
+		Credential:  root.Credential,
+		Region:      region,
+		Protocol:    root.Protocol,
+		Domain:      root.Domain,
+		Retryer:     root.Retryer,
+		RateLimiter: root.RateLimiter,
+		Endpoints:   root.Endpoints,
+		Transport:   root.Transport,
+		Redactor:    root.Redactor,
+		Recorder:    root.Recorder,
+		root:        root,
+	}
+	root.regions.clients[region] = client
+	return client
+}
@@ -0,0 +1,64 @@
+package connectivity
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+func TestWithRegion(t *testing.T) {
+	root := &TencentCloudClient{
+		Credential: common.NewCredential("AKIDfake", "fake"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"cvm": "cvm.internal.tencentcloudapi.com"},
+		Retryer:    NewRetryer(DefaultRetryPolicy),
+	}
+
+	if root.WithRegion("") != root || root.WithRegion("ap-guangzhou") != root {
+		t.Errorf("expect the client itself for the empty and its own region")
+	}
+
+	shanghai := root.WithRegion("ap-shanghai")
+	if shanghai == root || shanghai.Region != "ap-shanghai" {
+		t.Fatalf("expect a client of ap-shanghai, got %s", shanghai.Region)
+	}
+	if shanghai.Credential != root.Credential || shanghai.Retryer != root.Retryer || shanghai.Endpoints["cvm"] != root.Endpoints["cvm"] {
+		t.Errorf("expect the client of ap-shanghai sharing the credential, retryer and endpoints")
+	}
+	if region := shanghai.UseVpcClient().GetRegion(); region != "ap-shanghai" {
+		t.Errorf("expect the vpc client of ap-shanghai, got %s", region)
+	}
+	if region := root.UseVpcClient().GetRegion(); region != "ap-guangzhou" {
+		t.Errorf("expect the vpc client of the root in ap-guangzhou, got %s", region)
+	}
+
+	if root.WithRegion("ap-shanghai") != shanghai {
+		t.Errorf("expect the client of ap-shanghai cached")
+	}
+	if shanghai.WithRegion("ap-guangzhou") != root || shanghai.WithRegion("ap-beijing") != root.WithRegion("ap-beijing") {
+		t.Errorf("expect the clients created by a region client cached by the root")
+	}
+}
+
+func TestWithRegionConcurrently(t *testing.T) {
+	root := &TencentCloudClient{Region: "ap-guangzhou"}
+
+	clients := make([]*TencentCloudClient, 20)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i] = root.WithRegion("ap-singapore")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, client := range clients {
+		if client != clients[0] {
+			t.Fatalf("expect one client of ap-singapore created")
+		}
+	}
+}
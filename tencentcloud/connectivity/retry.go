@@ -0,0 +1,253 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	codeRequestLimitExceeded = "RequestLimitExceeded"
+	codeNetworkError         = "ClientError.NetworkError"
+
+	// the API limit is counted per second, waiting less than it is useless
+	requestLimitExceededMinDelay = time.Second
+
+	// signatureWindow is how long a signed API request is accepted, the request sent again after it
+	// fails with AuthFailure.SignatureExpire. The margin leaves room for the clock skew.
+	signatureWindow       = 5 * time.Minute
+	signatureWindowMargin = 30 * time.Second
+)
+
+// DefaultRetryableCodes are retried by every client, the codes of products are retried in the resources
+var DefaultRetryableCodes = []string{
+	codeRequestLimitExceeded,
+	codeNetworkError,
+}
+
+// DefaultRetryPolicy is used when the provider `retry` block is absent
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   time.Second,
+	MaxDelay:    30 * time.Second,
+}
+
+// RetryPolicy is the provider `retry` block
+type RetryPolicy struct {
+	MaxAttempts         int
+	BaseDelay           time.Duration
+	MaxDelay            time.Duration
+	ExtraRetryableCodes []string
+}
+
+// Clock is the time source of Retryer, replaced by a fake one in tests
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Retryer retries with exponential backoff and jitter
+type Retryer struct {
+	policy RetryPolicy
+	clock  Clock
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewRetryer returns a Retryer, the zero fields of policy fall back to DefaultRetryPolicy
+func NewRetryer(policy RetryPolicy) *Retryer {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+	if policy.MaxDelay < policy.BaseDelay {
+		policy.MaxDelay = policy.BaseDelay
+	}
+
+	return &Retryer{
+		policy: policy,
+		clock:  realClock{},
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// WithClock replaces the clock of Retryer
+func (me *Retryer) WithClock(clock Clock) *Retryer {
+	me.clock = clock
+	return me
+}
+
+// Clock returns the clock of Retryer
+func (me *Retryer) Clock() Clock {
+	return me.clock
+}
+
+// Retryable returns whether the error code is retryable, `Foo.Bar` is retryable if `Foo` is
+func (me *Retryer) Retryable(code string) bool {
+	if code == "" {
+		return false
+	}
+	shortCode := strings.Split(code, ".")[0]
+	for _, codes := range [][]string{DefaultRetryableCodes, me.policy.ExtraRetryableCodes} {
+		for _, c := range codes {
+			if c == code || c == shortCode {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay before the next attempt, `attempt` starts from 1. The delay is
+// `BaseDelay * 2^(attempt-1)` capped by `MaxDelay`, half of it is randomized to avoid retrying in lockstep.
+func (me *Retryer) Backoff(attempt int, code string) time.Duration {
+	delay := me.policy.MaxDelay
+	if attempt < 32 {
+		if d := me.policy.BaseDelay << uint(attempt-1); d > 0 && d < delay {
+			delay = d
+		}
+	}
+
+	me.mu.Lock()
+	jitter := time.Duration(me.rand.Int63n(int64(delay/2) + 1))
+	me.mu.Unlock()
+	delay = delay/2 + jitter
+
+	if strings.HasPrefix(code, codeRequestLimitExceeded) && delay < requestLimitExceededMinDelay {
+		delay = requestLimitExceededMinDelay
+	}
+	return delay
+}
+
+// Do calls `f` until it returns a code which is not retryable or the attempts are used up,
+// and returns the error of the last call.
+func (me *Retryer) Do(ctx context.Context, f func() (code string, err error)) error {
+	return me.DoUntil(ctx, time.Time{}, f)
+}
+
+// DoUntil is Do which doesn't retry after the deadline, the zero deadline means no limit.
+func (me *Retryer) DoUntil(ctx context.Context, deadline time.Time, f func() (code string, err error)) error {
+	for attempt := 1; ; attempt++ {
+		code, err := f()
+		if !me.Retryable(code) || attempt >= me.policy.MaxAttempts {
+			return err
+		}
+
+		delay := me.Backoff(attempt, code)
+		if !deadline.IsZero() && me.clock.Now().Add(delay).After(deadline) {
+			log.Printf("[DEBUG] retryable error %s, not retrying after %s", code, deadline.Format(time.RFC3339))
+			return err
+		}
+		log.Printf("[DEBUG] retryable error %s, retrying (%d/%d) in %s", code, attempt, me.policy.MaxAttempts-1, delay)
+		if sleepErr := me.clock.Sleep(ctx, delay); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// RetryRoundTripper retries the API requests with the Retryer
+type RetryRoundTripper struct {
+	Retryer *Retryer
+	Next    http.RoundTripper
+}
+
+// RoundTrip sends the signed request again, so the retries are limited to the signature window
+// which starts from `X-TC-Timestamp`, the retries after it can't succeed.
+func (me *RetryRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
+	action := apiHeader(request, "X-TC-Action")
+
+	signedAt := me.Retryer.clock.Now()
+	if timestamp, err := strconv.ParseInt(apiHeader(request, "X-TC-Timestamp"), 10, 64); err == nil {
+		signedAt = time.Unix(timestamp, 0)
+	}
+	deadline := signedAt.Add(signatureWindow - signatureWindowMargin)
+
+	errRet = me.Retryer.DoUntil(request.Context(), deadline, func() (string, error) {
+		attemptRequest := request.Clone(request.Context())
+		if request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return "", err
+			}
+			attemptRequest.Body = body
+		}
+
+		var err error
+		response, err = me.Next.RoundTrip(attemptRequest)
+		if err != nil {
+			// the write request may have been received, only the read ones are safe to send again
+			if isReadAction(action) {
+				return codeNetworkError, err
+			}
+			return "", err
+		}
+
+		body, err := ioutil.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		response.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+		return parseErrorCode(body), nil
+	})
+
+	return
+}
+
+func isReadAction(action string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List", "Query", "Inquiry", "Inquire", "Check"} {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type errorCodeResponse struct {
+	Response struct {
+		Error struct {
+			Code string `json:"Code"`
+		} `json:"Error"`
+	} `json:"Response"`
+}
+
+func parseErrorCode(body []byte) string {
+	var result errorCodeResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ""
+	}
+	return result.Response.Error.Code
+}
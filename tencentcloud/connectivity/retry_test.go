@@ -0,0 +1,202 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func TestRetryerBackoff(t *testing.T) {
+	retryer := NewRetryer(RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+
+	for i := 0; i < 100; i++ {
+		for attempt, expected := range map[int]time.Duration{
+			1:  100 * time.Millisecond,
+			2:  200 * time.Millisecond,
+			4:  800 * time.Millisecond,
+			5:  time.Second,
+			64: time.Second,
+		} {
+			if delay := retryer.Backoff(attempt, ""); delay < expected/2 || delay > expected {
+				t.Fatalf("attempt %d: expect delay in [%s, %s], got %s", attempt, expected/2, expected, delay)
+			}
+		}
+		if delay := retryer.Backoff(1, "RequestLimitExceeded.UinLimitExceeded"); delay < time.Second {
+			t.Fatalf("expect at least 1s for RequestLimitExceeded, got %s", delay)
+		}
+	}
+}
+
+func TestRetryerRetryable(t *testing.T) {
+	retryer := NewRetryer(RetryPolicy{ExtraRetryableCodes: []string{"ResourceInUse", "FailedOperation.Busy"}})
+
+	for code, expected := range map[string]bool{
+		"":                                      false,
+		"RequestLimitExceeded":                  true,
+		"RequestLimitExceeded.UinLimitExceeded": true,
+		"ClientError.NetworkError":              true,
+		"ClientError.HttpStatusCodeError":       false,
+		"ResourceInUse.Vpc":                     true,
+		"FailedOperation.Busy":                  true,
+		"FailedOperation":                       false,
+		"InternalError":                         false,
+	} {
+		if retryer.Retryable(code) != expected {
+			t.Errorf("code %q: expect retryable %t", code, expected)
+		}
+	}
+}
+
+func TestRetryerDo(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	retryer := NewRetryer(RetryPolicy{MaxAttempts: 4, BaseDelay: time.Second, MaxDelay: 3 * time.Second}).WithClock(clock)
+
+	var calls int
+	err := retryer.Do(context.Background(), func() (string, error) {
+		calls++
+		return "ResourceUnavailable", fmt.Errorf("call %d", calls)
+	})
+	if calls != 1 || len(clock.sleeps) != 0 || err == nil {
+		t.Fatalf("non-retryable code should not be retried, calls: %d", calls)
+	}
+
+	calls = 0
+	err = retryer.Do(context.Background(), func() (string, error) {
+		calls++
+		return "ClientError.NetworkError", fmt.Errorf("call %d", calls)
+	})
+	if calls != 4 || len(clock.sleeps) != 3 {
+		t.Fatalf("expect 4 calls and 3 sleeps, got %d calls and %d sleeps", calls, len(clock.sleeps))
+	}
+	if err == nil || err.Error() != "call 4" {
+		t.Fatalf("expect the error of the last call, got %v", err)
+	}
+
+	calls = 0
+	err = retryer.Do(context.Background(), func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "RequestLimitExceeded", nil
+		}
+		return "", nil
+	})
+	if calls != 3 || err != nil {
+		t.Fatalf("expect success after 3 calls, got %d calls, err: %v", calls, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	_ = retryer.Do(ctx, func() (string, error) {
+		calls++
+		return "RequestLimitExceeded", nil
+	})
+	if calls != 1 {
+		t.Fatalf("canceled context should stop retrying, got %d calls", calls)
+	}
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"VpcId":"vpc-1"}` {
+			t.Errorf("request body should be sent again, got %s", body)
+		}
+		if atomic.AddInt32(&count, 1) < 3 {
+			fmt.Fprint(w, `{"Response":{"Error":{"Code":"RequestLimitExceeded","Message":"limited"},"RequestId":"1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":{"RequestId":"2"}}`)
+	}))
+	defer server.Close()
+
+	clock := &fakeClock{now: time.Now()}
+	transport := &RetryRoundTripper{
+		Retryer: NewRetryer(RetryPolicy{MaxAttempts: 5}).WithClock(clock),
+		Next:    http.DefaultTransport,
+	}
+
+	// the headers are set by the keys which are not canonical, as the SDK does
+	request, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"VpcId":"vpc-1"}`))
+	request.Header["X-TC-Action"] = []string{"DeleteVpc"}
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	body, _ := ioutil.ReadAll(response.Body)
+	if string(body) != `{"Response":{"RequestId":"2"}}` || atomic.LoadInt32(&count) != 3 || len(clock.sleeps) != 2 {
+		t.Fatalf("unexpected response %s after %d requests", body, count)
+	}
+
+	// the network failure of write requests is not retried
+	server.Close()
+	clock.sleeps = nil
+	request, _ = http.NewRequest("POST", server.URL, strings.NewReader(`{"VpcId":"vpc-1"}`))
+	request.Header["X-TC-Action"] = []string{"DeleteVpc"}
+	if _, err = transport.RoundTrip(request); err == nil || len(clock.sleeps) != 0 {
+		t.Fatalf("expect error without retrying, err: %v, sleeps: %d", err, len(clock.sleeps))
+	}
+
+	request, _ = http.NewRequest("POST", server.URL, strings.NewReader(`{"VpcId":"vpc-1"}`))
+	request.Header["X-TC-Action"] = []string{"DescribeVpcs"}
+	if _, err = transport.RoundTrip(request); err == nil || len(clock.sleeps) != 4 {
+		t.Fatalf("expect error after retrying, err: %v, sleeps: %d", err, len(clock.sleeps))
+	}
+}
+
+func TestRetryRoundTripperSignatureWindow(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		fmt.Fprint(w, `{"Response":{"Error":{"Code":"RequestLimitExceeded","Message":"limited"},"RequestId":"1"}}`)
+	}))
+	defer server.Close()
+
+	clock := &fakeClock{now: time.Now()}
+	transport := &RetryRoundTripper{
+		Retryer: NewRetryer(RetryPolicy{MaxAttempts: 100, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}).WithClock(clock),
+		Next:    http.DefaultTransport,
+	}
+
+	// the request was signed one minute ago
+	signedAt := clock.now.Add(-time.Minute)
+	request, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	request.Header["X-TC-Action"] = []string{"DescribeVpcs"}
+	request.Header["X-TC-Timestamp"] = []string{strconv.FormatInt(signedAt.Unix(), 10)}
+	if _, err := transport.RoundTrip(request); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if n := atomic.LoadInt32(&count); n < 2 || n >= 100 {
+		t.Fatalf("expect retrying within the signature window, got %d requests", n)
+	}
+	if !clock.now.Before(signedAt.Add(signatureWindow)) {
+		t.Fatalf("the last retry at %s is out of the signature window signed at %s", clock.now, signedAt)
+	}
+}
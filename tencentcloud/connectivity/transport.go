@@ -2,13 +2,17 @@ package connectivity
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const REQUEST_CLIENT = "TENCENTCLOUD_API_REQUEST_CLIENT"
@@ -22,91 +26,178 @@ func SetReqClient(name string) {
 	ReqClient = name
 }
 
+// TransportConfig is the proxy and TLS settings of the provider
+type TransportConfig struct {
+	Proxy      string
+	CABundle   string
+	ClientCert string
+	ClientKey  string
+	Insecure   bool
+}
+
+// NewTransport returns the transport shared by all the clients
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.Proxy != "" {
+		proxy, err := url.Parse(config.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy %s failed: %s", config.Proxy, err.Error())
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.Insecure,
+	}
+
+	if config.CABundle != "" {
+		pem, err := ioutil.ReadFile(config.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("read ca bundle %s failed: %s", config.CABundle, err.Error())
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate is found in ca bundle %s", config.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("client cert and client key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCert, config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("load client cert %s failed: %s", config.ClientCert, err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// apiHeader returns the header of the API request, the SDK sets the headers such as `X-TC-Action` by the keys
+// which are not canonical, so they are not found by Header.Get.
+func apiHeader(request *http.Request, key string) string {
+	if values := request.Header[key]; len(values) > 0 {
+		return values[0]
+	}
+	return request.Header.Get(key)
+}
+
 type LogRoundTripper struct {
+	// Transport sends the requests, http.DefaultTransport is used if it is nil
+	Transport http.RoundTripper
+	// Redactor masks the secrets in the log, DefaultRedactor is used if it is nil
+	Redactor *Redactor
+	// Service is the service of the requests, e.g. `cvm`, which is the subsystem of the log
+	Service string
+	// Fields are added to the log entries, e.g. the resource and operation sending the requests
+	Fields map[string]interface{}
 }
 
 func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
 
-	var inBytes, outBytes []byte
+	var requestBody, responseBody []byte
 
 	var start = time.Now()
 
-	defer func() { me.log(inBytes, outBytes, errRet, start) }()
+	redactor := me.Redactor
+	if redactor == nil {
+		redactor = DefaultRedactor
+	}
+	service := me.Service
+	action := apiHeader(request, "X-TC-Action")
+
+	defer func() {
+		me.log(request, service, redactor.Redact(action, requestBody), redactor.Redact(action, responseBody), errRet, start)
+	}()
 
 	bodyReader, errRet := request.GetBody()
 	if errRet != nil {
 		return
 	}
-	var headName = "X-TC-Action"
 
 	if envReqClient := os.Getenv(REQUEST_CLIENT); envReqClient != "" {
 		ReqClient = envReqClient
 	}
 
 	request.Header.Set("X-TC-RequestClient", ReqClient)
-	inBytes = []byte(fmt.Sprintf("%s, request: ", request.Header[headName]))
-	requestBody, errRet := ioutil.ReadAll(bodyReader)
+	requestBody, errRet = ioutil.ReadAll(bodyReader)
 	if errRet != nil {
 		return
 	}
-	inBytes = append(inBytes, requestBody...)
-
-	headName = "X-TC-Region"
-	appendMessage := []byte(fmt.Sprintf(
-		", (host %+v, region:%+v)",
-		request.Header["Host"],
-		request.Header[headName],
-	))
-
-	inBytes = append(inBytes, appendMessage...)
 
-	response, errRet = http.DefaultTransport.RoundTrip(request)
+	transport := me.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	response, errRet = transport.RoundTrip(request)
 	if errRet != nil {
 		return
 	}
-	outBytes, errRet = ioutil.ReadAll(response.Body)
+	responseBody, errRet = ioutil.ReadAll(response.Body)
 	if errRet != nil {
 		return
 	}
-	response.Body = ioutil.NopCloser(bytes.NewBuffer(outBytes))
+	response.Body = ioutil.NopCloser(bytes.NewBuffer(responseBody))
 	return
 }
 
-func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time) {
-	var buf bytes.Buffer
-	buf.WriteString("######")
-	tag := "[DEBUG]"
-	if err != nil {
-		tag = "[CRITICAL]"
+// log writes a structured entry of the API request to the subsystem of service
+func (me *LogRoundTripper) log(request *http.Request, service string, in []byte, out []byte, err error, start time.Time) {
+	ctx := ServiceLogContext(WithLogFields(request.Context(), me.Fields), service)
+
+	host := request.Host
+	if host == "" {
+		host = request.URL.Host
 	}
-	buf.WriteString(tag)
-	if len(in) > 0 {
-		buf.WriteString("tencentcloud-sdk-go: ")
-		buf.Write(in)
+	fields := map[string]interface{}{
+		LogFieldService:     service,
+		LogFieldAction:      apiHeader(request, "X-TC-Action"),
+		LogFieldRegion:      apiHeader(request, "X-TC-Region"),
+		LogFieldHost:        host,
+		LogFieldLatency:     time.Since(start).Milliseconds(),
+		LogFieldRequestBody: compactBody(in),
 	}
 	if len(out) > 0 {
-		buf.WriteString("; response:")
-		err := json.Compact(&buf, out)
-		if err != nil {
-			out := bytes.Replace(out,
-				[]byte("\n"),
-				[]byte(""),
-				-1)
-			out = bytes.Replace(out,
-				[]byte(" "),
-				[]byte(""),
-				-1)
-			buf.Write(out)
+		fields[LogFieldResponseBody] = compactBody(out)
+		var result requestIdResponse
+		if json.Unmarshal(out, &result) == nil {
+			fields[LogFieldRequestId] = result.Response.RequestId
 		}
 	}
 
 	if err != nil {
-		buf.WriteString("; error:")
-		buf.WriteString(err.Error())
+		fields["error"] = err.Error()
+		tflog.SubsystemError(ctx, service, "API request failed", fields)
+		return
+	}
+	if code := parseErrorCode(out); code != "" {
+		fields[LogFieldErrorCode] = code
+		tflog.SubsystemWarn(ctx, service, "API returned error", fields)
+		return
 	}
+	tflog.SubsystemDebug(ctx, service, "API request", fields)
+}
 
-	costFormat := fmt.Sprintf(",cost %s", time.Since(start).String())
-	buf.WriteString(costFormat)
+type requestIdResponse struct {
+	Response struct {
+		RequestId string `json:"RequestId"`
+	} `json:"Response"`
+}
 
-	log.Println(buf.String())
+// compactBody returns the body in a line, which is compacted if it is JSON
+func compactBody(body []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return string(bytes.Replace(body, []byte("\n"), []byte(""), -1))
+	}
+	return buf.String()
 }
@@ -0,0 +1,68 @@
+package connectivity
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":{"RequestId":"1"}}`)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caBundle, certPem, 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	send := func(config TransportConfig) error {
+		transport, err := NewTransport(config)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		request, _ := http.NewRequest("POST", server.URL, strings.NewReader("{}"))
+		response, err := (&LogRoundTripper{Transport: transport}).RoundTrip(request)
+		if err == nil {
+			_ = response.Body.Close()
+		}
+		return err
+	}
+
+	if err := send(TransportConfig{}); err == nil {
+		t.Errorf("expect error verifying the self signed certificate")
+	}
+	if err := send(TransportConfig{CABundle: caBundle}); err != nil {
+		t.Errorf("expect the certificate trusted by the ca bundle, err: %s", err)
+	}
+	if err := send(TransportConfig{Insecure: true}); err != nil {
+		t.Errorf("expect the certificate not verified, err: %s", err)
+	}
+
+	transport, err := NewTransport(TransportConfig{Proxy: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	request, _ := http.NewRequest("POST", "https://cvm.tencentcloudapi.com", nil)
+	if proxy, _ := transport.Proxy(request); proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("unexpected proxy: %v", proxy)
+	}
+
+	for _, config := range []TransportConfig{
+		{CABundle: filepath.Join(t.TempDir(), "missing.pem")},
+		{CABundle: filepath.Join(t.TempDir())},
+		{ClientCert: caBundle},
+		{ClientCert: caBundle, ClientKey: caBundle},
+	} {
+		if _, err := NewTransport(config); err == nil {
+			t.Errorf("expect error for config %+v", config)
+		}
+	}
+}
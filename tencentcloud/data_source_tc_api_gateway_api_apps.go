@@ -68,6 +68,7 @@ func dataSourceTencentCloudAPIGatewayAPIApps() *schema.Resource {
 						"api_app_secret": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "ApiApp secret.",
 						},
 						"created_time": {
@@ -70,6 +70,7 @@ func dataSourceTencentCloudAPIGatewayAPIKeys() *schema.Resource {
 						"access_key_secret": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "Created API key.",
 						},
 						"modify_time": {
@@ -0,0 +1,127 @@
+/*
+Use this data source to inquire the price of CBS storages before creating them, both the prepaid and the postpaid prices are returned.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cbs_storage_price" "price" {
+  storage_type   = "CLOUD_SSD"
+  storage_size   = 100
+  prepaid_period = 12
+}
+
+output "postpaid_price" {
+  value = data.tencentcloud_cbs_storage_price.price.postpaid_price
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCbsStoragePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsStoragePriceRead,
+
+		Schema: priceSchema(map[string]*schema.Schema{
+			"storage_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of CBS medium. Valid values: CLOUD_BASIC: HDD cloud disk, CLOUD_PREMIUM: Premium Cloud Storage, CLOUD_BSSD: General Purpose SSD, CLOUD_SSD: SSD, CLOUD_HSSD: Enhanced SSD, CLOUD_TSSD: Tremendous SSD.",
+			},
+			"storage_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Volume of CBS, and unit is GB.",
+			},
+			"storage_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 50),
+				Description:  "The number of CBS to be priced. Default is `1`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(CBS_PREPAID_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid price. Valid values are 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 24, 36. Default is `1`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"throughput_performance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Add extra performance to the data disk. Only works when disk type is `CLOUD_TSSD` or `CLOUD_HSSD`.",
+			},
+			"disk_backup_quota": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The quota of backup points of cloud disk.",
+			},
+		}),
+	}
+}
+
+func dataSourceTencentCloudCbsStoragePriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_cbs_storage_price.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := cbs.NewInquiryPriceCreateDisksRequest()
+	request.DiskType = helper.String(d.Get("storage_type").(string))
+	request.DiskSize = helper.IntUint64(d.Get("storage_size").(int))
+	request.DiskCount = helper.IntUint64(d.Get("storage_count").(int))
+	request.ProjectId = helper.IntUint64(d.Get("project_id").(int))
+	if v, ok := d.GetOk("throughput_performance"); ok {
+		request.ThroughputPerformance = helper.IntUint64(v.(int))
+	}
+	if v, ok := d.GetOk("disk_backup_quota"); ok {
+		request.DiskBackupQuota = helper.IntUint64(v.(int))
+	}
+	period := d.Get("prepaid_period").(int)
+	id := fmt.Sprintf("%s#%d", request.ToJsonString(), period)
+
+	cbsService := CbsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	items := make([]priceItem, 0, 2)
+	for _, chargeType := range []string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID} {
+		request.DiskChargeType = helper.String(chargeType)
+		request.DiskChargePrepaid = nil
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period)}
+		}
+
+		var price *cbs.Price
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := cbsService.InquiryPriceCreateDisks(ctx, request)
+			if e != nil {
+				return retryError(e)
+			}
+			price = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		items = append(items, cbsPriceItem(chargeType, price))
+	}
+
+	return setPrices(d, id, items)
+}
@@ -0,0 +1,65 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+)
+
+func TestUnitCbsStoragePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	r := Provider().DataSourcesMap["tencentcloud_cbs_storage_price"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"storage_type":   "CLOUD_SSD",
+		"storage_size":   100,
+		"storage_count":  3,
+		"prepaid_period": 6,
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 2, server.Calls("cbs", "InquiryPriceCreateDisks"))
+
+	diskHour := fakeapi.PriceDiskGBHour * 100 * 3
+	assert.InDelta(t, diskHour*720*6, d.Get("prepaid_price"), 0.001)
+	assert.InDelta(t, diskHour, d.Get("postpaid_price"), 0.001)
+	assert.Equal(t, 2, d.Get("price_list.#"))
+	assert.Equal(t, "PREPAID", d.Get("price_list.0.charge_type"))
+	assert.Equal(t, "disk", d.Get("price_list.0.item"))
+	assert.Equal(t, 100.0, d.Get("price_list.0.discount"))
+	assert.Equal(t, "POSTPAID_BY_HOUR", d.Get("price_list.1.charge_type"))
+	assert.Equal(t, "HOUR", d.Get("price_list.1.charge_unit"))
+}
+
+func TestAccTencentCloudCbsStoragePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsStoragePriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_cbs_storage_price.price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_storage_price.price", "prepaid_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_storage_price.price", "postpaid_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_price.price", "price_list.#", "2"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_storage_price.price", "price_list.1.charge_unit", "HOUR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsStoragePriceDataSource = `
+data "tencentcloud_cbs_storage_price" "price" {
+  storage_type   = "CLOUD_PREMIUM"
+  storage_size   = 50
+  prepaid_period = 1
+}
+`
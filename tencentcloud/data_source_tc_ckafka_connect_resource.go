@@ -154,6 +154,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the Dts consumer group.",
 												},
 												"resource": {
@@ -193,6 +194,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -242,6 +244,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -291,6 +294,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -340,6 +344,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -394,6 +399,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -448,6 +454,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -492,6 +499,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -546,6 +554,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -575,6 +584,7 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "The password of the connection source.",
 												},
 												"resource": {
@@ -296,6 +296,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "MongoDB database password.",
 												},
 												"listening_event": {
@@ -345,6 +346,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "Es Password.",
 												},
 												"self_built": {
@@ -708,6 +710,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"group_password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "Dts consumer group passwd.",
 												},
 												"tran_sql": {
@@ -791,6 +794,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "ClickHouse passwd.",
 												},
 												"service_vip": {
@@ -1678,6 +1682,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "MongoDB database password.",
 												},
 												"listening_event": {
@@ -1727,6 +1732,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "Es Password.",
 												},
 												"self_built": {
@@ -2090,6 +2096,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"group_password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "Dts consumer group passwd.",
 												},
 												"tran_sql": {
@@ -2173,6 +2180,7 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "ClickHouse passwd.",
 												},
 												"service_vip": {
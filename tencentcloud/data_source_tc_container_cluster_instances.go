@@ -91,6 +91,11 @@ func dataSourceTencentCloudContainerClusterInstances() *schema.Resource {
 					},
 				},
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -179,5 +184,12 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 	_ = d.Set("nodes", nodes)
 	_ = d.Set("total_count", *response.Response.TotalCount)
 
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), nodes); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
 	return nil
 }
@@ -80,6 +80,7 @@ func dataSourceTencentCloudContainerClusters() *schema.Resource {
 						"security_password": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "Describe the password needed for using kubectl to access to kubernetes.",
 						},
 						"description": {
@@ -115,6 +116,11 @@ func dataSourceTencentCloudContainerClusters() *schema.Resource {
 					},
 				},
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -241,5 +247,12 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 	_ = d.Set("clusters", clustersList)
 	_ = d.Set("total_count", *response.Response.TotalCount)
 
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), clustersList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+			return err
+		}
+	}
 	return nil
 }
@@ -84,12 +84,14 @@ func dataSourceTencentCloudDtsMigrateDbInstances() *schema.Resource {
 			"tmp_secret_key": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "temporary secret key, used across account.",
 			},
 
 			"tmp_token": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "temporary token, used across account.",
 			},
 
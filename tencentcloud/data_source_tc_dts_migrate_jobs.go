@@ -432,6 +432,7 @@ func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "password.",
 												},
 												"cvm_instance_id": {
@@ -492,11 +493,13 @@ func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												"tmp_secret_key": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "temporary secret key.",
 												},
 												"tmp_token": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "temporary token.",
 												},
 											},
@@ -589,6 +592,7 @@ func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												"password": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "password.",
 												},
 												"cvm_instance_id": {
@@ -649,11 +653,13 @@ func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												"tmp_secret_key": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "temporary secret key.",
 												},
 												"tmp_token": {
 													Type:        schema.TypeString,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "temporary token.",
 												},
 											},
@@ -441,6 +441,7 @@ func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "password.",
 									},
 									"db_name": {
@@ -511,11 +512,13 @@ func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "temporary secret key.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "temporary token.",
 									},
 								},
@@ -575,6 +578,7 @@ func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "password.",
 									},
 									"db_name": {
@@ -645,11 +649,13 @@ func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "temporary secret key.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "temporary token.",
 									},
 								},
@@ -82,6 +82,11 @@ func dataSourceTencentCloudEip() *schema.Resource {
 				Computed:    true,
 				Description: "The status of the EIP, there are several status like `BIND`, `UNBIND`, and `BIND_ENI`.",
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -155,5 +160,15 @@ func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) err
 	_ = d.Set("public_ip", *eip.AddressIp)
 	_ = d.Set("status", *eip.AddressStatus)
 
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"id":        eip.AddressId,
+			"public_ip": eip.AddressIp,
+			"status":    eip.AddressStatus,
+		}); e != nil {
+			return e
+		}
+	}
 	return nil
 }
@@ -154,6 +154,7 @@ func datasourceTencentCloudEksClusterCredential() *schema.Resource {
 			"kube_config": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "EKS cluster kubeconfig.",
 			},
 		},
@@ -0,0 +1,197 @@
+/*
+Use this data source to inquire the price of CVM instances before creating them, both the prepaid and the postpaid prices are returned.
+
+Example Usage
+
+```hcl
+data "tencentcloud_instance_price" "price" {
+  availability_zone                   = "ap-guangzhou-3"
+  image_id                            = "img-l8og963d"
+  instance_type                       = "S5.MEDIUM4"
+  system_disk_type                    = "CLOUD_PREMIUM"
+  system_disk_size                    = 50
+  internet_charge_type                = "TRAFFIC_POSTPAID_BY_HOUR"
+  internet_max_bandwidth_out          = 10
+  instance_charge_type_prepaid_period = 12
+
+  data_disks {
+    data_disk_type = "CLOUD_SSD"
+    data_disk_size = 100
+  }
+}
+
+output "prepaid_price" {
+  value = data.tencentcloud_instance_price.price.prepaid_price
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudInstancePriceRead,
+
+		Schema: priceSchema(map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The available zone for the CVM instance.",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The image to use for the instance, the price of the paid images is included.",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the instance.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+				Description:  "The number of instances to be priced. Default is `1`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The project the instance belongs to, default to 0.",
+			},
+			"instance_charge_type_prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(CVM_PREPAID_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid price. Valid values are `1`, `2`, `3`, `4`, `5`, `6`, `7`, `8`, `9`, `10`, `11`, `12`, `24`, `36`. Default is `1`.",
+			},
+			"internet_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(CVM_INTERNET_CHARGE_TYPE),
+				Description:  "Internet charge type of the instance, Valid values are `BANDWIDTH_PREPAID`, `TRAFFIC_POSTPAID_BY_HOUR`, `BANDWIDTH_POSTPAID_BY_HOUR` and `BANDWIDTH_PACKAGE`. The postpaid price of `BANDWIDTH_PREPAID` is inquired as `BANDWIDTH_POSTPAID_BY_HOUR`.",
+			},
+			"internet_max_bandwidth_out": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum outgoing bandwidth to the public network, measured in Mbps (Mega bits per second).",
+			},
+			"bandwidth_package_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "bandwidth package id. if user is standard user, then the bandwidth_package_id is needed, or default has bandwidth_package_id.",
+			},
+			"allocate_public_ip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Associate a public IP address with an instance in a VPC or Classic.",
+			},
+			"system_disk_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CVM_DISK_TYPE_CLOUD_PREMIUM,
+				ValidateFunc: validateAllowedStringValue(CVM_DISK_TYPE),
+				Description:  "System disk type. Valid values: `LOCAL_BASIC`: local disk, `LOCAL_SSD`: local SSD disk, `CLOUD_SSD`: SSD, `CLOUD_PREMIUM`: Premium Cloud Storage, `CLOUD_BSSD`: Basic SSD. Default is `CLOUD_PREMIUM`.",
+			},
+			"system_disk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Size of the system disk. unit is GB, Default is 50GB.",
+			},
+			"data_disks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Settings for data disks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_disk_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Data disk type. Valid values: LOCAL_BASIC, LOCAL_SSD, CLOUD_BASIC, CLOUD_PREMIUM, CLOUD_SSD, CLOUD_HSSD, CLOUD_TSSD and CLOUD_BSSD.",
+						},
+						"data_disk_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Size of the data disk, and unit is GB.",
+						},
+						"throughput_performance": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Add extra performance to the data disk. Only works when disk type is `CLOUD_TSSD` or `CLOUD_HSSD`.",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceTencentCloudInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_instance_price.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := cvm.NewInquiryPriceRunInstancesRequest()
+	request.Placement = &cvm.Placement{
+		Zone:      helper.String(d.Get("availability_zone").(string)),
+		ProjectId: helper.IntInt64(d.Get("project_id").(int)),
+	}
+	request.ImageId = helper.String(d.Get("image_id").(string))
+	request.InstanceType = helper.String(d.Get("instance_type").(string))
+	request.InstanceCount = helper.IntInt64(d.Get("instance_count").(int))
+	request.SystemDisk = &cvm.SystemDisk{
+		DiskType: helper.String(d.Get("system_disk_type").(string)),
+		DiskSize: helper.IntInt64(d.Get("system_disk_size").(int)),
+	}
+	for _, v := range d.Get("data_disks").([]interface{}) {
+		value := v.(map[string]interface{})
+		dataDisk := &cvm.DataDisk{
+			DiskType: helper.String(value["data_disk_type"].(string)),
+			DiskSize: helper.IntInt64(value["data_disk_size"].(int)),
+		}
+		if throughput := value["throughput_performance"].(int); throughput > 0 {
+			dataDisk.ThroughputPerformance = helper.IntInt64(throughput)
+		}
+		request.DataDisks = append(request.DataDisks, dataDisk)
+	}
+
+	internetAccessible := &cvm.InternetAccessible{}
+	if v, ok := d.GetOk("internet_charge_type"); ok {
+		internetAccessible.InternetChargeType = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("internet_max_bandwidth_out"); ok {
+		internetAccessible.InternetMaxBandwidthOut = helper.IntInt64(v.(int))
+	}
+	if v, ok := d.GetOk("bandwidth_package_id"); ok {
+		internetAccessible.BandwidthPackageId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOkExists("allocate_public_ip"); ok {
+		internetAccessible.PublicIpAssigned = helper.Bool(v.(bool))
+	}
+	request.InternetAccessible = internetAccessible
+
+	period := d.Get("instance_charge_type_prepaid_period").(int)
+	id := fmt.Sprintf("%s#%d", request.ToJsonString(), period)
+	cvmService := CvmService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	items, err := inquiryCvmPrices(ctx, cvmService, request, period)
+	if err != nil {
+		return err
+	}
+
+	return setPrices(d, id, items)
+}
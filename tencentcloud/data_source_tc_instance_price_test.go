@@ -0,0 +1,132 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+)
+
+func TestUnitInstancePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	r := Provider().DataSourcesMap["tencentcloud_instance_price"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"availability_zone":                   "ap-guangzhou-3",
+		"image_id":                            "img-test",
+		"instance_type":                       "S5.MEDIUM4",
+		"instance_count":                      2,
+		"internet_charge_type":                "BANDWIDTH_PREPAID",
+		"internet_max_bandwidth_out":          10,
+		"instance_charge_type_prepaid_period": 12,
+		"data_disks": []interface{}{
+			map[string]interface{}{"data_disk_type": "CLOUD_SSD", "data_disk_size": 100},
+		},
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 2, server.Calls("cvm", "InquiryPriceRunInstances"))
+
+	// 2 instances of 150GB disks and 10Mbps, whose bandwidth is inquired by hour for the postpaid price
+	instanceHour := (fakeapi.PriceInstanceHour + fakeapi.PriceDiskGBHour*150) * 2
+	bandwidthHour := fakeapi.PriceBandwidthMbpsHour * 10 * 2
+	expected := []map[string]interface{}{
+		{"charge_type": "PREPAID", "item": "instance", "discount_price": instanceHour * 720 * 12 * 0.8, "charge_unit": ""},
+		{"charge_type": "PREPAID", "item": "bandwidth", "discount_price": bandwidthHour * 720 * 12 * 0.8, "charge_unit": ""},
+		{"charge_type": "POSTPAID_BY_HOUR", "item": "instance", "discount_price": instanceHour, "charge_unit": "HOUR"},
+		{"charge_type": "POSTPAID_BY_HOUR", "item": "bandwidth", "discount_price": bandwidthHour, "charge_unit": "HOUR"},
+	}
+	priceList := d.Get("price_list").([]interface{})
+	assert.Len(t, priceList, len(expected))
+	for i, item := range priceList {
+		item := item.(map[string]interface{})
+		assert.Equal(t, expected[i]["charge_type"], item["charge_type"])
+		assert.Equal(t, expected[i]["item"], item["item"])
+		assert.Equal(t, expected[i]["charge_unit"], item["charge_unit"])
+		assert.InDelta(t, expected[i]["discount_price"], item["discount_price"], 0.001)
+	}
+	assert.InDelta(t, (instanceHour+bandwidthHour)*720*12*0.8, d.Get("prepaid_price"), 0.001)
+	assert.InDelta(t, instanceHour+bandwidthHour, d.Get("postpaid_price"), 0.001)
+
+	// the traffic is listed for both charge types but not summed up in the prices
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"availability_zone":          "ap-guangzhou-3",
+		"image_id":                   "img-test",
+		"instance_type":              "S5.MEDIUM4",
+		"internet_charge_type":       "TRAFFIC_POSTPAID_BY_HOUR",
+		"internet_max_bandwidth_out": 10,
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.Equal(t, "PREPAID", d.Get("price_list.1.charge_type"))
+	assert.Equal(t, "GB", d.Get("price_list.1.charge_unit"))
+	assert.Equal(t, "GB", d.Get("price_list.3.charge_unit"))
+	assert.InDelta(t, (fakeapi.PriceInstanceHour+fakeapi.PriceDiskGBHour*50)*720*0.8, d.Get("prepaid_price"), 0.001)
+	assert.InDelta(t, fakeapi.PriceInstanceHour+fakeapi.PriceDiskGBHour*50, d.Get("postpaid_price"), 0.001)
+
+	server.InjectFault("cvm", "InquiryPriceRunInstances", fakeapi.Fault{Code: "ResourceInsufficient.SpecifiedInstanceType", Times: 1})
+	assert.NotNil(t, r.Read(d, meta))
+
+	// the charge type not sold for the instances is left out, the inquiry fails if neither is sold
+	server.InjectFault("cvm", "InquiryPriceRunInstances", fakeapi.Fault{Code: "InvalidInstanceNotSupportedPrepaidInstance", Times: 1})
+	assert.Nil(t, r.Read(d, meta))
+	assert.Equal(t, 2, d.Get("price_list.#"))
+	assert.Equal(t, "POSTPAID_BY_HOUR", d.Get("price_list.0.charge_type"))
+	assert.Equal(t, 0.0, d.Get("prepaid_price"))
+	assert.InDelta(t, fakeapi.PriceInstanceHour+fakeapi.PriceDiskGBHour*50, d.Get("postpaid_price"), 0.001)
+
+	server.InjectFault("cvm", "InquiryPriceRunInstances", fakeapi.Fault{Code: "UnsupportedOperation.InstanceChargeType", Times: 2})
+	assert.NotNil(t, r.Read(d, meta))
+}
+
+func TestAccTencentCloudInstancePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancePriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_instance_price.price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.price", "prepaid_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.price", "postpaid_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_instance_price.price", "price_list.0.charge_type", "PREPAID"),
+					resource.TestCheckResourceAttr("data.tencentcloud_instance_price.price", "price_list.0.item", "instance"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.price", "price_list.0.discount_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInstancePriceDataSource = `
+data "tencentcloud_images" "default" {
+  image_type = ["PUBLIC_IMAGE"]
+  os_name    = "centos"
+}
+
+data "tencentcloud_instance_types" "default" {
+  availability_zone = "` + defaultAZone + `"
+  cpu_core_count    = 2
+  memory_size       = 2
+  exclude_sold_out  = true
+}
+
+data "tencentcloud_instance_price" "price" {
+  availability_zone                   = "` + defaultAZone + `"
+  image_id                            = data.tencentcloud_images.default.images.0.image_id
+  instance_type                       = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+  internet_charge_type                = "TRAFFIC_POSTPAID_BY_HOUR"
+  internet_max_bandwidth_out          = 10
+  instance_charge_type_prepaid_period = 1
+
+  data_disks {
+    data_disk_type = "CLOUD_PREMIUM"
+    data_disk_size = 50
+  }
+}
+`
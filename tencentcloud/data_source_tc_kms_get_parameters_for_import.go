@@ -49,6 +49,7 @@ func dataSourceTencentCloudKmsGetParametersForImport() *schema.Resource {
 			"import_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The token required for importing key material is used as the parameter of ImportKeyMaterial.",
 			},
 			"parameters_valid_to": {
@@ -192,11 +192,13 @@ func tkeClusterInfo() map[string]*schema.Schema {
 		"kube_config": {
 			Type:        schema.TypeString,
 			Computed:    true,
+			Sensitive:   true,
 			Description: "Kubernetes config.",
 		},
 		"kube_config_intranet": {
 			Type:        schema.TypeString,
 			Computed:    true,
+			Sensitive:   true,
 			Description: "Kubernetes config of private network.",
 		},
 	}
@@ -0,0 +1,194 @@
+/*
+Use this data source to inquire the price of the nodes of a kubernetes node pool before creating it, both the prepaid and the postpaid prices are returned.
+
+The nodes are CVM instances launched by the `auto_scaling_config` of the node pool, so they are priced as `desired_capacity` instances of it.
+
+Example Usage
+
+```hcl
+data "tencentcloud_kubernetes_node_pool_price" "price" {
+  availability_zone = "ap-guangzhou-3"
+  image_id          = "img-l8og963d"
+  desired_capacity  = 3
+
+  auto_scaling_config {
+    instance_type                       = "S5.MEDIUM4"
+    system_disk_type                    = "CLOUD_PREMIUM"
+    system_disk_size                    = 50
+    internet_charge_type                = "TRAFFIC_POSTPAID_BY_HOUR"
+    internet_max_bandwidth_out          = 10
+    public_ip_assigned                  = true
+    instance_charge_type_prepaid_period = 12
+
+    data_disk {
+      disk_type = "CLOUD_PREMIUM"
+      disk_size = 50
+    }
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudKubernetesNodePoolPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudKubernetesNodePoolPriceRead,
+
+		Schema: priceSchema(map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The available zone of the subnets of the node pool.",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The image of the nodes, the price of the paid images is included.",
+			},
+			"desired_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+				Description:  "Desired capacity of the node pool, which is the number of nodes to be priced. Default is `1`.",
+			},
+			"auto_scaling_config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Auto scaling config parameters of the node pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Specified types of CVM instance.",
+						},
+						"system_disk_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SYSTEM_DISK_TYPE_CLOUD_PREMIUM,
+							ValidateFunc: validateAllowedStringValue(SYSTEM_DISK_ALLOW_TYPE),
+							Description:  "Type of a CVM disk. Valid value: `CLOUD_PREMIUM` and `CLOUD_SSD`. Default is `CLOUD_PREMIUM`.",
+						},
+						"system_disk_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validateIntegerInRange(50, 500),
+							Description:  "Volume of system disk in GB. Default is `50`.",
+						},
+						"data_disk": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configurations of data disk.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      SYSTEM_DISK_TYPE_CLOUD_PREMIUM,
+										ValidateFunc: validateAllowedStringValue(SYSTEM_DISK_ALLOW_TYPE),
+										Description:  "Types of disk. Valid value: `CLOUD_PREMIUM` and `CLOUD_SSD`.",
+									},
+									"disk_size": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Default:     0,
+										Description: "Volume of disk in GB. Default is `0`.",
+									},
+								},
+							},
+						},
+						"instance_charge_type_prepaid_period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateAllowedIntValue(CVM_PREPAID_PERIOD),
+							Description:  "The tenancy (in month) of the prepaid price. Valid values are `1`, `2`, `3`, `4`, `5`, `6`, `7`, `8`, `9`, `10`, `11`, `12`, `24`, `36`. Default is `1`.",
+						},
+						"internet_charge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      INTERNET_CHARGE_TYPE_TRAFFIC_POSTPAID_BY_HOUR,
+							ValidateFunc: validateAllowedStringValue(INTERNET_CHARGE_ALLOW_TYPE),
+							Description:  "Charge types for network traffic. Valid value: `BANDWIDTH_PREPAID`, `TRAFFIC_POSTPAID_BY_HOUR` and `BANDWIDTH_PACKAGE`. The postpaid price of `BANDWIDTH_PREPAID` is inquired as `BANDWIDTH_POSTPAID_BY_HOUR`.",
+						},
+						"internet_max_bandwidth_out": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Max bandwidth of Internet access in Mbps. Default is `0`.",
+						},
+						"bandwidth_package_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "bandwidth package id. if user is standard user, then the bandwidth_package_id is needed, or default has bandwidth_package_id.",
+						},
+						"public_ip_assigned": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Specify whether to assign an Internet IP address.",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceTencentCloudKubernetesNodePoolPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_kubernetes_node_pool_price.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	config := d.Get("auto_scaling_config").([]interface{})[0].(map[string]interface{})
+
+	request := cvm.NewInquiryPriceRunInstancesRequest()
+	request.Placement = &cvm.Placement{Zone: helper.String(d.Get("availability_zone").(string))}
+	request.ImageId = helper.String(d.Get("image_id").(string))
+	request.InstanceCount = helper.IntInt64(d.Get("desired_capacity").(int))
+	request.InstanceType = helper.String(config["instance_type"].(string))
+	request.SystemDisk = &cvm.SystemDisk{
+		DiskType: helper.String(config["system_disk_type"].(string)),
+		DiskSize: helper.IntInt64(config["system_disk_size"].(int)),
+	}
+	for _, v := range config["data_disk"].([]interface{}) {
+		value := v.(map[string]interface{})
+		request.DataDisks = append(request.DataDisks, &cvm.DataDisk{
+			DiskType: helper.String(value["disk_type"].(string)),
+			DiskSize: helper.IntInt64(value["disk_size"].(int)),
+		})
+	}
+
+	request.InternetAccessible = &cvm.InternetAccessible{
+		InternetChargeType:      helper.String(config["internet_charge_type"].(string)),
+		InternetMaxBandwidthOut: helper.IntInt64(config["internet_max_bandwidth_out"].(int)),
+		PublicIpAssigned:        helper.Bool(config["public_ip_assigned"].(bool)),
+	}
+	if v := config["bandwidth_package_id"].(string); v != "" {
+		request.InternetAccessible.BandwidthPackageId = helper.String(v)
+	}
+
+	period := config["instance_charge_type_prepaid_period"].(int)
+	id := fmt.Sprintf("%s#%d", request.ToJsonString(), period)
+	cvmService := CvmService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	items, err := inquiryCvmPrices(ctx, cvmService, request, period)
+	if err != nil {
+		return err
+	}
+
+	return setPrices(d, id, items)
+}
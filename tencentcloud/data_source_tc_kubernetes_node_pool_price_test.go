@@ -0,0 +1,53 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudKubernetesNodePoolPriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodePoolPriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_kubernetes_node_pool_price.price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kubernetes_node_pool_price.price", "prepaid_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kubernetes_node_pool_price.price", "postpaid_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_kubernetes_node_pool_price.price", "price_list.0.item", "instance"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKubernetesNodePoolPriceDataSource = `
+data "tencentcloud_instance_types" "default" {
+  availability_zone = "` + defaultAZone + `"
+  cpu_core_count    = 2
+  memory_size       = 4
+  exclude_sold_out  = true
+}
+
+data "tencentcloud_kubernetes_node_pool_price" "price" {
+  availability_zone = "` + defaultAZone + `"
+  image_id          = "` + defaultTkeOSImageId + `"
+  desired_capacity  = 2
+
+  auto_scaling_config {
+    instance_type    = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+    system_disk_type = "CLOUD_PREMIUM"
+    system_disk_size = 50
+
+    data_disk {
+      disk_type = "CLOUD_PREMIUM"
+      disk_size = 50
+    }
+  }
+}
+`
@@ -24,7 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentMonitorPolicyGroups() *schema.Resource {
@@ -344,7 +343,6 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 			break
 		}
 		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupList(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -24,7 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentMonitorProductEvent() *schema.Resource {
@@ -400,7 +399,6 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 		}
 
 		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if response, err = monitorService.client.UseMonitorClient().DescribeProductEventList(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -0,0 +1,169 @@
+/*
+Use this data source to inquire the price of MySQL instances before creating them, both the prepaid and the postpaid prices are returned.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mysql_instance_price" "price" {
+  availability_zone = "ap-guangzhou-3"
+  mem_size          = 4000
+  cpu               = 2
+  volume_size       = 200
+  prepaid_period    = 12
+}
+
+output "prepaid_price" {
+  value = data.tencentcloud_mysql_instance_price.price.prepaid_price
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+var mysqlPricePayType = map[string]string{
+	PRICE_CHARGE_TYPE_PREPAID:  "PRE_PAID",
+	PRICE_CHARGE_TYPE_POSTPAID: "HOUR_PAID",
+}
+
+func dataSourceTencentCloudMysqlInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlInstancePriceRead,
+
+		Schema: priceSchema(map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Indicates which availability zone will be used.",
+			},
+			"mem_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size (in MB).",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "CPU cores. It's completed by `mem_size` if it's not set.",
+			},
+			"volume_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size (in GB).",
+			},
+			"device_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specify device type, available values: `UNIVERSAL` (default), `EXCLUSIVE`, `BASIC_V2`.",
+			},
+			"slave_sync_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedIntValue([]int{0, 1, 2}),
+				Default:      0,
+				Description:  "Data replication mode. 0 - Async replication; 1 - Semisync replication; 2 - Strongsync replication.",
+			},
+			"second_slave_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Zone information about second slave instance, the instance of three nodes is priced if it's set.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+				Description:  "The number of instances to be priced. Default is `1`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(MYSQL_AVAILABLE_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid price. Default is `1`.",
+			},
+		}),
+	}
+}
+
+func dataSourceTencentCloudMysqlInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_mysql_instance_price.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := cdb.NewDescribeDBPriceRequest()
+	request.Zone = helper.String(d.Get("availability_zone").(string))
+	request.Memory = helper.IntInt64(d.Get("mem_size").(int))
+	request.Volume = helper.IntInt64(d.Get("volume_size").(int))
+	request.GoodsNum = helper.IntInt64(d.Get("instance_count").(int))
+	request.InstanceRole = helper.String("master")
+	request.ProtectMode = helper.IntInt64(d.Get("slave_sync_mode").(int))
+	if v, ok := d.GetOk("cpu"); ok {
+		request.Cpu = helper.IntInt64(v.(int))
+	}
+	if v, ok := d.GetOk("device_type"); ok {
+		request.DeviceType = helper.String(v.(string))
+	}
+	if _, ok := d.GetOk("second_slave_zone"); ok {
+		request.InstanceNodes = helper.IntInt64(3)
+	}
+	period := d.Get("prepaid_period").(int)
+	id := fmt.Sprintf("%s#%d", request.ToJsonString(), period)
+
+	mysqlService := MysqlService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	items := make([]priceItem, 0, 2)
+	for _, chargeType := range []string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID} {
+		request.PayType = helper.String(mysqlPricePayType[chargeType])
+		request.Period = nil
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.Period = helper.IntInt64(period)
+		}
+
+		var price *cdb.DescribeDBPriceResponseParams
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := mysqlService.DescribeDBPrice(ctx, request)
+			if e != nil {
+				return retryError(e)
+			}
+			price = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if price == nil || price.Price == nil {
+			log.Printf("[WARN]%s api[%s] returns no price of pay type %s", logId, request.GetAction(), *request.PayType)
+			continue
+		}
+
+		// the prices of MySQL are in cents
+		item := priceItem{
+			chargeType:    chargeType,
+			item:          PRICE_ITEM_INSTANCE,
+			discountPrice: float64(*price.Price) / 100,
+			originalPrice: float64(*price.Price) / 100,
+		}
+		if price.OriginalPrice != nil {
+			item.originalPrice = float64(*price.OriginalPrice) / 100
+		}
+		if chargeType == PRICE_CHARGE_TYPE_POSTPAID {
+			item.chargeUnit = PRICE_CHARGE_UNIT_HOUR
+		}
+		item.discount = discountOf(item.originalPrice, item.discountPrice)
+		items = append(items, item)
+	}
+
+	return setPrices(d, id, items)
+}
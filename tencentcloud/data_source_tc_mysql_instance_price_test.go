@@ -0,0 +1,39 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudMysqlInstancePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlInstancePriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_instance_price.price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_instance_price.price", "prepaid_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_instance_price.price", "postpaid_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_instance_price.price", "price_list.#", "2"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_instance_price.price", "price_list.0.charge_type", "PREPAID"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_instance_price.price", "price_list.1.charge_type", "POSTPAID_BY_HOUR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMysqlInstancePriceDataSource = `
+data "tencentcloud_mysql_instance_price" "price" {
+  availability_zone = "` + defaultAZone + `"
+  mem_size          = 1000
+  cpu               = 1
+  volume_size       = 50
+  prepaid_period    = 1
+}
+`
@@ -99,12 +99,12 @@ func TencentMysqlZoneConfig() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Elem:        &schema.Schema{Type: schema.TypeInt},
 			Computed:    true,
-			Description: "",
+			Description: "Supported billing modes. `0` - Monthly subscription; `1` - Pay as you go.",
 		},
 		"hour_instance_sale_max_num": {
 			Type:        schema.TypeInt,
 			Computed:    true,
-			Description: "",
+			Description: "Maximum number of pay as you go instances which can be sold in the zone.",
 		},
 		"support_slave_sync_modes": {
 			Type:        schema.TypeList,
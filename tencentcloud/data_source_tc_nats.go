@@ -123,6 +123,11 @@ func dataSourceTencentCloudNats() *schema.Resource {
 					},
 				},
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -214,5 +219,12 @@ func dataSourceTencentCloudNatsRead(d *schema.ResourceData, meta interface{}) er
 		return e
 	}
 
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if e := writeToFile(output.(string), natList); e != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), e.Error())
+			return e
+		}
+	}
 	return nil
 }
@@ -0,0 +1,151 @@
+/*
+Use this data source to inquire the price of Redis instances before creating them, both the prepaid and the postpaid prices are returned.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_instance_price" "price" {
+  availability_zone  = "ap-guangzhou-3"
+  type_id            = 7
+  mem_size           = 4096
+  redis_shard_num    = 3
+  redis_replicas_num = 1
+  prepaid_period     = 12
+}
+
+output "postpaid_price" {
+  value = data.tencentcloud_redis_instance_price.price.postpaid_price
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudRedisInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudRedisInstancePriceRead,
+
+		Schema: priceSchema(map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The available zone of the instance, please refer to `tencentcloud_redis_zone_config.list`.",
+			},
+			"type_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerMin(2),
+				Description:  "Instance type. Available values reference data source `tencentcloud_redis_zone_config` or [document](https://intl.cloud.tencent.com/document/product/239/32069).",
+			},
+			"mem_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The memory volume of an available instance(in MB), please refer to `tencentcloud_redis_zone_config.list[zone].shard_memories`. When redis is standard type, it represents total memory size of the instance; when Redis is cluster type, it represents memory size of per sharding.",
+			},
+			"redis_shard_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of instance shard. This is not required for standalone and master slave versions.",
+			},
+			"redis_replicas_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The number of instance copies. This is not required for standalone and master slave versions.",
+			},
+			"replicas_read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether copy read-only is supported, Redis 2.8 Standard Edition and CKV Standard Edition do not support replica read-only.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+				Description:  "The number of instances to be priced. Default is `1`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedIntValue(REDIS_PREPAID_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid price. Valid values are `1`, `2`, `3`, `4`, `5`, `6`, `7`, `8`, `9`, `10`, `11`, `12`, `24`, `36`. Default is `1`.",
+			},
+		}),
+	}
+}
+
+func dataSourceTencentCloudRedisInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_redis_instance_price.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := redis.NewInquiryPriceCreateInstanceRequest()
+	request.ZoneName = helper.String(d.Get("availability_zone").(string))
+	request.TypeId = helper.IntUint64(d.Get("type_id").(int))
+	request.MemSize = helper.IntUint64(d.Get("mem_size").(int))
+	request.GoodsNum = helper.IntUint64(d.Get("instance_count").(int))
+	request.RedisReplicasNum = helper.IntInt64(d.Get("redis_replicas_num").(int))
+	if v, ok := d.GetOk("redis_shard_num"); ok {
+		request.RedisShardNum = helper.IntInt64(v.(int))
+	}
+	if v, ok := d.GetOkExists("replicas_read_only"); ok {
+		request.ReplicasReadonly = helper.Bool(v.(bool))
+	}
+	period := d.Get("prepaid_period").(int)
+	id := fmt.Sprintf("%s#%d", request.ToJsonString(), period)
+
+	redisService := RedisService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	items := make([]priceItem, 0, 2)
+	for _, chargeType := range []string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID} {
+		// the postpaid instances are priced with the period 1
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.BillingMode = helper.Int64(REDIS_CHARGE_TYPE_ID[REDIS_CHARGE_TYPE_PREPAID])
+			request.Period = helper.IntUint64(period)
+		} else {
+			request.BillingMode = helper.Int64(REDIS_CHARGE_TYPE_ID[REDIS_CHARGE_TYPE_POSTPAID])
+			request.Period = helper.IntUint64(1)
+		}
+
+		var price float64
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := redisService.InquiryPriceCreateInstance(ctx, request)
+			if e != nil {
+				return retryError(e)
+			}
+			price = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// the price of Redis is the discounted one in cents
+		item := priceItem{
+			chargeType:    chargeType,
+			item:          PRICE_ITEM_INSTANCE,
+			originalPrice: price / 100,
+			discountPrice: price / 100,
+			discount:      100,
+		}
+		if chargeType == PRICE_CHARGE_TYPE_POSTPAID {
+			item.chargeUnit = PRICE_CHARGE_UNIT_HOUR
+		}
+		items = append(items, item)
+	}
+
+	return setPrices(d, id, items)
+}
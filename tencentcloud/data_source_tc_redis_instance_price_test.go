@@ -0,0 +1,38 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudRedisInstancePriceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstancePriceDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_redis_instance_price.price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_instance_price.price", "prepaid_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_instance_price.price", "postpaid_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_instance_price.price", "price_list.#", "2"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_instance_price.price", "price_list.1.charge_unit", "HOUR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRedisInstancePriceDataSource = `
+data "tencentcloud_redis_instance_price" "price" {
+  availability_zone  = "` + defaultAZone + `"
+  type_id            = 8
+  mem_size           = 1024
+  redis_replicas_num = 1
+  prepaid_period     = 1
+}
+`
@@ -100,6 +100,11 @@ func dataSourceTencentCloudRouteTable() *schema.Resource {
 				Computed:    true,
 				Description: "Creation time of routing table.",
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -169,5 +174,18 @@ func dataSourceTencentCloudRouteTableRead(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"route_table_id": routetable.routeTableId,
+			"name":           routetable.name,
+			"vpc_id":         routetable.vpcId,
+			"subnet_num":     len(routetable.entryInfos),
+			"routes":         routes,
+			"create_time":    routetable.createTime,
+		}); e != nil {
+			return e
+		}
+	}
 	return nil
 }
@@ -61,6 +61,11 @@ func dataSourceTencentCloudSecurityGroup() *schema.Resource {
 				Computed:    true,
 				Description: "Project ID of the security group.",
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -116,5 +121,18 @@ func dataSourceTencentCloudSecurityGroupRead(d *schema.ResourceData, meta interf
 
 	_ = d.Set("project_id", projectId)
 
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"security_group_id":  sg.SecurityGroupId,
+			"name":               sg.SecurityGroupName,
+			"description":        sg.SecurityGroupDesc,
+			"create_time":        sg.CreatedTime,
+			"be_associate_count": len(in) + len(out),
+			"project_id":         projectId,
+		}); e != nil {
+			return e
+		}
+	}
 	return nil
 }
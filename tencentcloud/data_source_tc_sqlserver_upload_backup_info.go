@@ -58,11 +58,13 @@ func dataSourceTencentCloudSqlserverUploadBackupInfo() *schema.Resource {
 			"tmp_secret_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary key (Key).",
 			},
 			"x_cos_security_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary key (Token).",
 			},
 			"start_time": {
@@ -71,11 +71,13 @@ func dataSourceTencentCloudSsmSecretVersions() *schema.Resource {
 						"secret_binary": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "The base64-encoded binary secret.",
 						},
 						"secret_string": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "The string text of secret.",
 						},
 					},
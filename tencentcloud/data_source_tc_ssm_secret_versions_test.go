@@ -12,8 +12,8 @@ func TestAccTencentCloudSsmSecretVersionsDataSource(t *testing.T) {
 	dataSourceName := "data.tencentcloud_ssm_secret_versions.example"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: TestAccTencentCloudSsmSecretVersionsDataSourceConfig,
@@ -76,6 +76,11 @@ func dataSourceTencentCloudSubnet() *schema.Resource {
 				Computed:    true,
 				Description: "The Route Table ID.",
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -106,5 +111,18 @@ func dataSourceTencentCloudSubnetRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("route_table_id", subnet.routeTableId)
 	_ = d.Set("availability_zone", subnet.zone)
 
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"vpc_id":            vpcId,
+			"subnet_id":         subnet.subnetId,
+			"cidr_block":        subnet.cidr,
+			"availability_zone": subnet.zone,
+			"name":              subnet.name,
+			"route_table_id":    subnet.routeTableId,
+		}); e != nil {
+			return e
+		}
+	}
 	return nil
 }
@@ -0,0 +1,384 @@
+/*
+Use this data source to discover the resources by their tags. The resources are queried by the Tag DescribeResourcesByTags API if `tag_filters` is set, which filters the service type, resource type and region, otherwise by the Tag GetResources API. Only the resources bound with tags are known by the Tag APIs, so the resources without any tag are never returned.
+
+Example Usage
+
+Query the CVM instances of a tag
+
+```hcl
+data "tencentcloud_tag_resources" "instances" {
+  service_type  = "cvm"
+  resource_type = "instance"
+
+  tag_filters {
+    tag_key    = "team"
+    tag_values = ["finops", "infra"]
+  }
+}
+
+output "instance_ids" {
+  value = data.tencentcloud_tag_resources.instances.resource_list.*.resource_id
+}
+```
+
+Audit the tagged resources without the `owner` tag
+
+```hcl
+data "tencentcloud_tag_resources" "untagged" {
+  resource_prefixes = ["qcs::cvm:ap-guangzhou:uin/:instance/", "qcs::cbs:ap-guangzhou:uin/:disk/"]
+  exclude_tag_keys  = ["owner"]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudTagResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudTagResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"tag_filters": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				MaxItems:    5,
+				Description: "Tag filters, the resources bound with all of the tags are returned. Up to 5 filters are supported.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Tag key.",
+						},
+						"tag_values": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    10,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag values, the resources bound with any of them are matched. The resources bound with the key are matched if it's not set.",
+						},
+					},
+				},
+			},
+
+			"resource_names": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				MaxItems:    9,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Six-segment names of the resources to query, e.g. `qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxxxx`. Up to 9 names are supported, and the pagination is ignored by the API if it's set.",
+			},
+
+			"resource_prefixes": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes of the six-segment names of the resources, the resources matching any of them are returned. The empty segments of the prefix match any value, and the account may be left without uin as the names built by the provider, e.g. `qcs::cvm::uin/:instance/` matches the CVM instances of all regions.",
+			},
+
+			"service_type": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Service type of the resources, e.g. `cvm`, `cbs` and `vpc`.",
+			},
+
+			"resource_type": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Resource type of the resources, which is the segment before the resource ID, e.g. `instance` and `disk`.",
+			},
+
+			"resource_region": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Region of the resources, e.g. `ap-guangzhou`.",
+			},
+
+			"exclude_tag_keys": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tag keys the resources must not be bound with, which is used to audit the tagged resources missing the tags. The resources without any tag are not returned by the Tag APIs.",
+			},
+
+			"page_size": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Default:      200,
+				ValidateFunc: validateIntegerInRange(1, 200),
+				Description:  "The number of resources queried per request, all the pages are queried. Default is `200`.",
+			},
+
+			"resource_list": {
+				Computed:    true,
+				Type:        schema.TypeList,
+				Description: "List of the resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Six-segment name of the resource. The account is `uin/` without the uin if `tag_filters` is set and `resource_names` is not, as the names built by the provider.",
+						},
+						"service_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service type of the resource.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the resource.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type of the resource.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the resource.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Tags bound with the resource.",
+						},
+					},
+				},
+			},
+
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudTagResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_tag_resources.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	paramMap := make(map[string]interface{})
+	if v, ok := d.GetOk("tag_filters"); ok {
+		tagFilters := make([]*tag.TagFilter, 0, len(v.([]interface{})))
+		for _, item := range v.([]interface{}) {
+			filterMap := item.(map[string]interface{})
+			tagFilter := tag.TagFilter{
+				TagKey: helper.String(filterMap["tag_key"].(string)),
+			}
+			for _, value := range filterMap["tag_values"].([]interface{}) {
+				tagFilter.TagValue = append(tagFilter.TagValue, helper.String(value.(string)))
+			}
+			tagFilters = append(tagFilters, &tagFilter)
+		}
+		paramMap["TagFilters"] = tagFilters
+	}
+
+	prefixes := helper.InterfacesStrings(d.Get("resource_prefixes").([]interface{}))
+	excludeTagKeys := helper.InterfacesStrings(d.Get("exclude_tag_keys").([]interface{}))
+	serviceType := d.Get("service_type").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceRegion := d.Get("resource_region").(string)
+
+	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var resources []*tag.ResourceTagMapping
+	var err error
+	if _, ok := paramMap["TagFilters"]; ok && len(d.Get("resource_names").([]interface{})) == 0 {
+		// the service, resource type and region are filtered by DescribeResourcesByTags, which requires the tag filters
+		resources, err = describeTagResourcesByScopes(ctx, service, paramMap, tagResourceScopes(serviceType, resourceType, resourceRegion, prefixes), d.Get("page_size").(int))
+	} else {
+		if v, ok := d.GetOk("resource_names"); ok {
+			paramMap["ResourceList"] = helper.InterfacesStringsPoint(v.([]interface{}))
+		}
+		paramMap["MaxResults"] = helper.IntUint64(d.Get("page_size").(int))
+		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeTagResourcesByFilter(ctx, paramMap)
+			if e != nil {
+				return retryError(e)
+			}
+			resources = result
+			return nil
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(resources))
+	tmpList := make([]map[string]interface{}, 0, len(resources))
+	for _, item := range resources {
+		if item.Resource == nil {
+			continue
+		}
+		name, e := ParseTagResourceName(*item.Resource)
+		if e != nil {
+			log.Printf("[WARN]%s skip the resource: %s", logId, e.Error())
+			continue
+		}
+		// GetResources has no filters but the tags, and the prefixes are matched segment by segment
+		if serviceType != "" && name.ServiceType != serviceType ||
+			resourceType != "" && name.ResourceType != resourceType ||
+			resourceRegion != "" && name.Region != resourceRegion {
+			continue
+		}
+		if len(prefixes) > 0 && !matchAnyTagResourceName(prefixes, *item.Resource) {
+			continue
+		}
+
+		tags := make(map[string]interface{}, len(item.Tags))
+		for _, v := range item.Tags {
+			if v.TagKey != nil {
+				tags[*v.TagKey] = helper.PString(v.TagValue)
+			}
+		}
+		excluded := false
+		for _, key := range excludeTagKeys {
+			if _, ok := tags[key]; ok {
+				excluded = true
+				break
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		ids = append(ids, *item.Resource)
+		tmpList = append(tmpList, map[string]interface{}{
+			"resource_name": *item.Resource,
+			"service_type":  name.ServiceType,
+			"region":        name.Region,
+			"resource_type": name.ResourceType,
+			"resource_id":   name.ResourceId,
+			"tags":          tags,
+		})
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err = d.Set("resource_list", tmpList); err != nil {
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), tmpList); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// tagResourceScope is the service type, resource type and region filtered by DescribeResourcesByTags
+type tagResourceScope struct {
+	serviceType  string
+	resourceType string
+	region       string
+}
+
+// tagResourceScopes returns the scopes to query, one for each of the six-segment prefixes. The prefixes conflicting
+// with the service type, resource type or region are left out, as they match no resource.
+func tagResourceScopes(serviceType, resourceType, region string, prefixes []string) []tagResourceScope {
+	scope := tagResourceScope{serviceType: serviceType, resourceType: resourceType, region: region}
+	if len(prefixes) == 0 {
+		return []tagResourceScope{scope}
+	}
+
+	scopes := make([]tagResourceScope, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		name, err := ParseTagResourceName(prefix)
+		if err != nil {
+			// the prefix which is not six-segment is only matched by the names
+			return []tagResourceScope{scope}
+		}
+		prefixScope, ok := scope, true
+		prefixScope.serviceType, ok = mergeTagResourceSegment(prefixScope.serviceType, name.ServiceType, ok)
+		prefixScope.resourceType, ok = mergeTagResourceSegment(prefixScope.resourceType, name.ResourceType, ok)
+		prefixScope.region, ok = mergeTagResourceSegment(prefixScope.region, name.Region, ok)
+		if ok && !IsContains(scopes, prefixScope) {
+			scopes = append(scopes, prefixScope)
+		}
+	}
+	return scopes
+}
+
+// mergeTagResourceSegment returns the segment of the prefix if value is empty, and false if they conflict or ok is false
+func mergeTagResourceSegment(value, segment string, ok bool) (string, bool) {
+	if segment == "" {
+		return value, ok
+	}
+	if value != "" && value != segment {
+		return value, false
+	}
+	return segment, ok
+}
+
+// describeTagResourcesByScopes queries the resources bound with the tags of paramMap in each of scopes, the
+// resources are named by BuildTagResourceName and returned once.
+func describeTagResourcesByScopes(ctx context.Context, service TagService, paramMap map[string]interface{}, scopes []tagResourceScope, pageSize int) (resources []*tag.ResourceTagMapping, errRet error) {
+	names := make(map[string]bool)
+	for _, scope := range scopes {
+		param := map[string]interface{}{
+			"TagFilters": paramMap["TagFilters"],
+			"Limit":      helper.IntUint64(pageSize),
+		}
+		if scope.serviceType != "" {
+			param["ServiceType"] = helper.String(scope.serviceType)
+		}
+		if scope.resourceType != "" {
+			param["ResourcePrefix"] = helper.String(scope.resourceType)
+		}
+		if scope.region != "" {
+			param["ResourceRegion"] = helper.String(scope.region)
+		}
+
+		var rows []*tag.ResourceTag
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeResourcesByTagsByFilter(ctx, param)
+			if e != nil {
+				return retryError(e)
+			}
+			rows = result
+			return nil
+		})
+		if err != nil {
+			errRet = err
+			return
+		}
+
+		for _, row := range rows {
+			name := BuildTagResourceName(helper.PString(row.ServiceType), helper.PString(row.ResourcePrefix),
+				helper.PString(row.ResourceRegion), helper.PString(row.ResourceId))
+			if names[name] {
+				continue
+			}
+			names[name] = true
+			resources = append(resources, &tag.ResourceTagMapping{Resource: helper.String(name), Tags: row.Tags})
+		}
+	}
+	return
+}
+
+func matchAnyTagResourceName(prefixes []string, name string) bool {
+	for _, prefix := range prefixes {
+		if MatchTagResourceName(prefix, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func TestUnitTagResourcesDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	r := Provider().DataSourcesMap["tencentcloud_tag_resources"]
+
+	// the resources bound with the tags are filtered by the API, and returned a page of one resource per request
+	rows := []*tag.ResourceTag{
+		{
+			ServiceType: helper.String("cvm"), ResourcePrefix: helper.String("instance"), ResourceRegion: helper.String("ap-guangzhou"), ResourceId: helper.String("ins-00000001"),
+			Tags: []*tag.Tag{{TagKey: helper.String("team"), TagValue: helper.String("finops")}},
+		},
+		{
+			ServiceType: helper.String("cvm"), ResourcePrefix: helper.String("instance"), ResourceRegion: helper.String("ap-shanghai"), ResourceId: helper.String("ins-00000002"),
+			Tags: []*tag.Tag{{TagKey: helper.String("team"), TagValue: helper.String("finops")}},
+		},
+		{
+			ServiceType: helper.String("cbs"), ResourcePrefix: helper.String("disk"), ResourceRegion: helper.String("ap-guangzhou"), ResourceId: helper.String("disk-00000001"),
+			Tags: []*tag.Tag{
+				{TagKey: helper.String("team"), TagValue: helper.String("finops")},
+				{TagKey: helper.String("owner"), TagValue: helper.String("alice")},
+			},
+		},
+	}
+	var scopes []string
+	server.Handle("tag", "DescribeResourcesByTags", func(request *fakeapi.Request) (interface{}, error) {
+		var params tag.DescribeResourcesByTagsRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		assert.Equal(t, "team", *params.TagFilters[0].TagKey)
+		assert.Equal(t, uint64(1), *params.Limit)
+		if *params.Offset == 0 {
+			scopes = append(scopes, helper.PString(params.ServiceType)+"/"+helper.PString(params.ResourcePrefix)+"/"+helper.PString(params.ResourceRegion))
+		}
+		matched := make([]*tag.ResourceTag, 0)
+		for _, row := range rows {
+			if params.ServiceType != nil && *params.ServiceType != *row.ServiceType ||
+				params.ResourcePrefix != nil && *params.ResourcePrefix != *row.ResourcePrefix ||
+				params.ResourceRegion != nil && *params.ResourceRegion != *row.ResourceRegion {
+				continue
+			}
+			matched = append(matched, row)
+		}
+		response := &tag.DescribeResourcesByTagsResponseParams{TotalCount: helper.IntUint64(len(matched)), Rows: []*tag.ResourceTag{}}
+		if int(*params.Offset) < len(matched) {
+			response.Rows = matched[*params.Offset : *params.Offset+1]
+		}
+		return response, nil
+	})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tag_filters": []interface{}{
+			map[string]interface{}{"tag_key": "team", "tag_values": []interface{}{"finops"}},
+		},
+		"page_size": 1,
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.Equal(t, 3, server.Calls("tag", "DescribeResourcesByTags"))
+	assert.Equal(t, 3, d.Get("resource_list.#"))
+	assert.Equal(t, BuildTagResourceName("cbs", "disk", "ap-guangzhou", "disk-00000001"), d.Get("resource_list.2.resource_name"))
+	assert.Equal(t, "cbs", d.Get("resource_list.2.service_type"))
+	assert.Equal(t, "ap-guangzhou", d.Get("resource_list.2.region"))
+	assert.Equal(t, "disk", d.Get("resource_list.2.resource_type"))
+	assert.Equal(t, "disk-00000001", d.Get("resource_list.2.resource_id"))
+	assert.Equal(t, "alice", d.Get("resource_list.2.tags.owner"))
+
+	// each prefix is queried in its own scope, the ones conflicting with the region are left out
+	scopes = nil
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tag_filters": []interface{}{
+			map[string]interface{}{"tag_key": "team"},
+		},
+		"resource_prefixes": []interface{}{
+			BuildTagResourceName("cvm", "instance", "", ""),
+			"qcs::cbs:ap-guangzhou:uin/100000000001:disk/",
+			BuildTagResourceName("cvm", "instance", "ap-shanghai", ""),
+		},
+		"resource_region":  "ap-guangzhou",
+		"exclude_tag_keys": []interface{}{"owner"},
+		"page_size":        1,
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.Equal(t, []string{"cvm/instance/ap-guangzhou", "cbs/disk/ap-guangzhou"}, scopes)
+	assert.Equal(t, 1, d.Get("resource_list.#"))
+	assert.Equal(t, "ins-00000001", d.Get("resource_list.0.resource_id"))
+
+	server.InjectFault("tag", "DescribeResourcesByTags", fakeapi.Fault{Code: "InvalidParameter.ReservedTagKey", Times: 1})
+	assert.NotNil(t, r.Read(d, meta))
+
+	// the resources are queried by GetResources without the tag filters
+	server.Handle("tag", "GetResources", func(request *fakeapi.Request) (interface{}, error) {
+		var params tag.GetResourcesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		assert.Empty(t, params.TagFilters)
+		return &tag.GetResourcesResponseParams{ResourceTagMappingList: []*tag.ResourceTagMapping{
+			{
+				Resource: helper.String("qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-00000001"),
+				Tags:     []*tag.Tag{{TagKey: helper.String("team"), TagValue: helper.String("finops")}},
+			},
+			{
+				Resource: helper.String("qcs::cbs:ap-guangzhou:uin/100000000001:disk/disk-00000001"),
+				Tags:     []*tag.Tag{{TagKey: helper.String("owner"), TagValue: helper.String("alice")}},
+			},
+		}}, nil
+	})
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_prefixes": []interface{}{BuildTagResourceName("cvm", "instance", "", ""), BuildTagResourceName("cbs", "disk", "", "")},
+		"exclude_tag_keys":  []interface{}{"owner"},
+	})
+	assert.Nil(t, r.Read(d, meta))
+	assert.Equal(t, 1, server.Calls("tag", "GetResources"))
+	assert.Equal(t, 1, d.Get("resource_list.#"))
+	assert.Equal(t, "qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-00000001", d.Get("resource_list.0.resource_name"))
+}
+
+func TestAccTencentCloudTagResourcesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourcesDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_tag_resources.resources"),
+					resource.TestCheckResourceAttr("data.tencentcloud_tag_resources.resources", "resource_list.#", "1"),
+					resource.TestCheckResourceAttr("data.tencentcloud_tag_resources.resources", "resource_list.0.service_type", "vpc"),
+					resource.TestCheckResourceAttr("data.tencentcloud_tag_resources.resources", "resource_list.0.resource_type", "vpc"),
+					resource.TestCheckResourceAttrPair("data.tencentcloud_tag_resources.resources", "resource_list.0.resource_id", "tencentcloud_vpc.vpc", "id"),
+					resource.TestCheckResourceAttr("data.tencentcloud_tag_resources.resources", "resource_list.0.tags.test", "tag_resources"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTagResourcesDataSource = `
+resource "tencentcloud_vpc" "vpc" {
+  name       = "tf-tag-resources-test"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    "test" = "tag_resources"
+  }
+}
+
+data "tencentcloud_tag_resources" "resources" {
+  service_type  = "vpc"
+  resource_type = "vpc"
+
+  tag_filters {
+    tag_key    = "test"
+    tag_values = [tencentcloud_vpc.vpc.tags.test]
+  }
+
+  resource_prefixes = ["qcs::vpc::uin/:vpc/${tencentcloud_vpc.vpc.id}"]
+}
+`
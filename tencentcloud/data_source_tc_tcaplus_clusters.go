@@ -79,6 +79,7 @@ func dataSourceTencentCloudTcaplusClusters() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "Access password of the TcaplusDB cluster.",
 						},
 						"network_type": {
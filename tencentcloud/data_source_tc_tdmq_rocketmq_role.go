@@ -63,6 +63,7 @@ func dataSourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 						"token": {
 							Type:        schema.TypeString,
 							Computed:    true,
+							Sensitive:   true,
 							Description: "Value of the role token.",
 						},
 						"remark": {
@@ -162,6 +162,7 @@ func dataSourceTencentCloudTsfDeliveryConfigs() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "Password. Note: This field may return null, which means that no valid value was obtained.",
 									},
 									"kafka_infos": {
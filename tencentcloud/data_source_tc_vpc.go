@@ -64,6 +64,11 @@ func dataSourceTencentCloudVpc() *schema.Resource {
 				Computed:    true,
 				Description: "Whether or not the VPC has Multicast support.",
 			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
 		},
 	}
 }
@@ -108,5 +113,17 @@ func dataSourceTencentCloudVpcRead(d *schema.ResourceData, meta interface{}) err
 	_ = d.Set("is_default", vpc.isDefault)
 	_ = d.Set("is_multicast", vpc.isMulticast)
 
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"id":           vpc.vpcId,
+			"name":         vpc.name,
+			"cidr_block":   vpc.cidr,
+			"is_default":   vpc.isDefault,
+			"is_multicast": vpc.isMulticast,
+		}); e != nil {
+			return e
+		}
+	}
 	return nil
 }
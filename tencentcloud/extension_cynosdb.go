@@ -215,6 +215,11 @@ func TencentCynosdbClusterBaseInfo() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags of the CynosDB cluster.",
 		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+		},
 		// Computed
 		"charset": {
 			Type:        schema.TypeString,
@@ -155,6 +155,11 @@ func TencentMongodbBasicInfo() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags of the Mongodb. Key name `project` is system reserved and can't be used.",
 		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+		},
 		"mongos_cpu": {
 			Type:        schema.TypeInt,
 			Optional:    true,
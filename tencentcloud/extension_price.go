@@ -0,0 +1,231 @@
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	PRICE_CHARGE_TYPE_PREPAID  = "PREPAID"
+	PRICE_CHARGE_TYPE_POSTPAID = "POSTPAID_BY_HOUR"
+
+	PRICE_CHARGE_UNIT_HOUR = "HOUR"
+
+	PRICE_ITEM_INSTANCE  = "instance"
+	PRICE_ITEM_BANDWIDTH = "bandwidth"
+	PRICE_ITEM_DISK      = "disk"
+)
+
+// cvmPriceUnsupportedErrorCodes are returned by the inquiries of the charge types which are not sold for the
+// instances or the account, the prices of those charge types are left out instead of failing the inquiry.
+var cvmPriceUnsupportedErrorCodes = []string{
+	"InvalidInstanceNotSupportedPrepaidInstance",
+	"UnsupportedOperation.InstanceChargeType",
+	"UnsupportedOperation.OnlyForPrepaidAccount",
+}
+
+// priceItem is an item of the price breakdown returned by the price data sources. The prepaid price covers
+// the whole prepaid period, and the postpaid price is the unit price charged by chargeUnit.
+type priceItem struct {
+	chargeType    string
+	item          string
+	originalPrice float64
+	discountPrice float64
+	discount      float64
+	chargeUnit    string
+}
+
+// priceSchema adds the price attributes shared by the price data sources to s
+func priceSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["result_output_file"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Used to save results.",
+	}
+	s["prepaid_price"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The discounted price of the whole prepaid period, which is the sum of the `PREPAID` items of `price_list` without `charge_unit`. The items charged by traffic are listed in `price_list` only.",
+	}
+	s["postpaid_price"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The discounted price of an hour, which is the sum of the `POSTPAID_BY_HOUR` items of `price_list` charged by `HOUR`. The items charged by traffic are listed in `price_list` only.",
+	}
+	s["price_list"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The price breakdown of both the prepaid and the postpaid charge type. Each element contains the following attributes:",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"charge_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The charge type of the price, `PREPAID` or `POSTPAID_BY_HOUR`.",
+				},
+				"item": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The item priced, e.g. `instance`, `bandwidth` and `disk`.",
+				},
+				"original_price": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The original price. It's the unit price of `charge_unit` if `charge_unit` is set, otherwise the price of the whole prepaid period.",
+				},
+				"discount_price": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The discounted price, in the same unit as `original_price`.",
+				},
+				"discount": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The discount in percent of the original price, e.g. `80` means paying 80% of the original price.",
+				},
+				"charge_unit": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The unit of the price, e.g. `HOUR` and `GB`. It's empty for the price of the whole prepaid period.",
+				},
+			},
+		},
+	}
+	return s
+}
+
+// setPrices sets the price attributes of d by items, and saves them to `result_output_file` if it's set
+func setPrices(d *schema.ResourceData, id string, items []priceItem) error {
+	var prepaidPrice, postpaidPrice float64
+	priceList := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		switch {
+		case item.chargeType == PRICE_CHARGE_TYPE_PREPAID:
+			if item.chargeUnit == "" {
+				prepaidPrice += item.discountPrice
+			}
+		case item.chargeUnit == PRICE_CHARGE_UNIT_HOUR:
+			postpaidPrice += item.discountPrice
+		}
+		priceList = append(priceList, map[string]interface{}{
+			"charge_type":    item.chargeType,
+			"item":           item.item,
+			"original_price": item.originalPrice,
+			"discount_price": item.discountPrice,
+			"discount":       item.discount,
+			"charge_unit":    item.chargeUnit,
+		})
+	}
+
+	d.SetId(helper.DataResourceIdHash(id))
+	if err := d.Set("price_list", priceList); err != nil {
+		return err
+	}
+	_ = d.Set("prepaid_price", prepaidPrice)
+	_ = d.Set("postpaid_price", postpaidPrice)
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		return writeToFile(output.(string), priceList)
+	}
+	return nil
+}
+
+// inquiryCvmPrices inquires the prepaid price of period months and the postpaid price of the instances
+// launched by request. The prepaid bandwidth is priced as the postpaid one by hour for the postpaid instances.
+// The charge type not sold for the instances is left out, and the inquiry fails only if neither is sold.
+func inquiryCvmPrices(ctx context.Context, service CvmService, request *cvm.InquiryPriceRunInstancesRequest, period int) (items []priceItem, errRet error) {
+	logId := getLogId(ctx)
+	internetChargeType := ""
+	if request.InternetAccessible != nil && request.InternetAccessible.InternetChargeType != nil {
+		internetChargeType = *request.InternetAccessible.InternetChargeType
+	}
+
+	var unsupportedErr error
+	for _, chargeType := range []string{PRICE_CHARGE_TYPE_PREPAID, PRICE_CHARGE_TYPE_POSTPAID} {
+		request.InstanceChargeType = helper.String(chargeType)
+		request.InstanceChargePrepaid = nil
+		if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+			request.InstanceChargePrepaid = &cvm.InstanceChargePrepaid{Period: helper.IntInt64(period)}
+		} else if internetChargeType == CVM_INTERNET_CHARGE_TYPE_BANDWIDTH_PREPAID {
+			request.InternetAccessible.InternetChargeType = helper.String(CVM_INTERNET_CHARGE_TYPE_BANDWIDTH_POSTPAID)
+		}
+
+		var price *cvm.Price
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := service.InquiryPriceRunInstances(ctx, request)
+			if e != nil {
+				return retryError(e)
+			}
+			price = result
+			return nil
+		})
+		if err != nil {
+			if unsupportedErr == nil && isExpectError(errors.Cause(err), cvmPriceUnsupportedErrorCodes) {
+				log.Printf("[WARN]%s charge type %s is not sold, its price is left out, reason:%+v", logId, chargeType, err)
+				unsupportedErr = err
+				continue
+			}
+			errRet = err
+			return
+		}
+		if item, ok := cvmPriceItem(chargeType, PRICE_ITEM_INSTANCE, price.InstancePrice); ok {
+			items = append(items, item)
+		}
+		if item, ok := cvmPriceItem(chargeType, PRICE_ITEM_BANDWIDTH, price.BandwidthPrice); ok {
+			items = append(items, item)
+		}
+	}
+	return
+}
+
+// cvmPriceItem converts the CVM price of chargeType, it returns false if the price is not returned. The items
+// charged by traffic are priced by unit even for the prepaid instances.
+func cvmPriceItem(chargeType, name string, price *cvm.ItemPrice) (item priceItem, ok bool) {
+	if price == nil {
+		return
+	}
+	item = priceItem{chargeType: chargeType, item: name, discount: helper.PFloat64(price.Discount)}
+	switch {
+	case price.UnitPrice != nil || price.UnitPriceDiscount != nil:
+		item.originalPrice = helper.PFloat64(price.UnitPrice)
+		item.discountPrice = helper.PFloat64(price.UnitPriceDiscount)
+		item.chargeUnit = helper.PString(price.ChargeUnit)
+	case chargeType == PRICE_CHARGE_TYPE_PREPAID && (price.OriginalPrice != nil || price.DiscountPrice != nil):
+		item.originalPrice = helper.PFloat64(price.OriginalPrice)
+		item.discountPrice = helper.PFloat64(price.DiscountPrice)
+	default:
+		return
+	}
+	ok = true
+	return
+}
+
+// cbsPriceItem converts the CBS price of chargeType
+func cbsPriceItem(chargeType string, price *cbs.Price) priceItem {
+	item := priceItem{chargeType: chargeType, item: PRICE_ITEM_DISK}
+	if chargeType == PRICE_CHARGE_TYPE_PREPAID {
+		item.originalPrice = helper.PFloat64(price.OriginalPrice)
+		item.discountPrice = helper.PFloat64(price.DiscountPrice)
+	} else {
+		item.originalPrice = helper.PFloat64(price.UnitPrice)
+		item.discountPrice = helper.PFloat64(price.UnitPriceDiscount)
+		item.chargeUnit = helper.PString(price.ChargeUnit)
+	}
+	item.discount = discountOf(item.originalPrice, item.discountPrice)
+	return item
+}
+
+// discountOf returns the discount in percent of the original price, or 100 if the original price is 0
+func discountOf(originalPrice, discountPrice float64) float64 {
+	if originalPrice <= 0 {
+		return 100
+	}
+	return discountPrice * 100 / originalPrice
+}
@@ -0,0 +1,301 @@
+package fakeapi
+
+import (
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	diskStateUnattached = "UNATTACHED"
+	diskStateAttaching  = "ATTACHING"
+	diskStateAttached   = "ATTACHED"
+	diskStateDetaching  = "DETACHING"
+)
+
+type diskState struct {
+	*cbs.Disk
+	transition
+}
+
+// refresh updates the attributes of the disk changed with the state
+func (me *diskState) refresh() {
+	state := me.state
+	me.DiskState = helper.String(state)
+	me.Attached = helper.Bool(state == diskStateAttached || state == diskStateDetaching)
+	if state == diskStateUnattached {
+		me.InstanceId = helper.String("")
+		me.InstanceIdList = []*string{}
+	}
+}
+
+func (me *Server) registerCbs() {
+	me.handle("cbs", "CreateDisks", me.createDisks)
+	me.handle("cbs", "DescribeDisks", me.describeDisks)
+	me.handle("cbs", "AttachDisks", me.attachDisks)
+	me.handle("cbs", "DetachDisks", me.detachDisks)
+	me.handle("cbs", "TerminateDisks", me.terminateDisks)
+	me.handle("cbs", "InquiryPriceCreateDisks", me.inquiryPriceCreateDisks)
+}
+
+func (me *Server) createDisks(request *Request) (interface{}, error) {
+	var params cbs.CreateDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, errorf("MissingParameter", "placement zone is required")
+	}
+	if stringValue(params.DiskType) == "" || (params.DiskSize == nil && params.SnapshotId == nil) {
+		return nil, errorf("MissingParameter", "disk type and disk size are required")
+	}
+
+	count := uint64Value(params.DiskCount)
+	if count == 0 {
+		count = 1
+	}
+	chargeType := stringValue(params.DiskChargeType)
+	if chargeType == "" {
+		chargeType = "POSTPAID_BY_HOUR"
+	}
+	diskSize := uint64Value(params.DiskSize)
+	if diskSize == 0 {
+		diskSize = 10
+	}
+
+	diskIds := make([]*string, 0, count)
+	for i := uint64(0); i < count; i++ {
+		disk := me.addDisk(&cbs.Disk{
+			DiskName:              params.DiskName,
+			DiskType:              params.DiskType,
+			DiskSize:              helper.Uint64(diskSize),
+			DiskUsage:             helper.String("DATA_DISK"),
+			DiskChargeType:        helper.String(chargeType),
+			Placement:             params.Placement,
+			Portable:              helper.Bool(true),
+			Shareable:             helper.Bool(params.Shareable != nil && *params.Shareable),
+			Encrypt:               helper.Bool(stringValue(params.Encrypt) == "ENCRYPT"),
+			ThroughputPerformance: params.ThroughputPerformance,
+			Tags:                  params.Tags,
+		}, diskStateUnattached)
+		diskIds = append(diskIds, disk.DiskId)
+	}
+
+	return &cbs.CreateDisksResponseParams{DiskIdSet: diskIds}, nil
+}
+
+func (me *Server) describeDisks(request *Request) (interface{}, error) {
+	var params cbs.DescribeDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*cbs.Disk, 0)
+	for _, item := range me.disks {
+		if !matchIds(params.DiskIds, *item.DiskId) {
+			continue
+		}
+		item.observe()
+		item.refresh()
+
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "disk-id":
+				return []string{*item.DiskId}, true
+			case "disk-name":
+				return []string{stringValue(item.DiskName)}, true
+			case "disk-type":
+				return []string{stringValue(item.DiskType)}, true
+			case "disk-usage":
+				return []string{stringValue(item.DiskUsage)}, true
+			case "disk-charge-type":
+				return []string{stringValue(item.DiskChargeType)}, true
+			case "disk-state":
+				return []string{stringValue(item.DiskState)}, true
+			case "portable":
+				return []string{boolString(item.Portable)}, true
+			case "zone":
+				return []string{stringValue(item.Placement.Zone)}, true
+			case "instance-id":
+				return []string{stringValue(item.InstanceId)}, true
+			}
+			return tagAttribute(name, cbsTags(item.Tags))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item.Disk)
+		}
+	}
+
+	start, end, err := page(len(matched), int64(uint64Value(params.Offset)), int64(uint64Value(params.Limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cbs.DescribeDisksResponseParams{
+		TotalCount: helper.IntUint64(len(matched)),
+		DiskSet:    matched[start:end],
+	}, nil
+}
+
+func (me *Server) attachDisks(request *Request) (interface{}, error) {
+	var params cbs.AttachDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	instance := me.findInstance(stringValue(params.InstanceId))
+	if instance == nil {
+		return nil, errorf("InvalidInstanceId.NotFound", "instance %s is not found", stringValue(params.InstanceId))
+	}
+	disks, err := me.findDisks(params.DiskIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, disk := range disks {
+		if disk.state != diskStateUnattached {
+			return nil, errorf("InvalidDisk.Busy", "disk %s is %s", *disk.DiskId, disk.state)
+		}
+		if stringValue(disk.Placement.Zone) != stringValue(instance.Placement.Zone) {
+			return nil, errorf("ResourceUnavailable.ZoneNotMatch", "disk %s is not in the zone of instance %s", *disk.DiskId, *instance.InstanceId)
+		}
+	}
+
+	for _, disk := range disks {
+		disk.InstanceId = instance.InstanceId
+		disk.InstanceIdList = []*string{instance.InstanceId}
+		disk.DeleteWithInstance = helper.Bool(params.DeleteWithInstance != nil && *params.DeleteWithInstance)
+		disk.moveTo(diskStateAttaching, diskStateAttached, me.StateDelay)
+		disk.refresh()
+	}
+
+	return &cbs.AttachDisksResponseParams{}, nil
+}
+
+func (me *Server) detachDisks(request *Request) (interface{}, error) {
+	var params cbs.DetachDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	disks, err := me.findDisks(params.DiskIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, disk := range disks {
+		if disk.state != diskStateAttached {
+			return nil, errorf("InvalidDisk.Busy", "disk %s is %s", *disk.DiskId, disk.state)
+		}
+		if params.InstanceId != nil && stringValue(disk.InstanceId) != *params.InstanceId {
+			return nil, errorf("InvalidParameterValue", "disk %s is not attached to instance %s", *disk.DiskId, *params.InstanceId)
+		}
+		if stringValue(disk.DiskUsage) == "SYSTEM_DISK" {
+			return nil, errorf("UnsupportedOperation.StateError", "system disk %s can not be detached", *disk.DiskId)
+		}
+	}
+
+	for _, disk := range disks {
+		disk.moveTo(diskStateDetaching, diskStateUnattached, me.StateDelay)
+		disk.refresh()
+	}
+
+	return &cbs.DetachDisksResponseParams{}, nil
+}
+
+func (me *Server) terminateDisks(request *Request) (interface{}, error) {
+	var params cbs.TerminateDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	disks, err := me.findDisks(params.DiskIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, disk := range disks {
+		if disk.state != diskStateUnattached {
+			return nil, errorf("ResourceUnavailable.Attached", "disk %s is %s", *disk.DiskId, disk.state)
+		}
+	}
+
+	remained := me.disks[:0]
+	for _, disk := range me.disks {
+		if !matchIds(params.DiskIds, *disk.DiskId) {
+			remained = append(remained, disk)
+		}
+	}
+	me.disks = remained
+
+	return &cbs.TerminateDisksResponseParams{}, nil
+}
+
+// addDisk saves the disk with a new id in the state
+func (me *Server) addDisk(disk *cbs.Disk, state string) *diskState {
+	disk.DiskId = helper.String(me.newId("disk"))
+	disk.CreateTime = helper.String(now())
+	disk.RenewFlag = helper.String("NOTIFY_AND_MANUAL_RENEW")
+	disk.InstanceId = helper.String("")
+	disk.InstanceIdList = []*string{}
+	disk.AutoSnapshotPolicyIds = []*string{}
+	disk.SnapshotCount = helper.Int64(0)
+	disk.Rollbacking = helper.Bool(false)
+	disk.DeleteWithInstance = helper.Bool(disk.DeleteWithInstance != nil && *disk.DeleteWithInstance)
+
+	item := &diskState{Disk: disk}
+	item.moveTo(state, state, 0)
+	item.refresh()
+	me.disks = append(me.disks, item)
+	return item
+}
+
+// addInstanceDisk saves a disk created with the instance, which is attached to it already
+func (me *Server) addInstanceDisk(instance *cvm.Instance, usage, diskType string, diskSize int64, deleteWithInstance bool) string {
+	var projectId *uint64
+	if instance.Placement.ProjectId != nil {
+		projectId = helper.Uint64(uint64(*instance.Placement.ProjectId))
+	}
+	item := me.addDisk(&cbs.Disk{
+		DiskType:           helper.String(diskType),
+		DiskSize:           helper.Uint64(uint64(diskSize)),
+		DiskUsage:          helper.String(usage),
+		DiskChargeType:     instance.InstanceChargeType,
+		DeleteWithInstance: helper.Bool(deleteWithInstance),
+		Portable:           helper.Bool(false),
+		Placement:          &cbs.Placement{Zone: instance.Placement.Zone, ProjectId: projectId},
+	}, diskStateAttached)
+	item.InstanceId = instance.InstanceId
+	item.InstanceIdList = []*string{instance.InstanceId}
+	return *item.DiskId
+}
+
+// findDisks returns the disks of ids, InvalidDiskId.NotFound is returned if any of them does not exist
+func (me *Server) findDisks(ids []*string) ([]*diskState, error) {
+	disks := make([]*diskState, 0, len(ids))
+	for _, id := range ids {
+		var found *diskState
+		for _, disk := range me.disks {
+			if *disk.DiskId == stringValue(id) {
+				found = disk
+			}
+		}
+		if found == nil {
+			return nil, errorf("InvalidDiskId.NotFound", "disk %s is not found", stringValue(id))
+		}
+		disks = append(disks, found)
+	}
+	return disks, nil
+}
+
+func cbsTags(tags []*cbs.Tag) map[string]string {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[stringValue(tag.Key)] = stringValue(tag.Value)
+	}
+	return values
+}
@@ -0,0 +1,333 @@
+package fakeapi
+
+import (
+	"fmt"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// the CLB tasks are finished along with the requests creating them, so DescribeTaskStatus always reports
+// them succeeded
+
+const clbTaskSucceeded = 0
+
+type loadBalancerState struct {
+	*clb.LoadBalancer
+	region    string
+	listeners []*clb.Listener
+}
+
+func (me *Server) registerClb() {
+	me.handle("clb", "CreateLoadBalancer", me.createLoadBalancer)
+	me.handle("clb", "DescribeLoadBalancers", me.describeLoadBalancers)
+	me.handle("clb", "ModifyLoadBalancerAttributes", me.modifyLoadBalancerAttributes)
+	me.handle("clb", "DeleteLoadBalancer", me.deleteLoadBalancer)
+	me.handle("clb", "CreateListener", me.createListener)
+	me.handle("clb", "DescribeListeners", me.describeListeners)
+	me.handle("clb", "ModifyListener", me.modifyListener)
+	me.handle("clb", "DeleteListener", me.deleteListener)
+	me.handle("clb", "DescribeTaskStatus", me.describeTaskStatus)
+}
+
+func (me *Server) createLoadBalancer(request *Request) (interface{}, error) {
+	var params clb.CreateLoadBalancerRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	loadBalancerType := stringValue(params.LoadBalancerType)
+	if loadBalancerType != "OPEN" && loadBalancerType != "INTERNAL" {
+		return nil, errorf("InvalidParameterValue", "load balancer type %s is invalid", loadBalancerType)
+	}
+	if loadBalancerType == "INTERNAL" && me.findSubnet(request.Region, stringValue(params.SubnetId)) == nil {
+		return nil, errorf(CodeResourceNotFound, "subnet %s is not found", stringValue(params.SubnetId))
+	}
+
+	loadBalancerId := me.newId("lb")
+	item := &clb.LoadBalancer{
+		LoadBalancerId:           helper.String(loadBalancerId),
+		LoadBalancerName:         params.LoadBalancerName,
+		LoadBalancerType:         params.LoadBalancerType,
+		Forward:                  helper.Uint64(1),
+		LoadBalancerVips:         []*string{helper.String(fmt.Sprintf("192.0.2.%d", me.ids["lb"]))},
+		Status:                   helper.Uint64(1),
+		CreateTime:               helper.String(now()),
+		ProjectId:                helper.Uint64(uint64(int64Value(params.ProjectId))),
+		VpcId:                    helper.String(stringValue(params.VpcId)),
+		SubnetId:                 helper.String(stringValue(params.SubnetId)),
+		SecureGroups:             []*string{},
+		TargetRegionInfo:         &clb.TargetRegionInfo{Region: helper.String(request.Region), VpcId: helper.String(stringValue(params.VpcId))},
+		AddressIPVersion:         helper.String("ipv4"),
+		VipIsp:                   params.VipIsp,
+		LoadBalancerPassToTarget: helper.Bool(params.LoadBalancerPassToTarget != nil && *params.LoadBalancerPassToTarget),
+		SnatPro:                  helper.Bool(params.SnatPro != nil && *params.SnatPro),
+		LogSetId:                 helper.String(""),
+		LogTopicId:               helper.String(""),
+		LoadBalancerDomain:       helper.String(loadBalancerId + ".clb.tencentclb.com"),
+		Tags:                     params.Tags,
+	}
+	if params.AddressIPVersion != nil {
+		item.AddressIPVersion = params.AddressIPVersion
+	}
+	if item.Tags == nil {
+		item.Tags = []*clb.TagInfo{}
+	}
+	me.loadBalancers = append(me.loadBalancers, &loadBalancerState{LoadBalancer: item, region: request.Region})
+
+	return &clb.CreateLoadBalancerResponseParams{LoadBalancerIds: []*string{item.LoadBalancerId}}, nil
+}
+
+func (me *Server) describeLoadBalancers(request *Request) (interface{}, error) {
+	var params clb.DescribeLoadBalancersRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	matched := make([]*clb.LoadBalancer, 0)
+	for _, item := range me.loadBalancers {
+		if item.region != request.Region || !matchIds(params.LoadBalancerIds, *item.LoadBalancerId) {
+			continue
+		}
+		if params.LoadBalancerType != nil && *params.LoadBalancerType != *item.LoadBalancerType {
+			continue
+		}
+		if params.LoadBalancerName != nil && *params.LoadBalancerName != stringValue(item.LoadBalancerName) {
+			continue
+		}
+		if params.VpcId != nil && *params.VpcId != stringValue(item.VpcId) {
+			continue
+		}
+		matched = append(matched, item.LoadBalancer)
+	}
+
+	start, end, err := page(len(matched), int64Value(params.Offset), int64Value(params.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &clb.DescribeLoadBalancersResponseParams{
+		TotalCount:      helper.IntUint64(len(matched)),
+		LoadBalancerSet: matched[start:end],
+	}, nil
+}
+
+func (me *Server) modifyLoadBalancerAttributes(request *Request) (interface{}, error) {
+	var params clb.ModifyLoadBalancerAttributesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	item := me.findLoadBalancer(request.Region, stringValue(params.LoadBalancerId))
+	if item == nil {
+		return nil, errorf("InvalidParameter.LBIdNotFound", "load balancer %s is not found", stringValue(params.LoadBalancerId))
+	}
+	if params.LoadBalancerName != nil {
+		item.LoadBalancerName = params.LoadBalancerName
+	}
+	if params.TargetRegionInfo != nil {
+		item.TargetRegionInfo = params.TargetRegionInfo
+	}
+	if params.LoadBalancerPassToTarget != nil {
+		item.LoadBalancerPassToTarget = params.LoadBalancerPassToTarget
+	}
+	if params.SnatPro != nil {
+		item.SnatPro = params.SnatPro
+	}
+	return &clb.ModifyLoadBalancerAttributesResponseParams{}, nil
+}
+
+func (me *Server) deleteLoadBalancer(request *Request) (interface{}, error) {
+	var params clb.DeleteLoadBalancerRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	for _, loadBalancerId := range params.LoadBalancerIds {
+		if me.findLoadBalancer(request.Region, stringValue(loadBalancerId)) == nil {
+			return nil, errorf("InvalidParameter.LBIdNotFound", "load balancer %s is not found", stringValue(loadBalancerId))
+		}
+	}
+	kept := me.loadBalancers[:0]
+	for _, item := range me.loadBalancers {
+		if item.region != request.Region || !matchIds(params.LoadBalancerIds, *item.LoadBalancerId) {
+			kept = append(kept, item)
+		}
+	}
+	me.loadBalancers = kept
+	return &clb.DeleteLoadBalancerResponseParams{}, nil
+}
+
+func (me *Server) createListener(request *Request) (interface{}, error) {
+	var params clb.CreateListenerRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	owner := me.findLoadBalancer(request.Region, stringValue(params.LoadBalancerId))
+	if owner == nil {
+		return nil, errorf("InvalidParameter.LBIdNotFound", "load balancer %s is not found", stringValue(params.LoadBalancerId))
+	}
+	if len(params.ListenerNames) > 0 && len(params.ListenerNames) != len(params.Ports) {
+		return nil, errorf("InvalidParameter.FormatError", "listener names mismatch the ports")
+	}
+
+	listenerIds := make([]*string, 0, len(params.Ports))
+	for i, port := range params.Ports {
+		for _, listener := range owner.listeners {
+			if *listener.Port == *port && *listener.Protocol == stringValue(params.Protocol) {
+				return nil, errorf("InvalidParameter.PortCheckFailed", "port %d of protocol %s is in use", *port, *listener.Protocol)
+			}
+		}
+		listener := &clb.Listener{
+			ListenerId:        helper.String(me.newId("lbl")),
+			Protocol:          params.Protocol,
+			Port:              port,
+			HealthCheck:       params.HealthCheck,
+			Scheduler:         helper.String("WRR"),
+			SessionExpireTime: helper.Int64(int64Value(params.SessionExpireTime)),
+			SniSwitch:         helper.Int64(int64Value(params.SniSwitch)),
+			Rules:             []*clb.RuleOutput{},
+			CreateTime:        helper.String(now()),
+			TargetType:        helper.String("NODE"),
+		}
+		if len(params.ListenerNames) > 0 {
+			listener.ListenerName = params.ListenerNames[i]
+		}
+		if params.Scheduler != nil {
+			listener.Scheduler = params.Scheduler
+		}
+		if params.TargetType != nil {
+			listener.TargetType = params.TargetType
+		}
+		owner.listeners = append(owner.listeners, listener)
+		listenerIds = append(listenerIds, listener.ListenerId)
+	}
+
+	return &clb.CreateListenerResponseParams{ListenerIds: listenerIds}, nil
+}
+
+func (me *Server) describeListeners(request *Request) (interface{}, error) {
+	var params clb.DescribeListenersRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	owner := me.findLoadBalancer(request.Region, stringValue(params.LoadBalancerId))
+	if owner == nil {
+		return nil, errorf("InvalidParameter.LBIdNotFound", "load balancer %s is not found", stringValue(params.LoadBalancerId))
+	}
+
+	matched := make([]*clb.Listener, 0)
+	for _, listener := range owner.listeners {
+		if !matchIds(params.ListenerIds, *listener.ListenerId) {
+			continue
+		}
+		if params.Protocol != nil && *params.Protocol != *listener.Protocol {
+			continue
+		}
+		if params.Port != nil && *params.Port != *listener.Port {
+			continue
+		}
+		matched = append(matched, listener)
+	}
+
+	return &clb.DescribeListenersResponseParams{
+		TotalCount: helper.IntUint64(len(matched)),
+		Listeners:  matched,
+	}, nil
+}
+
+func (me *Server) modifyListener(request *Request) (interface{}, error) {
+	var params clb.ModifyListenerRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	listener := me.findListener(request.Region, stringValue(params.LoadBalancerId), stringValue(params.ListenerId))
+	if listener == nil {
+		return nil, errorf(CodeResourceNotFound, "listener %s is not found", stringValue(params.ListenerId))
+	}
+	if params.ListenerName != nil {
+		listener.ListenerName = params.ListenerName
+	}
+	if params.SessionExpireTime != nil {
+		listener.SessionExpireTime = params.SessionExpireTime
+	}
+	if params.HealthCheck != nil {
+		listener.HealthCheck = params.HealthCheck
+	}
+	if params.Scheduler != nil {
+		listener.Scheduler = params.Scheduler
+	}
+	if params.SniSwitch != nil {
+		listener.SniSwitch = params.SniSwitch
+	}
+	if params.TargetType != nil {
+		listener.TargetType = params.TargetType
+	}
+	return &clb.ModifyListenerResponseParams{}, nil
+}
+
+func (me *Server) deleteListener(request *Request) (interface{}, error) {
+	var params clb.DeleteListenerRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	owner := me.findLoadBalancer(request.Region, stringValue(params.LoadBalancerId))
+	if owner == nil {
+		return nil, errorf("InvalidParameter.LBIdNotFound", "load balancer %s is not found", stringValue(params.LoadBalancerId))
+	}
+	for i, listener := range owner.listeners {
+		if *listener.ListenerId == stringValue(params.ListenerId) {
+			owner.listeners = append(owner.listeners[:i], owner.listeners[i+1:]...)
+			return &clb.DeleteListenerResponseParams{}, nil
+		}
+	}
+	return nil, errorf(CodeResourceNotFound, "listener %s is not found", stringValue(params.ListenerId))
+}
+
+func (me *Server) describeTaskStatus(request *Request) (interface{}, error) {
+	var params clb.DescribeTaskStatusRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if stringValue(params.TaskId) == "" && stringValue(params.DealName) == "" {
+		return nil, errorf("MissingParameter", "task id is required")
+	}
+	return &clb.DescribeTaskStatusResponseParams{
+		Status:          helper.Int64(clbTaskSucceeded),
+		LoadBalancerIds: []*string{},
+	}, nil
+}
+
+func (me *Server) findLoadBalancer(region, loadBalancerId string) *loadBalancerState {
+	for _, item := range me.loadBalancers {
+		if item.region == region && *item.LoadBalancerId == loadBalancerId {
+			return item
+		}
+	}
+	return nil
+}
+
+func (me *Server) findListener(region, loadBalancerId, listenerId string) *clb.Listener {
+	owner := me.findLoadBalancer(region, loadBalancerId)
+	if owner == nil {
+		return nil
+	}
+	for _, listener := range owner.listeners {
+		if *listener.ListenerId == listenerId {
+			return listener
+		}
+	}
+	return nil
+}
+
+func clbTags(tags []*clb.TagInfo) map[string]string {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[stringValue(tag.TagKey)] = stringValue(tag.TagValue)
+	}
+	return values
+}
@@ -0,0 +1,343 @@
+package fakeapi
+
+import (
+	"encoding/binary"
+	"net"
+
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	instanceStatePending     = "PENDING"
+	instanceStateRunning     = "RUNNING"
+	instanceStateTerminating = "TERMINATING"
+)
+
+type instanceState struct {
+	*cvm.Instance
+	transition
+}
+
+func (me *Server) registerCvm() {
+	me.handle("cvm", "RunInstances", me.runInstances)
+	me.handle("cvm", "DescribeInstances", me.describeInstances)
+	me.handle("cvm", "TerminateInstances", me.terminateInstances)
+	me.handle("cvm", "DescribeImages", me.describeImages)
+	me.handle("cvm", "InquiryPriceRunInstances", me.inquiryPriceRunInstances)
+}
+
+func (me *Server) runInstances(request *Request) (interface{}, error) {
+	var params cvm.RunInstancesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, errorf("MissingParameter", "placement zone is required")
+	}
+	if stringValue(params.InstanceType) == "" || stringValue(params.ImageId) == "" {
+		return nil, errorf("MissingParameter", "instance type and image id are required")
+	}
+
+	var subnet *subnetState
+	if params.VirtualPrivateCloud != nil {
+		subnet = me.findSubnet(request.Region, stringValue(params.VirtualPrivateCloud.SubnetId))
+		if subnet == nil || *subnet.VpcId != stringValue(params.VirtualPrivateCloud.VpcId) {
+			return nil, errorf("InvalidParameterValue.SubnetNotExist", "subnet %s of vpc %s does not exist",
+				stringValue(params.VirtualPrivateCloud.SubnetId), stringValue(params.VirtualPrivateCloud.VpcId))
+		}
+	}
+	for _, sgId := range params.SecurityGroupIds {
+		found := false
+		for _, sg := range me.securityGroups {
+			found = found || (sg.region == request.Region && *sg.SecurityGroupId == stringValue(sgId))
+		}
+		if !found {
+			return nil, errorf("InvalidSecurityGroupId.NotFound", "security group %s is not found", stringValue(sgId))
+		}
+	}
+	if params.DryRun != nil && *params.DryRun {
+		return nil, errorf("DryRunOperation", "the request would have succeeded")
+	}
+
+	count := int64Value(params.InstanceCount)
+	if count == 0 {
+		count = 1
+	}
+	chargeType := stringValue(params.InstanceChargeType)
+	if chargeType == "" {
+		chargeType = "POSTPAID_BY_HOUR"
+	}
+	var tags []*cvm.Tag
+	for _, spec := range params.TagSpecification {
+		if stringValue(spec.ResourceType) == "instance" {
+			tags = append(tags, spec.Tags...)
+		}
+	}
+
+	instanceIds := make([]*string, 0, count)
+	for i := int64(0); i < count; i++ {
+		instanceId := me.newId("ins")
+		item := &cvm.Instance{
+			InstanceId:         helper.String(instanceId),
+			InstanceName:       params.InstanceName,
+			InstanceType:       params.InstanceType,
+			InstanceChargeType: helper.String(chargeType),
+			ImageId:            params.ImageId,
+			Placement:          params.Placement,
+			InternetAccessible: params.InternetAccessible,
+			SecurityGroupIds:   params.SecurityGroupIds,
+			LoginSettings:      &cvm.LoginSettings{},
+			Tags:               tags,
+			CreatedTime:        helper.String(now()),
+			RestrictState:      helper.String("NORMAL"),
+			RenewFlag:          helper.String("NOTIFY_AND_MANUAL_RENEW"),
+			CamRoleName:        params.CamRoleName,
+			StopChargingMode:   helper.String("NOT_APPLICABLE"),
+			PrivateIpAddresses: []*string{},
+			PublicIpAddresses:  []*string{},
+			DataDisks:          []*cvm.DataDisk{},
+		}
+		if item.SecurityGroupIds == nil {
+			item.SecurityGroupIds = []*string{}
+		}
+		if subnet != nil {
+			item.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{
+				VpcId:    subnet.VpcId,
+				SubnetId: subnet.SubnetId,
+			}
+			item.PrivateIpAddresses = []*string{helper.String(me.allocateIp(subnet))}
+		}
+
+		systemDisk := &cvm.SystemDisk{DiskType: helper.String("CLOUD_PREMIUM"), DiskSize: helper.Int64(50)}
+		if params.SystemDisk != nil {
+			if params.SystemDisk.DiskType != nil {
+				systemDisk.DiskType = params.SystemDisk.DiskType
+			}
+			if params.SystemDisk.DiskSize != nil {
+				systemDisk.DiskSize = params.SystemDisk.DiskSize
+			}
+		}
+		systemDisk.DiskId = helper.String(me.addInstanceDisk(item, "SYSTEM_DISK", *systemDisk.DiskType, *systemDisk.DiskSize, true))
+		item.SystemDisk = systemDisk
+
+		for _, dataDisk := range params.DataDisks {
+			disk := &cvm.DataDisk{
+				DiskType:           dataDisk.DiskType,
+				DiskSize:           dataDisk.DiskSize,
+				DeleteWithInstance: helper.Bool(dataDisk.DeleteWithInstance == nil || *dataDisk.DeleteWithInstance),
+				Encrypt:            dataDisk.Encrypt,
+				SnapshotId:         dataDisk.SnapshotId,
+			}
+			disk.DiskId = helper.String(me.addInstanceDisk(item, "DATA_DISK", stringValue(disk.DiskType), int64Value(disk.DiskSize), *disk.DeleteWithInstance))
+			item.DataDisks = append(item.DataDisks, disk)
+		}
+
+		state := &instanceState{Instance: item}
+		state.moveTo(instanceStatePending, instanceStateRunning, me.StateDelay)
+		item.InstanceState = helper.String(state.state)
+		me.instances = append(me.instances, state)
+		instanceIds = append(instanceIds, item.InstanceId)
+	}
+
+	return &cvm.RunInstancesResponseParams{InstanceIdSet: instanceIds}, nil
+}
+
+func (me *Server) describeInstances(request *Request) (interface{}, error) {
+	var params cvm.DescribeInstancesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+	if len(params.InstanceIds) > 0 && len(filters) > 0 {
+		return nil, errorf("InvalidParameter", "instance ids and filters can not be specified at the same time")
+	}
+
+	matched := make([]*cvm.Instance, 0)
+	for _, item := range me.observeInstances(params.InstanceIds) {
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "zone":
+				return []string{stringValue(item.Placement.Zone)}, true
+			case "instance-id":
+				return []string{*item.InstanceId}, true
+			case "instance-name":
+				return []string{stringValue(item.InstanceName)}, true
+			case "instance-state":
+				return []string{stringValue(item.InstanceState)}, true
+			case "instance-type":
+				return []string{stringValue(item.InstanceType)}, true
+			case "instance-charge-type":
+				return []string{stringValue(item.InstanceChargeType)}, true
+			case "image-id":
+				return []string{stringValue(item.ImageId)}, true
+			case "private-ip-address":
+				return stringValues(item.PrivateIpAddresses), true
+			case "security-group-id":
+				return stringValues(item.SecurityGroupIds), true
+			case "vpc-id", "subnet-id":
+				if item.VirtualPrivateCloud == nil {
+					return nil, true
+				}
+				if name == "vpc-id" {
+					return []string{stringValue(item.VirtualPrivateCloud.VpcId)}, true
+				}
+				return []string{stringValue(item.VirtualPrivateCloud.SubnetId)}, true
+			}
+			return tagAttribute(name, cvmTags(item.Tags))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+
+	start, end, err := page(len(matched), int64Value(params.Offset), int64Value(params.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cvm.DescribeInstancesResponseParams{
+		TotalCount:  helper.IntInt64(len(matched)),
+		InstanceSet: matched[start:end],
+	}, nil
+}
+
+func (me *Server) terminateInstances(request *Request) (interface{}, error) {
+	var params cvm.TerminateInstancesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	items := make([]*instanceState, 0, len(params.InstanceIds))
+	for _, instanceId := range params.InstanceIds {
+		item := me.findInstance(stringValue(instanceId))
+		if item == nil {
+			return nil, errorf("InvalidInstanceId.NotFound", "instance %s is not found", stringValue(instanceId))
+		}
+		if item.state == instanceStateTerminating {
+			return nil, errorf("OperationDenied.InstanceOperationInProgress", "instance %s is terminating", *item.InstanceId)
+		}
+		items = append(items, item)
+	}
+	for _, item := range items {
+		item.moveTo(instanceStateTerminating, stateGone, me.StateDelay)
+		item.InstanceState = helper.String(item.state)
+	}
+	me.removeGoneInstances()
+
+	return &cvm.TerminateInstancesResponseParams{}, nil
+}
+
+// describeImages returns the images which the instances are launched from, as the images are not kept
+func (me *Server) describeImages(request *Request) (interface{}, error) {
+	var params cvm.DescribeImagesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	matched := make([]*cvm.Image, 0)
+	seen := map[string]bool{}
+	for _, item := range me.instances {
+		imageId := stringValue(item.ImageId)
+		if imageId == "" || seen[imageId] || !matchIds(params.ImageIds, imageId) {
+			continue
+		}
+		seen[imageId] = true
+		matched = append(matched, &cvm.Image{
+			ImageId:    helper.String(imageId),
+			ImageName:  helper.String(imageId),
+			ImageType:  helper.String("PUBLIC_IMAGE"),
+			ImageState: helper.String("NORMAL"),
+			OsName:     item.OsName,
+		})
+	}
+
+	start, end, err := page(len(matched), int64(uint64Value(params.Offset)), int64(uint64Value(params.Limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cvm.DescribeImagesResponseParams{
+		TotalCount: helper.IntInt64(len(matched)),
+		ImageSet:   matched[start:end],
+	}, nil
+}
+
+// observeInstances returns the instances of ids seen by a Describe request, the terminated ones are removed
+func (me *Server) observeInstances(ids []*string) []*cvm.Instance {
+	observed := make([]*cvm.Instance, 0)
+	for _, item := range me.instances {
+		if !matchIds(ids, *item.InstanceId) {
+			continue
+		}
+		state := item.observe()
+		item.InstanceState = helper.String(state)
+		if state != stateGone {
+			observed = append(observed, item.Instance)
+		}
+	}
+	me.removeGoneInstances()
+	return observed
+}
+
+// removeGoneInstances removes the terminated instances, their disks are deleted or detached
+func (me *Server) removeGoneInstances() {
+	instances := me.instances[:0]
+	for _, item := range me.instances {
+		if item.state != stateGone {
+			instances = append(instances, item)
+			continue
+		}
+		disks := me.disks[:0]
+		for _, disk := range me.disks {
+			if stringValue(disk.InstanceId) != *item.InstanceId {
+				disks = append(disks, disk)
+				continue
+			}
+			if disk.DeleteWithInstance != nil && *disk.DeleteWithInstance {
+				continue
+			}
+			disk.moveTo(diskStateUnattached, diskStateUnattached, 0)
+			disk.refresh()
+			disks = append(disks, disk)
+		}
+		me.disks = disks
+	}
+	me.instances = instances
+}
+
+func (me *Server) findInstance(instanceId string) *instanceState {
+	for _, item := range me.instances {
+		if *item.InstanceId == instanceId && item.state != stateGone {
+			return item
+		}
+	}
+	return nil
+}
+
+// allocateIp returns the next private ip of the subnet, the first two addresses are reserved
+func (me *Server) allocateIp(subnet *subnetState) string {
+	_, network, _ := net.ParseCIDR(*subnet.CidrBlock)
+	ip := network.IP.To4()
+	if ip == nil {
+		return network.IP.String()
+	}
+	me.ids["ip:"+*subnet.SubnetId]++
+	next := make(net.IP, 4)
+	binary.BigEndian.PutUint32(next, binary.BigEndian.Uint32(ip)+uint32(me.ids["ip:"+*subnet.SubnetId])+1)
+	return next.String()
+}
+
+func cvmTags(tags []*cvm.Tag) map[string]string {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[stringValue(tag.Key)] = stringValue(tag.Value)
+	}
+	return values
+}
@@ -0,0 +1,143 @@
+package fakeapi
+
+import (
+	"math"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// the prices of the fake are fixed, so the price inquiries can be checked by the tests. The postpaid prices
+// are per hour except the traffic one, and a month of the prepaid ones is 720 hours at the prepaid discount.
+const (
+	// PriceInstanceHour is the price of an instance of any type an hour
+	PriceInstanceHour = 0.5
+	// PriceDiskGBHour is the price of 1GB of any disk an hour
+	PriceDiskGBHour = 0.001
+	// PriceBandwidthMbpsHour is the price of 1Mbps of the bandwidth an hour
+	PriceBandwidthMbpsHour = 0.06
+	// PriceTrafficGB is the price of 1GB of the traffic
+	PriceTrafficGB = 0.8
+	// PricePrepaidDiscount is the discount in percent of the prepaid instances and bandwidth
+	PricePrepaidDiscount = 80.0
+
+	hoursOfMonth = 720
+)
+
+func (me *Server) inquiryPriceRunInstances(request *Request) (interface{}, error) {
+	var params cvm.InquiryPriceRunInstancesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, errorf("MissingParameter", "placement zone is required")
+	}
+	if stringValue(params.InstanceType) == "" || stringValue(params.ImageId) == "" {
+		return nil, errorf("MissingParameter", "instance type and image id are required")
+	}
+
+	chargeType := stringValue(params.InstanceChargeType)
+	if chargeType == "" {
+		chargeType = "POSTPAID_BY_HOUR"
+	}
+	var period int64
+	if chargeType == "PREPAID" {
+		if params.InstanceChargePrepaid == nil || int64Value(params.InstanceChargePrepaid.Period) == 0 {
+			return nil, errorf("MissingParameter", "period of prepaid instances is required")
+		}
+		period = int64Value(params.InstanceChargePrepaid.Period)
+	}
+	count := float64(int64Value(params.InstanceCount))
+	if count == 0 {
+		count = 1
+	}
+
+	diskSize := int64(50)
+	if params.SystemDisk != nil && params.SystemDisk.DiskSize != nil {
+		diskSize = *params.SystemDisk.DiskSize
+	}
+	for _, disk := range params.DataDisks {
+		diskSize += int64Value(disk.DiskSize)
+	}
+	instanceHour := (PriceInstanceHour + PriceDiskGBHour*float64(diskSize)) * count
+
+	price := &cvm.Price{}
+	if period > 0 {
+		price.InstancePrice = prepaidItemPrice(instanceHour*hoursOfMonth*float64(period), PricePrepaidDiscount)
+	} else {
+		price.InstancePrice = postpaidItemPrice(instanceHour, "HOUR")
+	}
+
+	if internet := params.InternetAccessible; internet != nil && int64Value(internet.InternetMaxBandwidthOut) > 0 {
+		bandwidthHour := PriceBandwidthMbpsHour * float64(*internet.InternetMaxBandwidthOut) * count
+		switch stringValue(internet.InternetChargeType) {
+		case "BANDWIDTH_PREPAID":
+			if period == 0 {
+				return nil, errorf("InvalidParameterCombination", "BANDWIDTH_PREPAID is only for prepaid instances")
+			}
+			price.BandwidthPrice = prepaidItemPrice(bandwidthHour*hoursOfMonth*float64(period), PricePrepaidDiscount)
+		case "BANDWIDTH_POSTPAID_BY_HOUR":
+			price.BandwidthPrice = postpaidItemPrice(bandwidthHour, "HOUR")
+		case "BANDWIDTH_PACKAGE":
+			price.BandwidthPrice = postpaidItemPrice(0, "HOUR")
+		default:
+			price.BandwidthPrice = postpaidItemPrice(PriceTrafficGB, "GB")
+		}
+	}
+
+	return &cvm.InquiryPriceRunInstancesResponseParams{Price: price}, nil
+}
+
+func (me *Server) inquiryPriceCreateDisks(request *Request) (interface{}, error) {
+	var params cbs.InquiryPriceCreateDisksRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if stringValue(params.DiskType) == "" || params.DiskSize == nil || stringValue(params.DiskChargeType) == "" {
+		return nil, errorf("MissingParameter", "disk type, disk size and disk charge type are required")
+	}
+	count := float64(uint64Value(params.DiskCount))
+	if count == 0 {
+		count = 1
+	}
+	diskHour := PriceDiskGBHour * float64(*params.DiskSize) * count
+
+	price := &cbs.Price{}
+	if *params.DiskChargeType == "PREPAID" {
+		if params.DiskChargePrepaid == nil || uint64Value(params.DiskChargePrepaid.Period) == 0 {
+			return nil, errorf("MissingParameter", "period of prepaid disks is required")
+		}
+		original := roundPrice(diskHour * hoursOfMonth * float64(*params.DiskChargePrepaid.Period))
+		price.OriginalPrice = helper.Float64(original)
+		price.DiscountPrice = helper.Float64(original)
+	} else {
+		price.UnitPrice = helper.Float64(roundPrice(diskHour))
+		price.UnitPriceDiscount = helper.Float64(roundPrice(diskHour))
+		price.ChargeUnit = helper.String("HOUR")
+	}
+
+	return &cbs.InquiryPriceCreateDisksResponseParams{DiskPrice: price}, nil
+}
+
+func prepaidItemPrice(original, discount float64) *cvm.ItemPrice {
+	return &cvm.ItemPrice{
+		OriginalPrice: helper.Float64(roundPrice(original)),
+		DiscountPrice: helper.Float64(roundPrice(original * discount / 100)),
+		Discount:      helper.Float64(discount),
+	}
+}
+
+func postpaidItemPrice(unitPrice float64, chargeUnit string) *cvm.ItemPrice {
+	return &cvm.ItemPrice{
+		UnitPrice:         helper.Float64(roundPrice(unitPrice)),
+		UnitPriceDiscount: helper.Float64(roundPrice(unitPrice)),
+		ChargeUnit:        helper.String(chargeUnit),
+		Discount:          helper.Float64(100),
+	}
+}
+
+// roundPrice rounds the price to 4 decimal places as the API does
+func roundPrice(price float64) float64 {
+	return math.Round(price*10000) / 10000
+}
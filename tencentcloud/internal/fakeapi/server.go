@@ -0,0 +1,388 @@
+// Package fakeapi is an in-process fake of the TencentCloud API 3.0, which serves the core actions of
+// VPC, CVM, CBS and CLB, and the tags of their resources, with the states kept in memory. The clients are
+// pointed at it by the endpoint overrides, so the services and resources can be tested offline with
+// deterministic fault injection.
+package fakeapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// CodeInternalError is returned when the handler fails with an error which is not *Error
+	CodeInternalError = "InternalError"
+	// CodeInvalidAction is returned when no handler is registered for the action
+	CodeInvalidAction = "InvalidAction"
+	// CodeInvalidParameter is returned when the request body can not be parsed
+	CodeInvalidParameter = "InvalidParameter"
+	// CodeAuthFailure is returned when the signature is checked and mismatched
+	CodeAuthFailure = "AuthFailure.SignatureFailure"
+	// CodeResourceNotFound is returned when the resource of the request does not exist
+	CodeResourceNotFound = "ResourceNotFound"
+	// CodeResourceInUse is returned when the resource is depended on by others
+	CodeResourceInUse = "ResourceInUse"
+)
+
+// Services are the services served by Server
+var Services = []string{"vpc", "cvm", "cbs", "clb", "tag"}
+
+// Error is the API error returned in the response
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+}
+
+func errorf(code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Request is an API request received by Server
+type Request struct {
+	Service string
+	Action  string
+	Region  string
+	Body    []byte
+}
+
+// Bind parses the request body into v, which is usually the `XxxRequestParams` of the SDK
+func (r *Request) Bind(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return errorf(CodeInvalidParameter, "parse request body of %s failed: %s", r.Action, err.Error())
+	}
+	return nil
+}
+
+// HandlerFunc handles an action, the result is the `XxxResponseParams` of the SDK, `RequestId` is filled by
+// Server. The handlers are called one by one with the states of Server locked.
+type HandlerFunc func(request *Request) (interface{}, error)
+
+// Fault is the error returned by Server instead of handling the request
+type Fault struct {
+	Code    string
+	Message string
+	// StatusCode is the HTTP status of the response, the API errors are returned with 200 if it is 0
+	StatusCode int
+	// Times is the count of requests failed by the fault, 0 means all of them
+	Times int
+}
+
+// Server is the fake API server, the states of resources live as long as it.
+type Server struct {
+	// URL is the endpoint of the server, e.g. `http://127.0.0.1:54321`
+	URL string
+	// StateDelay is the count of Describe requests which see a resource in its intermediate state, e.g. the
+	// `PENDING` instances and the `ATTACHING` disks, it is 0 by default so the changes take effect immediately.
+	StateDelay int
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	secretId  string
+	secretKey string
+	handlers  map[string]map[string]HandlerFunc
+	faults    map[string][]*Fault
+	calls     map[string]int
+	ids       map[string]int
+	requests  int
+
+	vpcs           []*vpcState
+	subnets        []*subnetState
+	securityGroups []*securityGroupState
+	instances      []*instanceState
+	disks          []*diskState
+	loadBalancers  []*loadBalancerState
+}
+
+// NewServer starts a Server serving the actions of Services, it should be closed after use
+func NewServer() *Server {
+	me := &Server{
+		handlers: map[string]map[string]HandlerFunc{},
+		faults:   map[string][]*Fault{},
+		calls:    map[string]int{},
+		ids:      map[string]int{},
+	}
+	me.registerVpc()
+	me.registerCvm()
+	me.registerCbs()
+	me.registerClb()
+	me.registerTag()
+
+	me.server = httptest.NewServer(me)
+	me.URL = me.server.URL
+	return me
+}
+
+// Close shuts down the server
+func (me *Server) Close() {
+	me.server.Close()
+}
+
+// Endpoints returns the endpoint overrides of Services, which is used as `TencentCloudClient.Endpoints`
+func (me *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(Services))
+	for _, service := range Services {
+		endpoints[service] = me.URL
+	}
+	return endpoints
+}
+
+// CheckSignature makes the server verify the TC3-HMAC-SHA256 signature of requests with the secret,
+// the signature is not checked by default.
+func (me *Server) CheckSignature(secretId, secretKey string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	me.secretId, me.secretKey = secretId, secretKey
+}
+
+// Handle registers the handler of action, which replaces the builtin one if exists
+func (me *Server) Handle(service, action string, handler HandlerFunc) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	me.handle(service, action, handler)
+}
+
+func (me *Server) handle(service, action string, handler HandlerFunc) {
+	if me.handlers[service] == nil {
+		me.handlers[service] = map[string]HandlerFunc{}
+	}
+	me.handlers[service][action] = handler
+}
+
+// InjectFault makes the following requests of action fail with the fault, the faults injected to the same
+// action take effect in order.
+func (me *Server) InjectFault(service, action string, fault Fault) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	key := service + "." + action
+	me.faults[key] = append(me.faults[key], &fault)
+}
+
+// ClearFaults removes all the faults injected
+func (me *Server) ClearFaults() {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	me.faults = map[string][]*Fault{}
+}
+
+// Calls returns the count of requests of action received, including the failed ones
+func (me *Server) Calls(service, action string) int {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	return me.calls[service+"."+action]
+}
+
+func (me *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	me.requests++
+	requestId := fmt.Sprintf("fake-request-%d", me.requests)
+	request := &Request{
+		Service: requestService(r),
+		Action:  r.Header.Get("X-TC-Action"),
+		Region:  r.Header.Get("X-TC-Region"),
+		Body:    body,
+	}
+	handler := me.lookup(request)
+	me.calls[request.Service+"."+request.Action]++
+
+	if fault := me.popFault(request.Service + "." + request.Action); fault != nil {
+		statusCode := fault.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+		writeResponse(w, statusCode, errorResponse(requestId, &Error{Code: fault.Code, Message: fault.Message}))
+		return
+	}
+
+	if me.secretKey != "" {
+		if err := me.verifySignature(r, request.Service, body); err != nil {
+			writeResponse(w, http.StatusOK, errorResponse(requestId, err))
+			return
+		}
+	}
+
+	if handler == nil {
+		writeResponse(w, http.StatusOK, errorResponse(requestId,
+			errorf(CodeInvalidAction, "action %s of service %s is not supported by the fake server", request.Action, request.Service)))
+		return
+	}
+
+	result, err := handler(request)
+	if err != nil {
+		apiErr, ok := err.(*Error)
+		if !ok {
+			apiErr = &Error{Code: CodeInternalError, Message: err.Error()}
+		}
+		writeResponse(w, http.StatusOK, errorResponse(requestId, apiErr))
+		return
+	}
+
+	response := map[string]interface{}{}
+	if result != nil {
+		content, err := json.Marshal(result)
+		if err == nil {
+			err = json.Unmarshal(content, &response)
+		}
+		if err != nil {
+			writeResponse(w, http.StatusOK, errorResponse(requestId, &Error{Code: CodeInternalError, Message: err.Error()}))
+			return
+		}
+	}
+	response["RequestId"] = requestId
+	writeResponse(w, http.StatusOK, response)
+}
+
+// lookup returns the handler of the request, the service is resolved by the action if the request is not signed
+func (me *Server) lookup(request *Request) HandlerFunc {
+	if request.Service != "" {
+		return me.handlers[request.Service][request.Action]
+	}
+
+	services := make([]string, 0, len(me.handlers))
+	for service := range me.handlers {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		if handler, ok := me.handlers[service][request.Action]; ok {
+			request.Service = service
+			return handler
+		}
+	}
+	return nil
+}
+
+func (me *Server) popFault(key string) *Fault {
+	faults := me.faults[key]
+	if len(faults) == 0 {
+		return nil
+	}
+	fault := faults[0]
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			me.faults[key] = faults[1:]
+		}
+	}
+	return fault
+}
+
+// newId returns the id of a new resource, e.g. `vpc-00000001`, the ids are allocated in sequence
+func (me *Server) newId(prefix string) string {
+	me.ids[prefix]++
+	return fmt.Sprintf("%s-%08d", prefix, me.ids[prefix])
+}
+
+// verifySignature checks the TC3-HMAC-SHA256 signature as the API does, the host is signed by the SDK
+// as the endpoint, which is the host of the server.
+func (me *Server) verifySignature(r *http.Request, service string, body []byte) *Error {
+	authorization := r.Header.Get("Authorization")
+	fields := map[string]string{}
+	for _, field := range strings.Split(strings.TrimPrefix(authorization, "TC3-HMAC-SHA256 "), ", ") {
+		if kv := strings.SplitN(field, "=", 2); len(kv) == 2 {
+			fields[kv[0]] = kv[1]
+		}
+	}
+	if !strings.HasPrefix(authorization, "TC3-HMAC-SHA256 ") || fields["Credential"] == "" {
+		return errorf(CodeAuthFailure, "the authorization %q is not signed by TC3-HMAC-SHA256", authorization)
+	}
+	if !strings.HasPrefix(fields["Credential"], me.secretId+"/") {
+		return errorf("AuthFailure.SecretIdNotFound", "the secret id of credential %s is not found", fields["Credential"])
+	}
+
+	timestamp := r.Header.Get("X-TC-Timestamp")
+	var seconds int64
+	_, _ = fmt.Sscanf(timestamp, "%d", &seconds)
+	date := time.Unix(seconds, 0).UTC().Format("2006-01-02")
+
+	hashedPayload := sha256hex(string(body))
+	if r.Header.Get("X-TC-Content-SHA256") == "UNSIGNED-PAYLOAD" {
+		hashedPayload = sha256hex("UNSIGNED-PAYLOAD")
+	}
+	canonicalRequest := fmt.Sprintf("%s\n%s\n%s\ncontent-type:%s\nhost:%s\n\n%s\n%s",
+		r.Method, "/", r.URL.RawQuery, r.Header.Get("Content-Type"), r.Host, "content-type;host", hashedPayload)
+	credentialScope := fmt.Sprintf("%s/%s/tc3_request", date, service)
+	stringToSign := fmt.Sprintf("TC3-HMAC-SHA256\n%s\n%s\n%s", timestamp, credentialScope, sha256hex(canonicalRequest))
+
+	secretDate := hmacsha256(date, "TC3"+me.secretKey)
+	secretService := hmacsha256(service, secretDate)
+	secretSigning := hmacsha256("tc3_request", secretService)
+	signature := hex.EncodeToString([]byte(hmacsha256(stringToSign, secretSigning)))
+
+	if fields["Signature"] != signature {
+		return errorf(CodeAuthFailure, "the signature of request %s is mismatched", r.Header.Get("X-TC-Action"))
+	}
+	return nil
+}
+
+// requestService returns the service in the credential scope of the authorization, e.g. `vpc` of
+// `TC3-HMAC-SHA256 Credential=AKIDxxx/2006-01-02/vpc/tc3_request, ...`, the requests are sent to the
+// same host, so it is the only way to tell the service.
+func requestService(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	index := strings.Index(authorization, "Credential=")
+	if index < 0 {
+		return ""
+	}
+	credential := strings.SplitN(authorization[index+len("Credential="):], ",", 2)[0]
+	scope := strings.Split(credential, "/")
+	if len(scope) != 4 {
+		return ""
+	}
+	return scope[2]
+}
+
+func errorResponse(requestId string, err *Error) map[string]interface{} {
+	return map[string]interface{}{
+		"Error":     map[string]string{"Code": err.Code, "Message": err.Message},
+		"RequestId": requestId,
+	}
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, response map[string]interface{}) {
+	content, _ := json.Marshal(map[string]interface{}{"Response": response})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(content)
+}
+
+func sha256hex(s string) string {
+	b := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(b[:])
+}
+
+func hmacsha256(s, key string) string {
+	hashed := hmac.New(sha256.New, []byte(key))
+	hashed.Write([]byte(s))
+	return string(hashed.Sum(nil))
+}
@@ -0,0 +1,261 @@
+package fakeapi
+
+import (
+	"testing"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func newTestClient(server *Server, secretKey string) *connectivity.TencentCloudClient {
+	return &connectivity.TencentCloudClient{
+		Credential: common.NewCredential("AKIDfake", secretKey),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTP",
+		Endpoints:  server.Endpoints(),
+	}
+}
+
+func errorCode(err error) string {
+	if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
+		return sdkError.Code
+	}
+	return ""
+}
+
+func TestServerSignature(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.CheckSignature("AKIDfake", "secret")
+
+	request := vpc.NewDescribeVpcsRequest()
+	if _, err := newTestClient(server, "secret").UseVpcClient().DescribeVpcs(request); err != nil {
+		t.Errorf("expect the signed request succeeded, got %v", err)
+	}
+	if _, err := newTestClient(server, "wrong").UseVpcClient().DescribeVpcs(request); errorCode(err) != CodeAuthFailure {
+		t.Errorf("expect error %s of the wrong secret, got %v", CodeAuthFailure, err)
+	}
+	if calls := server.Calls("vpc", "DescribeVpcs"); calls != 2 {
+		t.Errorf("expect 2 calls of DescribeVpcs, got %d", calls)
+	}
+}
+
+func TestServerFault(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server, "secret").UseVpcClient()
+
+	server.InjectFault("vpc", "DescribeVpcs", Fault{Code: "RequestLimitExceeded", Times: 2})
+	server.InjectFault("vpc", "DescribeVpcs", Fault{Code: "InternalError", Times: 1})
+
+	request := vpc.NewDescribeVpcsRequest()
+	for _, expected := range []string{"RequestLimitExceeded", "RequestLimitExceeded", "InternalError", ""} {
+		if _, err := client.DescribeVpcs(request); errorCode(err) != expected {
+			t.Errorf("expect error %q, got %v", expected, err)
+		}
+	}
+
+	server.InjectFault("vpc", "DeleteVpc", Fault{Code: "InternalError", StatusCode: 502})
+	deleteRequest := vpc.NewDeleteVpcRequest()
+	deleteRequest.VpcId = helper.String("vpc-00000001")
+	for i := 0; i < 2; i++ {
+		if _, err := client.DeleteVpc(deleteRequest); errorCode(err) != "InternalError" {
+			t.Errorf("expect the fault without times failing all requests, got %v", err)
+		}
+	}
+	server.ClearFaults()
+	if _, err := client.DeleteVpc(deleteRequest); errorCode(err) != CodeResourceNotFound {
+		t.Errorf("expect error %s after the faults cleared, got %v", CodeResourceNotFound, err)
+	}
+}
+
+func TestServerVpc(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server, "secret").UseVpcClient()
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = helper.String("test")
+	createVpc.CidrBlock = helper.String("10.0.0.0/16")
+	vpcResponse, err := client.CreateVpc(createVpc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	vpcId := *vpcResponse.Response.Vpc.VpcId
+	if vpcId != "vpc-00000001" {
+		t.Errorf("expect the ids allocated in sequence, got %s", vpcId)
+	}
+
+	createSubnet := vpc.NewCreateSubnetRequest()
+	createSubnet.VpcId = &vpcId
+	createSubnet.SubnetName = helper.String("test")
+	createSubnet.Zone = helper.String("ap-guangzhou-3")
+	for _, c := range []struct {
+		cidr string
+		code string
+	}{
+		{"10.0.1.0/24", ""},
+		{"10.0.1.128/25", "InvalidParameterValue.SubnetConflict"},
+		{"10.1.0.0/24", "InvalidParameterValue.SubnetRange"},
+	} {
+		createSubnet.CidrBlock = helper.String(c.cidr)
+		if _, err := client.CreateSubnet(createSubnet); errorCode(err) != c.code {
+			t.Errorf("expect error %q creating subnet %s, got %v", c.code, c.cidr, err)
+		}
+	}
+
+	deleteVpc := vpc.NewDeleteVpcRequest()
+	deleteVpc.VpcId = &vpcId
+	if _, err := client.DeleteVpc(deleteVpc); errorCode(err) != CodeResourceInUse {
+		t.Errorf("expect error %s deleting the vpc with subnets, got %v", CodeResourceInUse, err)
+	}
+
+	describeSubnets := vpc.NewDescribeSubnetsRequest()
+	describeSubnets.Filters = []*vpc.Filter{{Name: helper.String("vpc-id"), Values: []*string{&vpcId}}}
+	subnets, err := client.DescribeSubnets(describeSubnets)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(subnets.Response.SubnetSet) != 1 || *subnets.Response.SubnetSet[0].AvailableIpAddressCount != 253 {
+		t.Errorf("expect a subnet of 253 available ips, got %s", subnets.ToJsonString())
+	}
+
+	describeSubnets.Filters = []*vpc.Filter{{Name: helper.String("unknown"), Values: []*string{&vpcId}}}
+	if _, err := client.DescribeSubnets(describeSubnets); errorCode(err) != "InvalidParameterValue.FilterNotSupported" {
+		t.Errorf("expect the unsupported filter rejected, got %v", err)
+	}
+}
+
+func TestServerVpcRegion(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	guangzhou := newTestClient(server, "secret")
+	shanghai := guangzhou.WithRegion("ap-shanghai").UseVpcClient()
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = helper.String("test")
+	createVpc.CidrBlock = helper.String("10.0.0.0/16")
+	vpcResponse, err := shanghai.CreateVpc(createVpc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	vpcId := vpcResponse.Response.Vpc.VpcId
+
+	describeVpcs := vpc.NewDescribeVpcsRequest()
+	describeVpcs.VpcIds = []*string{vpcId}
+	for _, c := range []struct {
+		region string
+		count  int
+	}{
+		{"ap-shanghai", 1},
+		{"ap-guangzhou", 0},
+	} {
+		response, err := guangzhou.WithRegion(c.region).UseVpcClient().DescribeVpcs(describeVpcs)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if len(response.Response.VpcSet) != c.count {
+			t.Errorf("expect %d vpcs in %s, got %s", c.count, c.region, response.ToJsonString())
+		}
+	}
+
+	deleteVpc := vpc.NewDeleteVpcRequest()
+	deleteVpc.VpcId = vpcId
+	if _, err := guangzhou.UseVpcClient().DeleteVpc(deleteVpc); errorCode(err) != CodeResourceNotFound {
+		t.Errorf("expect error %s deleting the vpc of another region, got %v", CodeResourceNotFound, err)
+	}
+	if _, err := shanghai.DeleteVpc(deleteVpc); err != nil {
+		t.Errorf("expect the vpc deleted in its region, got %v", err)
+	}
+}
+
+func TestServerStateDelay(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.StateDelay = 2
+	client := newTestClient(server, "secret")
+
+	run := cvm.NewRunInstancesRequest()
+	run.Placement = &cvm.Placement{Zone: helper.String("ap-guangzhou-3")}
+	run.InstanceType = helper.String("S5.MEDIUM2")
+	run.ImageId = helper.String("img-fake")
+	runResponse, err := client.UseCvmClient().RunInstances(run)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	instanceId := runResponse.Response.InstanceIdSet[0]
+
+	describe := cvm.NewDescribeInstancesRequest()
+	describe.InstanceIds = []*string{instanceId}
+	for _, expected := range []string{"PENDING", "PENDING", "RUNNING", "RUNNING"} {
+		response, err := client.UseCvmClient().DescribeInstances(describe)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if state := *response.Response.InstanceSet[0].InstanceState; state != expected {
+			t.Errorf("expect instance state %s, got %s", expected, state)
+		}
+	}
+
+	create := cbs.NewCreateDisksRequest()
+	create.Placement = &cbs.Placement{Zone: helper.String("ap-guangzhou-3")}
+	create.DiskType = helper.String("CLOUD_PREMIUM")
+	create.DiskSize = helper.Uint64(50)
+	create.DiskChargeType = helper.String("POSTPAID_BY_HOUR")
+	createResponse, err := client.UseCbsClient().CreateDisks(create)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	diskId := createResponse.Response.DiskIdSet[0]
+
+	attach := cbs.NewAttachDisksRequest()
+	attach.DiskIds = []*string{diskId}
+	attach.InstanceId = instanceId
+	if _, err := client.UseCbsClient().AttachDisks(attach); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.UseCbsClient().AttachDisks(attach); errorCode(err) != "InvalidDisk.Busy" {
+		t.Errorf("expect error InvalidDisk.Busy attaching the attaching disk, got %v", err)
+	}
+
+	describeDisks := cbs.NewDescribeDisksRequest()
+	describeDisks.DiskIds = []*string{diskId}
+	for _, expected := range []string{"ATTACHING", "ATTACHING", "ATTACHED"} {
+		response, err := client.UseCbsClient().DescribeDisks(describeDisks)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if state := *response.Response.DiskSet[0].DiskState; state != expected {
+			t.Errorf("expect disk state %s, got %s", expected, state)
+		}
+	}
+
+	terminate := cvm.NewTerminateInstancesRequest()
+	terminate.InstanceIds = []*string{instanceId}
+	if _, err := client.UseCvmClient().TerminateInstances(terminate); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, expected := range []int{1, 1, 0} {
+		response, err := client.UseCvmClient().DescribeInstances(describe)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if count := len(response.Response.InstanceSet); count != expected {
+			t.Errorf("expect %d instances while terminating, got %d", expected, count)
+		}
+	}
+
+	// the system disk is deleted with the instance, the attached data disk is detached
+	allDisks, err := client.UseCbsClient().DescribeDisks(cbs.NewDescribeDisksRequest())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(allDisks.Response.DiskSet) != 1 || *allDisks.Response.DiskSet[0].DiskState != "UNATTACHED" {
+		t.Errorf("expect the data disk detached, got %s", allDisks.ToJsonString())
+	}
+}
@@ -0,0 +1,179 @@
+package fakeapi
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+
+	// stateGone is the target state of the resources being deleted, they are removed when it is reached
+	stateGone = ""
+)
+
+// transition is the state of a resource which changes to the target after seen by `remain` Describe requests
+type transition struct {
+	state  string
+	target string
+	remain int
+	moving bool
+}
+
+// moveTo changes the state to the target after `delay` Describe requests, or immediately if delay is 0
+func (t *transition) moveTo(state, target string, delay int) {
+	t.state, t.target, t.remain, t.moving = state, target, delay, delay > 0
+	if !t.moving {
+		t.state = target
+	}
+}
+
+// observe returns the state seen by a Describe request, which counts down the transition
+func (t *transition) observe() string {
+	if t.moving {
+		if t.remain > 0 {
+			t.remain--
+		} else {
+			t.state, t.moving = t.target, false
+		}
+	}
+	return t.state
+}
+
+// filter is the `Filters` of Describe requests, which are of the same shape in all the services
+type filter struct {
+	Name   string   `json:"Name"`
+	Values []string `json:"Values"`
+}
+
+// filters returns the `Filters` of the request
+func (r *Request) filters() ([]filter, error) {
+	var params struct {
+		Filters []filter `json:"Filters"`
+	}
+	if err := r.Bind(&params); err != nil {
+		return nil, err
+	}
+	return params.Filters, nil
+}
+
+// matchFilters returns whether the resource matches all the filters, `attribute` returns the values of
+// the filter name and whether the filter is supported, the unsupported filters are rejected.
+func matchFilters(filters []filter, attribute func(name string) ([]string, bool)) (bool, error) {
+	for _, f := range filters {
+		values, ok := attribute(f.Name)
+		if !ok {
+			return false, errorf("InvalidParameterValue.FilterNotSupported", "filter %s is not supported by the fake server", f.Name)
+		}
+		if !containsAny(values, f.Values) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchIds returns whether the id is one of ids, all the ids are matched if ids is empty
+func matchIds(ids []*string, id string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, v := range ids {
+		if v != nil && *v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values, expected []string) bool {
+	for _, v := range values {
+		for _, e := range expected {
+			if v == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// page returns the range of the page in a set of `total` items
+func page(total int, offset, limit int64) (start, end int, err error) {
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if offset < 0 || limit < 0 || limit > maxLimit {
+		return 0, 0, errorf("InvalidParameterValue.Range", "offset %d or limit %d is out of range [0, %d]", offset, limit, maxLimit)
+	}
+	start, end = int(offset), int(offset+limit)
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	return
+}
+
+// stringInt64 parses the `Offset` and `Limit` of VPC, which are strings
+func stringInt64(s *string) (int64, error) {
+	if s == nil || *s == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(*s, 10, 64)
+	if err != nil {
+		return 0, errorf(CodeInvalidParameter, "%s is not an integer", *s)
+	}
+	return v, nil
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func uint64Value(v *uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func boolString(b *bool) string {
+	if b == nil {
+		return "false"
+	}
+	return strconv.FormatBool(*b)
+}
+
+func now() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
+
+// tagAttribute returns the values of the tag filters, which are `tag-key` and `tag:<key>`
+func tagAttribute(name string, tags map[string]string) ([]string, bool) {
+	if name == "tag-key" {
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		return keys, true
+	}
+	if strings.HasPrefix(name, "tag:") {
+		if v, ok := tags[strings.TrimPrefix(name, "tag:")]; ok {
+			return []string{v}, true
+		}
+		return nil, true
+	}
+	return nil, false
+}
@@ -0,0 +1,75 @@
+package fakeapi
+
+import (
+	"sort"
+
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// the tags are kept by the resources of the other services, the tag service only reads them
+
+func (me *Server) registerTag() {
+	me.handle("tag", "DescribeResourceTagsByResourceIds", me.describeResourceTagsByResourceIds)
+}
+
+func (me *Server) describeResourceTagsByResourceIds(request *Request) (interface{}, error) {
+	var params tag.DescribeResourceTagsByResourceIdsRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	region := stringValue(params.ResourceRegion)
+	matched := make([]*tag.TagResource, 0)
+	for _, resourceId := range params.ResourceIds {
+		tags := me.resourceTags(stringValue(params.ServiceType), stringValue(params.ResourcePrefix), region, stringValue(resourceId))
+		keys := make([]string, 0, len(tags))
+		for key := range tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			matched = append(matched, &tag.TagResource{
+				TagKey:      helper.String(key),
+				TagValue:    helper.String(tags[key]),
+				ResourceId:  resourceId,
+				ServiceType: params.ServiceType,
+			})
+		}
+	}
+
+	start, end, err := page(len(matched), int64(uint64Value(params.Offset)), int64(uint64Value(params.Limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag.DescribeResourceTagsByResourceIdsResponseParams{
+		TotalCount: helper.IntUint64(len(matched)),
+		Offset:     params.Offset,
+		Limit:      params.Limit,
+		Tags:       matched[start:end],
+	}, nil
+}
+
+// resourceTags returns the tags of the resource, which has no tags if it is not found
+func (me *Server) resourceTags(serviceType, resourcePrefix, region, resourceId string) map[string]string {
+	switch serviceType + "/" + resourcePrefix {
+	case "cvm/instance":
+		if item := me.findInstance(resourceId); item != nil {
+			return cvmTags(item.Tags)
+		}
+	case "vpc/vpc":
+		if item := me.findVpc(region, resourceId); item != nil {
+			return vpcTags(item.TagSet)
+		}
+	case "vpc/subnet":
+		if item := me.findSubnet(region, resourceId); item != nil {
+			return vpcTags(item.TagSet)
+		}
+	case "clb/clb":
+		if item := me.findLoadBalancer(region, resourceId); item != nil {
+			return clbTags(item.Tags)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,541 @@
+package fakeapi
+
+import (
+	"net"
+	"strings"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// the VPC resources belong to the region of the request creating them, and are only seen by the requests
+// of the same region
+
+type vpcState struct {
+	*vpc.Vpc
+	region string
+}
+
+type subnetState struct {
+	*vpc.Subnet
+	region string
+}
+
+type securityGroupState struct {
+	*vpc.SecurityGroup
+	region string
+}
+
+func (me *Server) registerVpc() {
+	me.handle("vpc", "CreateVpc", me.createVpc)
+	me.handle("vpc", "DescribeVpcs", me.describeVpcs)
+	me.handle("vpc", "ModifyVpcAttribute", me.modifyVpcAttribute)
+	me.handle("vpc", "DeleteVpc", me.deleteVpc)
+	me.handle("vpc", "DescribeRouteTables", me.describeRouteTables)
+	me.handle("vpc", "CreateSubnet", me.createSubnet)
+	me.handle("vpc", "DescribeSubnets", me.describeSubnets)
+	me.handle("vpc", "ModifySubnetAttribute", me.modifySubnetAttribute)
+	me.handle("vpc", "DeleteSubnet", me.deleteSubnet)
+	me.handle("vpc", "CreateSecurityGroup", me.createSecurityGroup)
+	me.handle("vpc", "DescribeSecurityGroups", me.describeSecurityGroups)
+	me.handle("vpc", "DeleteSecurityGroup", me.deleteSecurityGroup)
+}
+
+func (me *Server) createVpc(request *Request) (interface{}, error) {
+	var params vpc.CreateVpcRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if _, _, err := net.ParseCIDR(stringValue(params.CidrBlock)); err != nil {
+		return nil, errorf("InvalidParameterValue.Malformed", "cidr block %s is malformed", stringValue(params.CidrBlock))
+	}
+
+	item := &vpc.Vpc{
+		VpcId:           helper.String(me.newId("vpc")),
+		VpcName:         params.VpcName,
+		CidrBlock:       params.CidrBlock,
+		IsDefault:       helper.Bool(false),
+		EnableMulticast: helper.Bool(stringValue(params.EnableMulticast) == "true"),
+		CreatedTime:     helper.String(now()),
+		DnsServerSet:    params.DnsServers,
+		DomainName:      params.DomainName,
+		TagSet:          params.Tags,
+	}
+	if item.DnsServerSet == nil {
+		item.DnsServerSet = []*string{}
+	}
+	me.vpcs = append(me.vpcs, &vpcState{Vpc: item, region: request.Region})
+
+	return &vpc.CreateVpcResponseParams{Vpc: item}, nil
+}
+
+func (me *Server) describeVpcs(request *Request) (interface{}, error) {
+	var params vpc.DescribeVpcsRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*vpc.Vpc, 0)
+	for _, item := range me.vpcs {
+		if item.region != request.Region || !matchIds(params.VpcIds, *item.VpcId) {
+			continue
+		}
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "vpc-id":
+				return []string{*item.VpcId}, true
+			case "vpc-name":
+				return []string{stringValue(item.VpcName)}, true
+			case "cidr-block":
+				return []string{stringValue(item.CidrBlock)}, true
+			case "is-default":
+				return []string{boolString(item.IsDefault)}, true
+			}
+			return tagAttribute(name, vpcTags(item.TagSet))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item.Vpc)
+		}
+	}
+
+	offset, err := stringInt64(params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := stringInt64(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeVpcsResponseParams{
+		TotalCount: helper.IntUint64(len(matched)),
+		VpcSet:     matched[start:end],
+	}, nil
+}
+
+func (me *Server) modifyVpcAttribute(request *Request) (interface{}, error) {
+	var params vpc.ModifyVpcAttributeRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	item := me.findVpc(request.Region, stringValue(params.VpcId))
+	if item == nil {
+		return nil, errorf(CodeResourceNotFound, "vpc %s is not found", stringValue(params.VpcId))
+	}
+	if params.VpcName != nil {
+		item.VpcName = params.VpcName
+	}
+	if params.EnableMulticast != nil {
+		item.EnableMulticast = helper.Bool(*params.EnableMulticast == "true")
+	}
+	if params.DnsServers != nil {
+		item.DnsServerSet = params.DnsServers
+	}
+	if params.DomainName != nil {
+		item.DomainName = params.DomainName
+	}
+	return &vpc.ModifyVpcAttributeResponseParams{}, nil
+}
+
+func (me *Server) deleteVpc(request *Request) (interface{}, error) {
+	var params vpc.DeleteVpcRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	vpcId := stringValue(params.VpcId)
+	for _, subnet := range me.subnets {
+		if subnet.region == request.Region && *subnet.VpcId == vpcId {
+			return nil, errorf(CodeResourceInUse, "vpc %s is in use by subnet %s", vpcId, *subnet.SubnetId)
+		}
+	}
+	for i, item := range me.vpcs {
+		if item.region == request.Region && *item.VpcId == vpcId {
+			me.vpcs = append(me.vpcs[:i], me.vpcs[i+1:]...)
+			return &vpc.DeleteVpcResponseParams{}, nil
+		}
+	}
+	return nil, errorf(CodeResourceNotFound, "vpc %s is not found", vpcId)
+}
+
+// describeRouteTables returns the main route tables of the VPCs, which are created along with them
+func (me *Server) describeRouteTables(request *Request) (interface{}, error) {
+	var params vpc.DescribeRouteTablesRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*vpc.RouteTable, 0)
+	for _, item := range me.vpcs {
+		routeTableId := mainRouteTableId(*item.VpcId)
+		if item.region != request.Region || !matchIds(params.RouteTableIds, routeTableId) {
+			continue
+		}
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "route-table-id":
+				return []string{routeTableId}, true
+			case "vpc-id":
+				return []string{*item.VpcId}, true
+			case "association.main":
+				return []string{"true"}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, &vpc.RouteTable{
+				VpcId:          item.VpcId,
+				RouteTableId:   helper.String(routeTableId),
+				RouteTableName: helper.String("default"),
+				Main:           helper.Bool(true),
+				CreatedTime:    item.CreatedTime,
+				AssociationSet: []*vpc.RouteTableAssociation{},
+				RouteSet:       []*vpc.Route{},
+				TagSet:         []*vpc.Tag{},
+			})
+		}
+	}
+
+	offset, err := stringInt64(params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := stringInt64(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeRouteTablesResponseParams{
+		TotalCount:    helper.IntUint64(len(matched)),
+		RouteTableSet: matched[start:end],
+	}, nil
+}
+
+func (me *Server) createSubnet(request *Request) (interface{}, error) {
+	var params vpc.CreateSubnetRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	vpcId := stringValue(params.VpcId)
+	var owner *vpcState
+	for _, item := range me.vpcs {
+		if item.region == request.Region && *item.VpcId == vpcId {
+			owner = item
+		}
+	}
+	if owner == nil {
+		return nil, errorf(CodeResourceNotFound, "vpc %s is not found", vpcId)
+	}
+	if stringValue(params.Zone) == "" {
+		return nil, errorf("MissingParameter", "zone is required")
+	}
+
+	cidr := stringValue(params.CidrBlock)
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errorf("InvalidParameterValue.Malformed", "cidr block %s is malformed", cidr)
+	}
+	_, vpcNetwork, _ := net.ParseCIDR(*owner.CidrBlock)
+	if !cidrContains(vpcNetwork, network) {
+		return nil, errorf("InvalidParameterValue.SubnetRange", "cidr block %s is out of vpc %s", cidr, *owner.CidrBlock)
+	}
+	for _, subnet := range me.subnets {
+		_, other, _ := net.ParseCIDR(*subnet.CidrBlock)
+		if *subnet.VpcId == vpcId && (other.Contains(network.IP) || network.Contains(other.IP)) {
+			return nil, errorf("InvalidParameterValue.SubnetConflict", "cidr block %s conflicts with subnet %s", cidr, *subnet.SubnetId)
+		}
+	}
+
+	ones, bits := network.Mask.Size()
+	item := &vpc.Subnet{
+		VpcId:                   params.VpcId,
+		SubnetId:                helper.String(me.newId("subnet")),
+		SubnetName:              params.SubnetName,
+		CidrBlock:               params.CidrBlock,
+		IsDefault:               helper.Bool(false),
+		EnableBroadcast:         helper.Bool(false),
+		Zone:                    params.Zone,
+		RouteTableId:            helper.String(mainRouteTableId(*owner.VpcId)),
+		CreatedTime:             helper.String(now()),
+		AvailableIpAddressCount: helper.Uint64(uint64(1)<<uint(bits-ones) - 3),
+		TotalIpAddressCount:     helper.Uint64(uint64(1) << uint(bits-ones)),
+		IsRemoteVpcSnat:         helper.Bool(false),
+		TagSet:                  params.Tags,
+	}
+	me.subnets = append(me.subnets, &subnetState{Subnet: item, region: request.Region})
+
+	return &vpc.CreateSubnetResponseParams{Subnet: item}, nil
+}
+
+func (me *Server) describeSubnets(request *Request) (interface{}, error) {
+	var params vpc.DescribeSubnetsRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*vpc.Subnet, 0)
+	for _, item := range me.subnets {
+		if item.region != request.Region || !matchIds(params.SubnetIds, *item.SubnetId) {
+			continue
+		}
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "subnet-id":
+				return []string{*item.SubnetId}, true
+			case "vpc-id":
+				return []string{*item.VpcId}, true
+			case "subnet-name":
+				return []string{stringValue(item.SubnetName)}, true
+			case "cidr-block":
+				return []string{stringValue(item.CidrBlock)}, true
+			case "zone":
+				return []string{stringValue(item.Zone)}, true
+			case "is-default":
+				return []string{boolString(item.IsDefault)}, true
+			case "is-remote-vpc-snat":
+				return []string{boolString(item.IsRemoteVpcSnat)}, true
+			}
+			return tagAttribute(name, vpcTags(item.TagSet))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item.Subnet)
+		}
+	}
+
+	offset, err := stringInt64(params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := stringInt64(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeSubnetsResponseParams{
+		TotalCount: helper.IntUint64(len(matched)),
+		SubnetSet:  matched[start:end],
+	}, nil
+}
+
+func (me *Server) modifySubnetAttribute(request *Request) (interface{}, error) {
+	var params vpc.ModifySubnetAttributeRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	item := me.findSubnet(request.Region, stringValue(params.SubnetId))
+	if item == nil {
+		return nil, errorf(CodeResourceNotFound, "subnet %s is not found", stringValue(params.SubnetId))
+	}
+	if params.SubnetName != nil {
+		item.SubnetName = params.SubnetName
+	}
+	if params.EnableBroadcast != nil {
+		item.EnableBroadcast = helper.Bool(*params.EnableBroadcast == "true")
+	}
+	return &vpc.ModifySubnetAttributeResponseParams{}, nil
+}
+
+func (me *Server) deleteSubnet(request *Request) (interface{}, error) {
+	var params vpc.DeleteSubnetRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	subnetId := stringValue(params.SubnetId)
+	for _, instance := range me.instances {
+		if instance.VirtualPrivateCloud != nil && stringValue(instance.VirtualPrivateCloud.SubnetId) == subnetId {
+			return nil, errorf(CodeResourceInUse, "subnet %s is in use by instance %s", subnetId, *instance.InstanceId)
+		}
+	}
+	for i, item := range me.subnets {
+		if item.region == request.Region && *item.SubnetId == subnetId {
+			me.subnets = append(me.subnets[:i], me.subnets[i+1:]...)
+			return &vpc.DeleteSubnetResponseParams{}, nil
+		}
+	}
+	return nil, errorf(CodeResourceNotFound, "subnet %s is not found", subnetId)
+}
+
+func (me *Server) createSecurityGroup(request *Request) (interface{}, error) {
+	var params vpc.CreateSecurityGroupRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	if stringValue(params.GroupName) == "" {
+		return nil, errorf("MissingParameter", "group name is required")
+	}
+
+	projectId := stringValue(params.ProjectId)
+	if projectId == "" {
+		projectId = "0"
+	}
+	item := &vpc.SecurityGroup{
+		SecurityGroupId:   helper.String(me.newId("sg")),
+		SecurityGroupName: params.GroupName,
+		SecurityGroupDesc: params.GroupDescription,
+		ProjectId:         helper.String(projectId),
+		IsDefault:         helper.Bool(false),
+		CreatedTime:       helper.String(now()),
+		UpdateTime:        helper.String(now()),
+		TagSet:            params.Tags,
+	}
+	me.securityGroups = append(me.securityGroups, &securityGroupState{SecurityGroup: item, region: request.Region})
+
+	return &vpc.CreateSecurityGroupResponseParams{SecurityGroup: item}, nil
+}
+
+func (me *Server) describeSecurityGroups(request *Request) (interface{}, error) {
+	var params vpc.DescribeSecurityGroupsRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+	filters, err := request.filters()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*vpc.SecurityGroup, 0)
+	for _, item := range me.securityGroups {
+		if item.region != request.Region || !matchIds(params.SecurityGroupIds, *item.SecurityGroupId) {
+			continue
+		}
+		ok, err := matchFilters(filters, func(name string) ([]string, bool) {
+			switch name {
+			case "security-group-id":
+				return []string{*item.SecurityGroupId}, true
+			case "security-group-name":
+				return []string{stringValue(item.SecurityGroupName)}, true
+			case "project-id":
+				return []string{stringValue(item.ProjectId)}, true
+			}
+			return tagAttribute(name, vpcTags(item.TagSet))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item.SecurityGroup)
+		}
+	}
+
+	offset, err := stringInt64(params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := stringInt64(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeSecurityGroupsResponseParams{
+		TotalCount:       helper.IntUint64(len(matched)),
+		SecurityGroupSet: matched[start:end],
+	}, nil
+}
+
+func (me *Server) deleteSecurityGroup(request *Request) (interface{}, error) {
+	var params vpc.DeleteSecurityGroupRequestParams
+	if err := request.Bind(&params); err != nil {
+		return nil, err
+	}
+
+	sgId := stringValue(params.SecurityGroupId)
+	for _, instance := range me.instances {
+		if containsAny(stringValues(instance.SecurityGroupIds), []string{sgId}) {
+			return nil, errorf(CodeResourceInUse, "security group %s is in use by instance %s", sgId, *instance.InstanceId)
+		}
+	}
+	for i, item := range me.securityGroups {
+		if item.region == request.Region && *item.SecurityGroupId == sgId {
+			me.securityGroups = append(me.securityGroups[:i], me.securityGroups[i+1:]...)
+			return &vpc.DeleteSecurityGroupResponseParams{}, nil
+		}
+	}
+	return nil, errorf(CodeResourceNotFound, "security group %s is not found", sgId)
+}
+
+func (me *Server) findVpc(region, vpcId string) *vpcState {
+	for _, item := range me.vpcs {
+		if item.region == region && *item.VpcId == vpcId {
+			return item
+		}
+	}
+	return nil
+}
+
+func (me *Server) findSubnet(region, subnetId string) *subnetState {
+	for _, item := range me.subnets {
+		if item.region == region && *item.SubnetId == subnetId {
+			return item
+		}
+	}
+	return nil
+}
+
+// mainRouteTableId returns the id of the main route table of the VPC, e.g. `rtb-00000001` of `vpc-00000001`
+func mainRouteTableId(vpcId string) string {
+	return "rtb-" + strings.TrimPrefix(vpcId, "vpc-")
+}
+
+// cidrContains returns whether inner is a subnet of outer
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerOnes >= outerOnes
+}
+
+func vpcTags(tags []*vpc.Tag) map[string]string {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[stringValue(tag.Key)] = stringValue(tag.Value)
+	}
+	return values
+}
+
+func stringValues(values []*string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, stringValue(v))
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateUpgradeFunc upgrades the raw state of a resource from a schema version to the next one
+type StateUpgradeFunc func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error)
+
+// StateUpgrade is the upgrade of the raw state from a prior schema version to the next one. Schema is the
+// schema of the prior version, which is used to decode the prior state, the current schema of the resource
+// is taken if it is nil, so it must be set when the prior version has attributes which are not in the current schema.
+type StateUpgrade struct {
+	Schema  map[string]*schema.Schema
+	Upgrade StateUpgradeFunc
+}
+
+// WithStateUpgraders sets the SchemaVersion of the resource to the count of upgrades, and adds the upgrades as
+// the StateUpgraders of version 0, 1, ... in order.
+func WithStateUpgraders(r *schema.Resource, upgrades ...StateUpgrade) *schema.Resource {
+	impliedType := r.CoreConfigSchema().ImpliedType()
+
+	r.SchemaVersion = len(upgrades)
+	r.StateUpgraders = make([]schema.StateUpgrader, 0, len(upgrades))
+	for version, upgrade := range upgrades {
+		priorType := impliedType
+		if upgrade.Schema != nil {
+			priorType = (&schema.Resource{Schema: upgrade.Schema}).CoreConfigSchema().ImpliedType()
+		}
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    priorType,
+			Upgrade: schema.StateUpgradeFunc(upgrade.Upgrade),
+		})
+	}
+	return r
+}
+
+// UpgradeStateId returns a StateUpgrade which replaces the id of the raw state with the one returned by
+// upgradeId, the raw state is kept as it is if the new id is empty. The prior version is taken to only
+// differ from the current schema in the id.
+func UpgradeStateId(upgradeId func(id string, rawState map[string]interface{}) (string, error)) StateUpgrade {
+	return StateUpgrade{Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		id := StateString(rawState, "id")
+		newId, err := upgradeId(id, rawState)
+		if err != nil {
+			return nil, fmt.Errorf("upgrade id %s of state fail, reason: %v", id, err)
+		}
+		if newId != "" && newId != id {
+			log.Printf("[DEBUG] upgrade id of state from [%s] to [%s]", id, newId)
+			rawState["id"] = newId
+		}
+		return rawState, nil
+	}}
+}
+
+// RenameStateAttributes returns a StateUpgrade which moves the values of the attributes renamed from the keys
+// of renames to its values, priorSchema is the schema of the prior version which has the old attributes.
+// The value of the new attribute is kept if it has been set already.
+func RenameStateAttributes(priorSchema map[string]*schema.Schema, renames map[string]string) StateUpgrade {
+	return StateUpgrade{Schema: priorSchema, Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		for oldKey, newKey := range renames {
+			value, ok := rawState[oldKey]
+			if !ok {
+				continue
+			}
+			delete(rawState, oldKey)
+			if current, ok := rawState[newKey]; ok && current != nil && current != "" {
+				continue
+			}
+			log.Printf("[DEBUG] upgrade attribute %s of state to %s", oldKey, newKey)
+			rawState[newKey] = value
+		}
+		return rawState, nil
+	}}
+}
+
+// StateString returns the string attribute of the raw state, empty string is returned if it is missing
+func StateString(rawState map[string]interface{}, key string) string {
+	if v, ok := rawState[key].(string); ok {
+		return v
+	}
+	return ""
+}
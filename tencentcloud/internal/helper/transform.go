@@ -72,6 +72,13 @@ func PString(pointer *string) string {
 	return *pointer
 }
 
+func PFloat64(pointer *float64) float64 {
+	if pointer == nil {
+		return 0
+	}
+	return *pointer
+}
+
 func PUint64(pointer *uint64) uint64 {
 	return *pointer
 }
@@ -36,6 +36,29 @@ provider "tencentcloud" {
     policy           = var.policy
   }
 }
+
+#Configure the TencentCloud Provider with the CAM role of current CVM
+provider "tencentcloud" {
+  region        = var.region
+  cam_role_name = var.cam_role_name
+}
+
+#Configure the TencentCloud Provider with the OIDC token of CI pipelines
+provider "tencentcloud" {
+  region = var.region
+  assume_role_with_web_identity {
+    provider_id             = var.provider_id
+    role_arn                = var.role_arn
+    session_name            = var.session_name
+    web_identity_token_file = var.web_identity_token_file
+  }
+}
+
+#Configure the TencentCloud Provider with shared credentials
+provider "tencentcloud" {
+  profile                = "default"
+  shared_credentials_dir = "~/.tccli"
+}
 ```
 
 Resources List
@@ -246,6 +269,7 @@ Cloud Block Storage(CBS)
     tencentcloud_cbs_storages
 	tencentcloud_cbs_storages_set
     tencentcloud_cbs_snapshot_policies
+	tencentcloud_cbs_storage_price
 
   Resource
     tencentcloud_cbs_storage
@@ -390,6 +414,7 @@ Cloud Virtual Machine(CVM)
     tencentcloud_placement_groups
     tencentcloud_reserved_instance_configs
     tencentcloud_reserved_instances
+	tencentcloud_instance_price
 	tencentcloud_cvm_instances_modification
 	tencentcloud_cvm_instance_vnc_url
 	tencentcloud_cvm_disaster_recover_group_quota
@@ -557,6 +582,7 @@ Tencent Kubernetes Engine(TKE)
     tencentcloud_kubernetes_cluster_common_names
 	tencentcloud_kubernetes_available_cluster_versions
 	tencentcloud_kubernetes_cluster_authentication_options
+	tencentcloud_kubernetes_node_pool_price
 
   Resource
     tencentcloud_kubernetes_cluster
@@ -631,6 +657,7 @@ TencentDB for MySQL(cdb)
 	tencentcloud_mysql_error_log
 	tencentcloud_mysql_project_security_group
 	tencentcloud_mysql_ro_min_scale
+	tencentcloud_mysql_instance_price
 
   Resource
     tencentcloud_mysql_instance
@@ -760,6 +787,7 @@ TencentDB for Redis(crs)
 	tencentcloud_redis_instance_zone_info
 	tencentcloud_redis_instance_task_list
 	tencentcloud_redis_instance_node_info
+	tencentcloud_redis_instance_price
 
   Resource
     tencentcloud_redis_instance
@@ -1606,6 +1634,9 @@ ClickHouse(CDWCH)
 	tencentcloud_clickhouse_delete_backup_data
 
 Tag
+  Data Source
+	tencentcloud_tag_resources
+
   Resource
 	tencentcloud_tag
 	tencentcloud_tag_attachment
@@ -1638,11 +1669,18 @@ WeData
 package tencentcloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -1660,26 +1698,47 @@ const (
 	PROVIDER_ASSUME_ROLE_ARN              = "TENCENTCLOUD_ASSUME_ROLE_ARN"
 	PROVIDER_ASSUME_ROLE_SESSION_NAME     = "TENCENTCLOUD_ASSUME_ROLE_SESSION_NAME"
 	PROVIDER_ASSUME_ROLE_SESSION_DURATION = "TENCENTCLOUD_ASSUME_ROLE_SESSION_DURATION"
+	PROVIDER_PROFILE                      = "TENCENTCLOUD_PROFILE"
+	PROVIDER_SHARED_CREDENTIALS_DIR       = "TENCENTCLOUD_SHARED_CREDENTIALS_DIR"
+	PROVIDER_CAM_ROLE_NAME                = "TENCENTCLOUD_CAM_ROLE_NAME"
+	PROVIDER_WEB_IDENTITY_PROVIDER_ID     = "TENCENTCLOUD_WEB_IDENTITY_PROVIDER_ID"
+	PROVIDER_WEB_IDENTITY_ROLE_ARN        = "TENCENTCLOUD_WEB_IDENTITY_ROLE_ARN"
+	PROVIDER_WEB_IDENTITY_SESSION_NAME    = "TENCENTCLOUD_WEB_IDENTITY_SESSION_NAME"
+	PROVIDER_WEB_IDENTITY_TOKEN           = "TENCENTCLOUD_WEB_IDENTITY_TOKEN"
+	PROVIDER_WEB_IDENTITY_TOKEN_FILE      = "TENCENTCLOUD_WEB_IDENTITY_TOKEN_FILE"
+	PROVIDER_RATE_LIMIT                   = "TENCENTCLOUD_RATE_LIMIT"
+	PROVIDER_RATE_LIMITS                  = "TENCENTCLOUD_RATE_LIMITS"
+	PROVIDER_CA_BUNDLE                    = "TENCENTCLOUD_CA_BUNDLE"
+	PROVIDER_CLIENT_CERT                  = "TENCENTCLOUD_CLIENT_CERT"
+	PROVIDER_CLIENT_KEY                   = "TENCENTCLOUD_CLIENT_KEY"
+)
+
+const (
+	DEFAULT_PROFILE                = "default"
+	DEFAULT_SHARED_CREDENTIALS_DIR = "~/.tccli"
 )
 
 type TencentCloudClient struct {
 	apiV3Conn *connectivity.TencentCloudClient
+
+	defaultTags map[string]string
+	ignoreTags  *IgnoreTagsConfig
 }
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_ID, nil),
-				Description: "This is the TencentCloud access key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable.",
+				Description: "This is the TencentCloud access key. It can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable or the `secretId` of the shared credentials profile.",
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_KEY, nil),
-				Description: "This is the TencentCloud secret key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable.",
+				Description: "This is the TencentCloud secret key. It can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable or the `secretKey` of the shared credentials profile.",
 				Sensitive:   true,
 			},
 			"security_token": {
@@ -1690,11 +1749,10 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 			},
 			"region": {
-				Type:         schema.TypeString,
-				Required:     true,
-				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_REGION, nil),
-				Description:  "This is the TencentCloud region. It must be provided, but it can also be sourced from the `TENCENTCLOUD_REGION` environment variables. The default input value is ap-guangzhou.",
-				InputDefault: "ap-guangzhou",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_REGION, nil),
+				Description: "This is the TencentCloud region. It can also be sourced from the `TENCENTCLOUD_REGION` environment variables or the `region` of the shared credentials profile.",
 			},
 			"protocol": {
 				Type:         schema.TypeString,
@@ -1709,6 +1767,213 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_DOMAIN, nil),
 				Description: "The root domain of the API request, Default is `tencentcloudapi.com`.",
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROFILE, nil),
+				Description: "The profile name as set in the shared credentials. It can also be sourced from the `TENCENTCLOUD_PROFILE` environment variable. If not set, the default profile created with `tccli configure` will be used.",
+			},
+			"shared_credentials_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SHARED_CREDENTIALS_DIR, nil),
+				Description: "The directory of the shared credentials. It can also be sourced from the `TENCENTCLOUD_SHARED_CREDENTIALS_DIR` environment variable. If not set this defaults to `~/.tccli`.",
+			},
+			"cam_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CAM_ROLE_NAME, nil),
+				Description: "The name of the CVM instance CAM role. If provided, the temporary credentials of this role are fetched from the CVM metadata service and refreshed before they expire, instead of using `secret_id` and `secret_key`. It can also be sourced from the `TENCENTCLOUD_CAM_ROLE_NAME` environment variable.",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with resource tag settings to apply across all resources which support `tags_all`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources, the same keys in resource `tags` take precedence.",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with resource tag settings to ignore across all resources which support `tags_all`. The ignored tags are neither read nor modified by terraform.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys to ignore across all resources.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag key prefixes to ignore across all resources.",
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block of retrying the API requests which failed with the retryable errors, e.g. `RequestLimitExceeded`. The delay between attempts grows exponentially with jitter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      connectivity.DefaultRetryPolicy.MaxAttempts,
+							ValidateFunc: validateIntegerInRange(1, 100),
+							Description:  "The max attempts of an API request, including the first one. Default is 5. The retries of one request stop when its signature is about to expire, 5 minutes after signing.",
+						},
+						"base_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      connectivity.DefaultRetryPolicy.BaseDelay.String(),
+							ValidateFunc: validateDuration,
+							Description:  "The delay before the first retry, which doubles for each next retry, e.g. `500ms`. Default is `1s`.",
+						},
+						"max_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      connectivity.DefaultRetryPolicy.MaxDelay.String(),
+							ValidateFunc: validateDuration,
+							Description:  "The max delay between retries. Default is `30s`.",
+						},
+						"extra_retryable_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The error codes to retry besides `RequestLimitExceeded` and `ClientError.NetworkError`, e.g. `ResourceInUse`. The sub codes of a code are also retried.",
+						},
+					},
+				},
+			},
+			"proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The url of the proxy of the API requests, e.g. `http://proxy.example.com:3128`. If not set, the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+			},
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CA_BUNDLE, nil),
+				Description: "The file of the PEM encoded CA certificates which are trusted besides the system ones, e.g. the corporate CA of the proxy. It can also be sourced from the `TENCENTCLOUD_CA_BUNDLE` environment variable.",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_CLIENT_CERT, nil),
+				RequiredWith: []string{"client_key"},
+				Description:  "The file of the PEM encoded client certificate for the mutual TLS. It can also be sourced from the `TENCENTCLOUD_CLIENT_CERT` environment variable.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_CLIENT_KEY, nil),
+				RequiredWith: []string{"client_cert"},
+				Description:  "The file of the PEM encoded private key of `client_cert`. It can also be sourced from the `TENCENTCLOUD_CLIENT_KEY` environment variable.",
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to skip verifying the TLS certificates of the API. Only use it in the lab environments.",
+			},
+			"log_redact_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The fields of the API requests and responses to mask in the debug log, besides the built-in ones such as `Password`, `SecretKey`, `SessionToken` and `Kubeconfig`. A field is matched if its name ends with one of them, case-insensitively.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block of the endpoints overriding the default ones of services, e.g. the private endpoints in VPC, keyed by the service name such as `cvm`, `vpc` and `cos`.",
+				Elem:        &schema.Resource{Schema: endpointsSchema()},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block of limiting the API requests per second of each action, the limit of an action is lowered when the API returns `RequestLimitExceeded` and grows back slowly.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "The limit of requests per second of the actions which are not in `limits` or the built-in limits, e.g. 50 of `cvm` and `cdb`. Default is 20. It can also be sourced from the `TENCENTCLOUD_RATE_LIMIT` environment variable.",
+						},
+						"limits": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The limits of requests per second keyed by `service` or `service.action`, e.g. `{cvm = 50, \"cvm.RunInstances\" = 10}`. It can also be sourced from the `TENCENTCLOUD_RATE_LIMITS` environment variable, e.g. `cvm=50,cvm.RunInstances=10`.",
+						},
+					},
+				},
+			},
+			"assume_role_with_web_identity": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `assume_role_with_web_identity` block. If provided, terraform will attempt to assume this role using the OIDC token issued by the identity provider, instead of using `secret_id` and `secret_key`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_PROVIDER_ID, nil),
+							Description: "The name of the OIDC identity provider. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_PROVIDER_ID`.",
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_ROLE_ARN, nil),
+							Description: "The ARN of the role to assume. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_ROLE_ARN`.",
+						},
+						"session_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_SESSION_NAME, nil),
+							Description: "The session name to use when making the AssumeRoleWithWebIdentity call. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_SESSION_NAME`.",
+						},
+						"session_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7200,
+							ValidateFunc: validateIntegerInRange(1, 43200),
+							Description:  "The duration of the session when making the AssumeRoleWithWebIdentity call. Its value ranges from 1 to 43200(seconds), and default is 7200 seconds.",
+						},
+						"web_identity_token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_TOKEN, nil),
+							Description: "The OIDC token issued by the identity provider. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_TOKEN`. One of `web_identity_token` and `web_identity_token_file` must be set.",
+						},
+						"web_identity_token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_TOKEN_FILE, nil),
+							Description: "The file containing the OIDC token, which is read again every time the credentials are renewed. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_TOKEN_FILE`. One of `web_identity_token` and `web_identity_token_file` must be set.",
+						},
+					},
+				},
+			},
 			"assume_role": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -1745,6 +2010,46 @@ func Provider() *schema.Provider {
 							Optional:    true,
 							Description: "A more restrictive policy when making the AssumeRole call. Its content must not contains `principal` elements. Notice: more syntax references, please refer to: [policies syntax logic](https://intl.cloud.tencent.com/document/product/598/10603).",
 						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The external ID of the role, which is required if the trust policy of the role contains the `qcs:external_id` condition.",
+						},
+						"source_identity": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The identity of the caller, which is recorded in the audit logs of the assumed role.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The session tags when making the AssumeRole call. Up to 50 tags can be passed.",
+						},
+						"role_chain": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The roles to assume in sequence after `role_arn`, each one is assumed using the credentials of the previous one, for example from the organization admin account to a member account.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ARN of the role to assume.",
+									},
+									"session_name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The session name to use when making the AssumeRole call. Default is the `session_name` of the `assume_role` block.",
+									},
+									"external_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The external ID of the role.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -1757,9 +2062,11 @@ func Provider() *schema.Provider {
 			"tencentcloud_availability_zones":                        dataSourceTencentCloudAvailabilityZones(),
 			"tencentcloud_availability_zones_by_product":             dataSourceTencentCloudAvailabilityZonesByProduct(),
 			"tencentcloud_projects":                                  dataSourceTencentCloudProjects(),
+			"tencentcloud_tag_resources":                             dataSourceTencentCloudTagResources(),
 			"tencentcloud_instances":                                 dataSourceTencentCloudInstances(),
 			"tencentcloud_instances_set":                             dataSourceTencentCloudInstancesSet(),
 			"tencentcloud_reserved_instances":                        dataSourceTencentCloudReservedInstances(),
+			"tencentcloud_instance_price":                            dataSourceTencentCloudInstancePrice(),
 			"tencentcloud_placement_groups":                          dataSourceTencentCloudPlacementGroups(),
 			"tencentcloud_key_pairs":                                 dataSourceTencentCloudKeyPairs(),
 			"tencentcloud_image":                                     dataSourceTencentCloudImage(),
@@ -1835,6 +2142,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_kubernetes_cluster_levels":                 datasourceTencentCloudKubernetesClusterLevels(),
 			"tencentcloud_kubernetes_cluster_common_names":           datasourceTencentCloudKubernetesClusterCommonNames(),
 			"tencentcloud_kubernetes_cluster_authentication_options": dataSourceTencentCloudKubernetesClusterAuthenticationOptions(),
+			"tencentcloud_kubernetes_node_pool_price":                dataSourceTencentCloudKubernetesNodePoolPrice(),
 			"tencentcloud_kubernetes_available_cluster_versions":     dataSourceTencentCloudKubernetesAvailableClusterVersions(),
 			"tencentcloud_eks_clusters":                              dataSourceTencentCloudEKSClusters(),
 			"tencentcloud_eks_cluster_credential":                    datasourceTencentCloudEksClusterCredential(),
@@ -1868,6 +2176,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_mysql_error_log":                           dataSourceTencentCloudMysqlErrorLog(),
 			"tencentcloud_mysql_project_security_group":              dataSourceTencentCloudMysqlProjectSecurityGroup(),
 			"tencentcloud_mysql_ro_min_scale":                        dataSourceTencentCloudMysqlRoMinScale(),
+			"tencentcloud_mysql_instance_price":                      dataSourceTencentCloudMysqlInstancePrice(),
 			"tencentcloud_cos_bucket_object":                         dataSourceTencentCloudCosBucketObject(),
 			"tencentcloud_cos_buckets":                               dataSourceTencentCloudCosBuckets(),
 			"tencentcloud_cos_batchs":                                dataSourceTencentCloudCosBatchs(),
@@ -1888,6 +2197,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_redis_instance_zone_info":                  dataSourceTencentCloudRedisInstanceZoneInfo(),
 			"tencentcloud_redis_instance_task_list":                  dataSourceTencentCloudRedisInstanceTaskList(),
 			"tencentcloud_redis_instance_node_info":                  dataSourceTencentCloudRedisInstanceNodeInfo(),
+			"tencentcloud_redis_instance_price":                      dataSourceTencentCloudRedisInstancePrice(),
 			"tencentcloud_as_scaling_configs":                        dataSourceTencentCloudAsScalingConfigs(),
 			"tencentcloud_as_scaling_groups":                         dataSourceTencentCloudAsScalingGroups(),
 			"tencentcloud_as_scaling_policies":                       dataSourceTencentCloudAsScalingPolicies(),
@@ -1895,6 +2205,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_cbs_storages_set":                          dataSourceTencentCloudCbsStoragesSet(),
 			"tencentcloud_cbs_snapshots":                             dataSourceTencentCloudCbsSnapshots(),
 			"tencentcloud_cbs_snapshot_policies":                     dataSourceTencentCloudCbsSnapshotPolicies(),
+			"tencentcloud_cbs_storage_price":                         dataSourceTencentCloudCbsStoragePrice(),
 			"tencentcloud_clb_instances":                             dataSourceTencentCloudClbInstances(),
 			"tencentcloud_clb_listeners":                             dataSourceTencentCloudClbListeners(),
 			"tencentcloud_clb_listener_rules":                        dataSourceTencentCloudClbListenerRules(),
@@ -2739,7 +3050,6 @@ func Provider() *schema.Provider {
 			"tencentcloud_ssm_secret":                                          resourceTencentCloudSsmSecret(),
 			"tencentcloud_ssm_ssh_key_pair_secret":                             resourceTencentCloudSsmSshKeyPairSecret(),
 			"tencentcloud_ssm_product_secret":                                  resourceTencentCloudSsmProductSecret(),
-			"tencentcloud_ssm_secret_version":                                  resourceTencentCloudSsmSecretVersion(),
 			"tencentcloud_cdh_instance":                                        resourceTencentCloudCdhInstance(),
 			"tencentcloud_dnspod_domain_instance":                              resourceTencentCloudDnspodDomainInstance(),
 			"tencentcloud_dnspod_record":                                       resourceTencentCloudDnspodRecord(),
@@ -3018,6 +3328,11 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+	addResourceLogFields(provider)
+	addRegionOverride(provider)
+	addTerraformContext(provider)
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -3028,21 +3343,120 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	protocol := d.Get("protocol").(string)
 	domain := d.Get("domain").(string)
 
+	// the shared credentials profile has the lowest priority, it only fills in
+	// what neither the tf config nor the environment variables provide
+	sharedProfile, err := loadSharedProfile(d.Get("profile").(string), d.Get("shared_credentials_dir").(string))
+	if err != nil {
+		return nil, err
+	}
+	if secretId == "" && secretKey == "" {
+		secretId = sharedProfile.SecretId
+		secretKey = sharedProfile.SecretKey
+		if securityToken == "" {
+			securityToken = sharedProfile.Token
+		}
+	}
+	if region == "" {
+		region = sharedProfile.Region
+	}
+
+	if region == "" {
+		return nil, fmt.Errorf("`region` must be provided, by the tf config, the `%s` environment variable or the shared credentials profile", PROVIDER_REGION)
+	}
+
 	// standard client
 	var tcClient TencentCloudClient
 	tcClient.apiV3Conn = &connectivity.TencentCloudClient{
-		Credential: common.NewTokenCredential(
+		Region:    region,
+		Protocol:  protocol,
+		Domain:    domain,
+		Endpoints: make(map[string]string),
+	}
+	transport, err := connectivity.NewTransport(connectivity.TransportConfig{
+		Proxy:      d.Get("proxy").(string),
+		CABundle:   d.Get("ca_bundle").(string),
+		ClientCert: d.Get("client_cert").(string),
+		ClientKey:  d.Get("client_key").(string),
+		Insecure:   d.Get("insecure").(bool),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tcClient.apiV3Conn.Transport = transport
+	tcClient.apiV3Conn.Redactor = connectivity.NewRedactor(helper.InterfacesStrings(d.Get("log_redact_fields").(*schema.Set).List())...)
+	if v, ok := helper.InterfacesHeadMap(d, "endpoints"); ok {
+		for service, endpoint := range v {
+			if endpoint.(string) != "" {
+				tcClient.apiV3Conn.Endpoints[service] = endpoint.(string)
+			}
+		}
+	}
+
+	retryPolicy := connectivity.DefaultRetryPolicy
+	if v, ok := helper.InterfacesHeadMap(d, "retry"); ok {
+		retryPolicy.MaxAttempts = v["max_attempts"].(int)
+		// the durations are validated by the schema
+		retryPolicy.BaseDelay, _ = time.ParseDuration(v["base_delay"].(string))
+		retryPolicy.MaxDelay, _ = time.ParseDuration(v["max_delay"].(string))
+		retryPolicy.ExtraRetryableCodes = helper.InterfacesStrings(v["extra_retryable_codes"].(*schema.Set).List())
+	}
+	tcClient.apiV3Conn.Retryer = connectivity.NewRetryer(retryPolicy)
+
+	rateLimit, rateLimits, err := parseRateLimit(d)
+	if err != nil {
+		return nil, err
+	}
+	tcClient.apiV3Conn.RateLimiter = ratelimit.NewLimiter(rateLimit, rateLimits)
+
+	webIdentityList := d.Get("assume_role_with_web_identity").(*schema.Set).List()
+
+	// the cvm role and web identity take precedence over the static credentials once they are specified
+	if camRoleName := d.Get("cam_role_name").(string); camRoleName != "" {
+		credential, err := connectivity.NewCvmRoleCredential(connectivity.DefaultCvmMetadataEndpoint, camRoleName)
+		if err != nil {
+			return nil, err
+		}
+		tcClient.apiV3Conn.Credential = credential
+	} else if len(webIdentityList) == 1 {
+		webIdentity := webIdentityList[0].(map[string]interface{})
+		err = genClientWithWebIdentity(&tcClient, webIdentityParams{
+			providerId:      webIdentity["provider_id"].(string),
+			roleArn:         webIdentity["role_arn"].(string),
+			sessionName:     webIdentity["session_name"].(string),
+			sessionDuration: webIdentity["session_duration"].(int),
+			token:           webIdentity["web_identity_token"].(string),
+			tokenFile:       webIdentity["web_identity_token_file"].(string),
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if secretId == "" || secretKey == "" {
+			return nil, fmt.Errorf("`secret_id` and `secret_key` must be provided, by the tf config, the `%s` and `%s` environment variables or the shared credentials profile", PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY)
+		}
+		tcClient.apiV3Conn.Credential = common.NewTokenCredential(
 			secretId,
 			secretKey,
 			securityToken,
-		),
-		Region:   region,
-		Protocol: protocol,
-		Domain:   domain,
+		)
+	}
+
+	if v, ok := helper.InterfacesHeadMap(d, "default_tags"); ok {
+		tcClient.defaultTags = make(map[string]string)
+		for key, value := range v["tags"].(map[string]interface{}) {
+			tcClient.defaultTags[key] = value.(string)
+		}
+	}
+	if v, ok := helper.InterfacesHeadMap(d, "ignore_tags"); ok {
+		tcClient.ignoreTags = &IgnoreTagsConfig{
+			Keys:        helper.InterfacesStrings(v["keys"].(*schema.Set).List()),
+			KeyPrefixes: helper.InterfacesStrings(v["key_prefixes"].(*schema.Set).List()),
+		}
 	}
 
 	envRoleArn := os.Getenv(PROVIDER_ASSUME_ROLE_ARN)
 	envSessionName := os.Getenv(PROVIDER_ASSUME_ROLE_SESSION_NAME)
+	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
 
 	// get assume role from env
 	if envRoleArn != "" && envSessionName != "" {
@@ -3058,42 +3472,316 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			assumeRoleSessionDuration = 7200
 		}
 
-		_ = genClientWithSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, "")
+		err = genClientWithSTS(&tcClient, assumeRoleParams{
+			roleArn:         envRoleArn,
+			sessionName:     envSessionName,
+			sessionDuration: assumeRoleSessionDuration,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// get assume role from tf config
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
+	// get assume role from tf config, the roles in chain are assumed in sequence
 	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		assumeRoleArn := assumeRole["role_arn"].(string)
-		assumeRoleSessionName := assumeRole["session_name"].(string)
-		assumeRoleSessionDuration := assumeRole["session_duration"].(int)
-		assumeRolePolicy := assumeRole["policy"].(string)
+		for _, params := range expandAssumeRoleChain(assumeRoleList[0].(map[string]interface{})) {
+			if err = genClientWithSTS(&tcClient, params); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-		_ = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy)
+	// get assume role from the shared credentials profile
+	if envRoleArn == "" && len(assumeRoleList) == 0 && sharedProfile.RoleArn != "" && sharedProfile.RoleSessionName != "" {
+		sessionDuration := sharedProfile.DurationSeconds
+		if sessionDuration == 0 {
+			sessionDuration = 7200
+		}
+		if sessionDuration < 0 || sessionDuration > 43200 {
+			return nil, fmt.Errorf("`duration-seconds` of the shared credentials profile must be in the range of 0 to 43200, got %d", sessionDuration)
+		}
+		err = genClientWithSTS(&tcClient, assumeRoleParams{
+			roleArn:         sharedProfile.RoleArn,
+			sessionName:     sharedProfile.RoleSessionName,
+			sessionDuration: sessionDuration,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &tcClient, nil
 }
 
-func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string) error {
-	// applying STS credentials
-	request := sts.NewAssumeRoleRequest()
-	request.RoleArn = helper.String(assumeRoleArn)
-	request.RoleSessionName = helper.String(assumeRoleSessionName)
-	request.DurationSeconds = helper.IntUint64(assumeRoleSessionDuration)
-	if assumeRolePolicy != "" {
-		request.Policy = helper.String(url.QueryEscape(assumeRolePolicy))
+// webIdentityParams is the params of AssumeRoleWithWebIdentity call
+type webIdentityParams struct {
+	providerId      string
+	roleArn         string
+	sessionName     string
+	sessionDuration int
+	token           string
+	tokenFile       string
+}
+
+func genClientWithWebIdentity(tcClient *TencentCloudClient, params webIdentityParams) error {
+	if params.token == "" && params.tokenFile == "" {
+		return fmt.Errorf("one of `web_identity_token` and `web_identity_token_file` must be set in `assume_role_with_web_identity`")
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := tcClient.apiV3Conn.UseStsClient().AssumeRole(request)
+
+	fetcher := func() (*connectivity.TemporaryCredential, error) {
+		// the token file is read every time, the CI runner may have rotated it
+		token := params.token
+		if params.tokenFile != "" {
+			content, err := ReadFromFile(params.tokenFile)
+			if err != nil {
+				return nil, err
+			}
+			token = strings.TrimSpace(string(content))
+		}
+
+		request := sts.NewAssumeRoleWithWebIdentityRequest()
+		request.ProviderId = helper.String(params.providerId)
+		request.WebIdentityToken = helper.String(token)
+		request.RoleArn = helper.String(params.roleArn)
+		request.RoleSessionName = helper.String(params.sessionName)
+		request.DurationSeconds = helper.IntInt64(params.sessionDuration)
+		// AssumeRoleWithWebIdentity is authenticated by the token instead of the signature
+		request.SetSkipSign(true)
+		client := tcClient.apiV3Conn.UseStsClient()
+		client.WithCredential(nil)
+		response, err := client.AssumeRoleWithWebIdentity(request)
+		if err != nil {
+			return nil, err
+		}
+		return &connectivity.TemporaryCredential{
+			SecretId:    *response.Response.Credentials.TmpSecretId,
+			SecretKey:   *response.Response.Credentials.TmpSecretKey,
+			Token:       *response.Response.Credentials.Token,
+			ExpiredTime: time.Unix(int64(*response.Response.ExpiredTime), 0),
+		}, nil
+	}
+
+	credential, err := connectivity.NewRefreshCredential("web identity "+params.roleArn, fetcher)
+	if err != nil {
+		return err
+	}
+	tcClient.apiV3Conn.Credential = credential
+	return nil
+}
+
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema, len(connectivity.EndpointServices))
+	for _, service := range connectivity.EndpointServices {
+		description := fmt.Sprintf("Use this to override the default endpoint of `%s`, either a host or an url with the scheme, e.g. `http://127.0.0.1:8080`.", service)
+		switch service {
+		case "cos", "cos_control", "ci", "pic":
+			description += " The `{bucket}` in the host is replaced by the bucket, otherwise the bucket is put in the path."
+		}
+		endpoints[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: description,
+		}
+	}
+	return endpoints
+}
+
+// parseRateLimit returns the default limit and the limits of the `rate_limit` block, which falls back
+// to the environment variables.
+func parseRateLimit(d *schema.ResourceData) (defaultLimit int64, limits map[string]int64, err error) {
+	limits = make(map[string]int64)
+
+	if v := os.Getenv(PROVIDER_RATE_LIMIT); v != "" {
+		if defaultLimit, err = strconv.ParseInt(v, 10, 64); err != nil || defaultLimit <= 0 {
+			return 0, nil, fmt.Errorf("%s must be a positive integer, got: %s", PROVIDER_RATE_LIMIT, v)
+		}
+	}
+	if v := os.Getenv(PROVIDER_RATE_LIMITS); v != "" {
+		for _, item := range strings.Split(v, ",") {
+			kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+			if len(kv) != 2 {
+				return 0, nil, fmt.Errorf("%s must be in the format of `key=limit,...`, got: %s", PROVIDER_RATE_LIMITS, v)
+			}
+			limit, parseErr := strconv.ParseInt(kv[1], 10, 64)
+			if parseErr != nil || limit <= 0 {
+				return 0, nil, fmt.Errorf("the limit of %s in %s must be a positive integer, got: %s", kv[0], PROVIDER_RATE_LIMITS, kv[1])
+			}
+			limits[kv[0]] = limit
+		}
+	}
+
+	if v, ok := helper.InterfacesHeadMap(d, "rate_limit"); ok {
+		if limit := v["default_limit"].(int); limit > 0 {
+			defaultLimit = int64(limit)
+		}
+		for key, limit := range v["limits"].(map[string]interface{}) {
+			if limit.(int) <= 0 {
+				return 0, nil, fmt.Errorf("the limit of %s in `rate_limit` must be positive", key)
+			}
+			limits[key] = int64(limit.(int))
+		}
+	}
+
+	return
+}
+
+// SharedProfile is the tccli style profile, made up of `<profile>.credential` and `<profile>.configure` files
+type SharedProfile struct {
+	SecretId        string `json:"secretId"`
+	SecretKey       string `json:"secretKey"`
+	Token           string `json:"token"`
+	RoleArn         string `json:"role-arn"`
+	RoleSessionName string `json:"role-session-name"`
+	DurationSeconds int    `json:"duration-seconds"`
+	Region          string `json:"-"`
+}
+
+type sharedConfigure struct {
+	SysParam struct {
+		Region string `json:"region"`
+	} `json:"_sys_param"`
+}
+
+// loadSharedProfile reads the profile from the shared credentials dir, missing files of
+// the default profile are ignored, while a profile set explicitly must have its credential file.
+func loadSharedProfile(profile, sharedCredentialsDir string) (*SharedProfile, error) {
+	explicit := profile != ""
+	if profile == "" {
+		profile = DEFAULT_PROFILE
+	}
+	if sharedCredentialsDir == "" {
+		sharedCredentialsDir = DEFAULT_SHARED_CREDENTIALS_DIR
+	}
+
+	dir, err := homedir.Expand(sharedCredentialsDir)
+	if err != nil {
+		return nil, fmt.Errorf("shared credentials dir (%s) homedir expand error: %s", sharedCredentialsDir, err.Error())
+	}
+
+	sharedProfile := &SharedProfile{}
+
+	credentialPath := filepath.Join(dir, profile+".credential")
+	content, err := ioutil.ReadFile(credentialPath)
+	if err != nil {
+		if !os.IsNotExist(err) || explicit {
+			return nil, fmt.Errorf("read shared credential file %s failed: %s", credentialPath, err.Error())
+		}
+	} else if err = json.Unmarshal(content, sharedProfile); err != nil {
+		return nil, fmt.Errorf("parse shared credential file %s failed: %s", credentialPath, err.Error())
+	}
+
+	configurePath := filepath.Join(dir, profile+".configure")
+	content, err = ioutil.ReadFile(configurePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("read shared configure file %s failed: %s", configurePath, err.Error())
+		}
+	} else {
+		var configure sharedConfigure
+		if err = json.Unmarshal(content, &configure); err != nil {
+			return nil, fmt.Errorf("parse shared configure file %s failed: %s", configurePath, err.Error())
+		}
+		sharedProfile.Region = configure.SysParam.Region
+	}
+
+	return sharedProfile, nil
+}
+
+// assumeRoleParams is the params of one AssumeRole call
+type assumeRoleParams struct {
+	roleArn         string
+	sessionName     string
+	sessionDuration int
+	policy          string
+	externalId      string
+	sourceIdentity  string
+	tags            map[string]string
+}
+
+// expandAssumeRoleChain returns the AssumeRole calls of the `assume_role` block, `role_arn` goes first then `role_chain`
+func expandAssumeRoleChain(assumeRole map[string]interface{}) []assumeRoleParams {
+	first := assumeRoleParams{
+		roleArn:         assumeRole["role_arn"].(string),
+		sessionName:     assumeRole["session_name"].(string),
+		sessionDuration: assumeRole["session_duration"].(int),
+		policy:          assumeRole["policy"].(string),
+	}
+	if v, ok := assumeRole["external_id"].(string); ok {
+		first.externalId = v
+	}
+	if v, ok := assumeRole["source_identity"].(string); ok {
+		first.sourceIdentity = v
+	}
+	if v, ok := assumeRole["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		first.tags = make(map[string]string, len(v))
+		for key, value := range v {
+			first.tags[key] = value.(string)
+		}
+	}
+
+	chain := []assumeRoleParams{first}
+	if v, ok := assumeRole["role_chain"].([]interface{}); ok {
+		for _, item := range v {
+			role := item.(map[string]interface{})
+			params := assumeRoleParams{
+				roleArn:         role["role_arn"].(string),
+				sessionName:     first.sessionName,
+				sessionDuration: first.sessionDuration,
+				externalId:      role["external_id"].(string),
+				sourceIdentity:  first.sourceIdentity,
+			}
+			if sessionName := role["session_name"].(string); sessionName != "" {
+				params.sessionName = sessionName
+			}
+			chain = append(chain, params)
+		}
+	}
+
+	return chain
+}
+
+func genClientWithSTS(tcClient *TencentCloudClient, params assumeRoleParams) error {
+	// the role is re-assumed with the source credential before the tmp credential expires
+	sourceCredential := tcClient.apiV3Conn.Credential
+	fetcher := func() (*connectivity.TemporaryCredential, error) {
+		// applying STS credentials
+		request := sts.NewAssumeRoleRequest()
+		request.RoleArn = helper.String(params.roleArn)
+		request.RoleSessionName = helper.String(params.sessionName)
+		request.DurationSeconds = helper.IntUint64(params.sessionDuration)
+		if params.policy != "" {
+			request.Policy = helper.String(url.QueryEscape(params.policy))
+		}
+		if params.externalId != "" {
+			request.ExternalId = helper.String(params.externalId)
+		}
+		if params.sourceIdentity != "" {
+			request.SourceIdentity = helper.String(params.sourceIdentity)
+		}
+		for key, value := range params.tags {
+			request.Tags = append(request.Tags, &sts.Tag{
+				Key:   helper.String(key),
+				Value: helper.String(value),
+			})
+		}
+		client := tcClient.apiV3Conn.UseStsClient()
+		client.WithCredential(sourceCredential)
+		response, err := client.AssumeRole(request)
+		if err != nil {
+			return nil, err
+		}
+		return &connectivity.TemporaryCredential{
+			SecretId:    *response.Response.Credentials.TmpSecretId,
+			SecretKey:   *response.Response.Credentials.TmpSecretKey,
+			Token:       *response.Response.Credentials.Token,
+			ExpiredTime: time.Unix(*response.Response.ExpiredTime, 0),
+		}, nil
+	}
+
+	credential, err := connectivity.NewRefreshCredential("assume role "+params.roleArn, fetcher)
 	if err != nil {
 		return err
 	}
 	// using STS credentials
-	tcClient.apiV3Conn.Credential = common.NewTokenCredential(
-		*response.Response.Credentials.TmpSecretId,
-		*response.Response.Credentials.TmpSecretKey,
-		*response.Response.Credentials.Token,
-	)
+	tcClient.apiV3Conn.Credential = credential
 	return nil
 }
@@ -0,0 +1,248 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkResources lists the resources implemented with the Plugin Framework, they must not be
+// registered in the ResourcesMap of Provider as well.
+var frameworkResources = []func() resource.Resource{
+	newSsmSecretVersionResource,
+}
+
+// ProviderServerFactory returns the factory of the provider server, which muxes the resources and data
+// sources implemented with the SDK and the Plugin Framework. They are served with the protocol 5 as before,
+// so the existing configurations and states keep working without any change.
+func ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return newProviderServerFactory(ctx, Provider())
+}
+
+func newProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	// the sdk provider must be the first one, as the framework provider reuses the meta it configures
+	servers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(newFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider is the Plugin Framework side of the provider. It shares the configuration and the
+// *TencentCloudClient of the sdk provider instead of configuring the clients again.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+
+	schemaOnce sync.Once
+	schema     providerschema.Schema
+	schemaErr  error
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+func newFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (me *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "tencentcloud"
+}
+
+// Schema mirrors the schema of the sdk provider, the muxed servers are required to have identical ones
+func (me *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	me.schemaOnce.Do(func() {
+		me.schema, me.schemaErr = frameworkProviderSchema(ctx, me.sdkProvider)
+	})
+	if me.schemaErr != nil {
+		resp.Diagnostics.AddError("Invalid provider schema", me.schemaErr.Error())
+		return
+	}
+	resp.Schema = me.schema
+}
+
+func (me *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := me.sdkProvider.Meta().(*TencentCloudClient)
+	if !ok || client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "the TencentCloud client is not configured by the sdk provider")
+		return
+	}
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (me *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return frameworkResources
+}
+
+func (me *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// frameworkProviderSchema converts the provider schema of the sdk provider to the Plugin Framework one
+func frameworkProviderSchema(ctx context.Context, sdkProvider *schema.Provider) (providerschema.Schema, error) {
+	resp, err := schema.NewGRPCProviderServer(sdkProvider).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return providerschema.Schema{}, err
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			return providerschema.Schema{}, fmt.Errorf("%s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	attributes, blocks, err := frameworkProviderSchemaBlock(resp.Provider.Block)
+	if err != nil {
+		return providerschema.Schema{}, err
+	}
+	return providerschema.Schema{
+		Description: resp.Provider.Block.Description,
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}, nil
+}
+
+func frameworkProviderSchemaBlock(block *tfprotov5.SchemaBlock) (map[string]providerschema.Attribute, map[string]providerschema.Block, error) {
+	attributes := make(map[string]providerschema.Attribute, len(block.Attributes))
+	for _, v := range block.Attributes {
+		attribute, err := frameworkProviderSchemaAttribute(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute %s: %s", v.Name, err)
+		}
+		attributes[v.Name] = attribute
+	}
+
+	blocks := make(map[string]providerschema.Block, len(block.BlockTypes))
+	for _, v := range block.BlockTypes {
+		nestedAttributes, nestedBlocks, err := frameworkProviderSchemaBlock(v.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block %s: %s", v.TypeName, err)
+		}
+		object := providerschema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}
+		deprecation := frameworkDeprecationMessage(v.Block.Deprecated)
+
+		switch v.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[v.TypeName] = providerschema.ListNestedBlock{NestedObject: object, Description: v.Block.Description, DeprecationMessage: deprecation}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[v.TypeName] = providerschema.SetNestedBlock{NestedObject: object, Description: v.Block.Description, DeprecationMessage: deprecation}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[v.TypeName] = providerschema.SingleNestedBlock{
+				Attributes:         nestedAttributes,
+				Blocks:             nestedBlocks,
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecation,
+			}
+		default:
+			return nil, nil, fmt.Errorf("block %s: unsupported nesting mode %s", v.TypeName, v.Nesting)
+		}
+	}
+	return attributes, blocks, nil
+}
+
+func frameworkProviderSchemaAttribute(attribute *tfprotov5.SchemaAttribute) (providerschema.Attribute, error) {
+	var (
+		required    = attribute.Required
+		optional    = attribute.Optional
+		sensitive   = attribute.Sensitive
+		description = attribute.Description
+		deprecation = frameworkDeprecationMessage(attribute.Deprecated)
+	)
+
+	switch {
+	case attribute.Type.Is(tftypes.String):
+		return providerschema.StringAttribute{Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	case attribute.Type.Is(tftypes.Number):
+		return providerschema.NumberAttribute{Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	case attribute.Type.Is(tftypes.Bool):
+		return providerschema.BoolAttribute{Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	}
+
+	elemType, err := frameworkAttrType(attribute.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch v := elemType.(type) {
+	case types.ListType:
+		return providerschema.ListAttribute{ElementType: v.ElemType, Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	case types.SetType:
+		return providerschema.SetAttribute{ElementType: v.ElemType, Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	case types.MapType:
+		return providerschema.MapAttribute{ElementType: v.ElemType, Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	case types.ObjectType:
+		return providerschema.ObjectAttribute{AttributeTypes: v.AttrTypes, Required: required, Optional: optional, Sensitive: sensitive, Description: description, DeprecationMessage: deprecation}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", attribute.Type)
+}
+
+func frameworkAttrType(typ tftypes.Type) (attr.Type, error) {
+	switch v := typ.(type) {
+	case tftypes.List:
+		elemType, err := frameworkAttrType(v.ElementType)
+		return types.ListType{ElemType: elemType}, err
+	case tftypes.Set:
+		elemType, err := frameworkAttrType(v.ElementType)
+		return types.SetType{ElemType: elemType}, err
+	case tftypes.Map:
+		elemType, err := frameworkAttrType(v.ElementType)
+		return types.MapType{ElemType: elemType}, err
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(v.AttributeTypes))
+		for name, attributeType := range v.AttributeTypes {
+			attrType, err := frameworkAttrType(attributeType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = attrType
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return types.StringType, nil
+	case typ.Is(tftypes.Number):
+		return types.NumberType, nil
+	case typ.Is(tftypes.Bool):
+		return types.BoolType, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// frameworkDeprecationMessage returns the message of the deprecated attributes and blocks, the message
+// itself is not kept in the protocol schema
+func frameworkDeprecationMessage(deprecated bool) string {
+	if deprecated {
+		return "Deprecated."
+	}
+	return ""
+}
+
+// FrameworkResources returns the resources implemented with the Plugin Framework keyed by the type name,
+// which are documented by gendoc along with the sdk ones.
+func FrameworkResources() map[string]resource.Resource {
+	resources := make(map[string]resource.Resource, len(frameworkResources))
+	for _, newResource := range frameworkResources {
+		r := newResource()
+		resp := &resource.MetadataResponse{}
+		r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "tencentcloud"}, resp)
+		resources[resp.TypeName] = r
+	}
+	return resources
+}
@@ -0,0 +1,64 @@
+package tencentcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitProviderServerSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	serverFactory, err := ProviderServerFactory(ctx)
+	assert.Nil(t, err)
+
+	// the muxed server fails with diagnostics if the provider schemas differ or a resource is served twice
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Diagnostics)
+
+	sdkProvider := Provider()
+	for name := range sdkProvider.ResourcesMap {
+		assert.Contains(t, resp.ResourceSchemas, name)
+	}
+	for name := range sdkProvider.DataSourcesMap {
+		assert.Contains(t, resp.DataSourceSchemas, name)
+	}
+	assert.Contains(t, resp.ResourceSchemas, "tencentcloud_ssm_secret_version")
+	assert.NotContains(t, sdkProvider.ResourcesMap, "tencentcloud_ssm_secret_version")
+	assert.Len(t, resp.ResourceSchemas, len(sdkProvider.ResourcesMap)+len(frameworkResources))
+
+	names := make(map[string]bool)
+	for _, v := range resp.Provider.Block.Attributes {
+		names[v.Name] = true
+	}
+	for _, v := range resp.Provider.Block.BlockTypes {
+		names[v.TypeName] = true
+	}
+	assert.Len(t, names, len(sdkProvider.Schema))
+}
+
+func TestUnitProviderServerConfigure(t *testing.T) {
+	t.Parallel()
+
+	_, meta := testFakeApiMeta(t)
+	sdkProvider := Provider()
+	frameworkProvider := newFrameworkProvider(sdkProvider)
+
+	// the framework provider can't be configured before the sdk one
+	resp := &provider.ConfigureResponse{}
+	frameworkProvider.Configure(context.TODO(), provider.ConfigureRequest{}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	// and it shares the client configured by the sdk one
+	sdkProvider.SetMeta(meta)
+	resp = &provider.ConfigureResponse{}
+	frameworkProvider.Configure(context.TODO(), provider.ConfigureRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Same(t, meta, resp.ResourceData)
+	assert.Same(t, meta, resp.DataSourceData)
+}
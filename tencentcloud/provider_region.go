@@ -0,0 +1,246 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalResources lists the resources which can be managed in a region other than the one of the provider
+// by their `region` argument, their CRUD functions, importer and CustomizeDiff run with the client of that region.
+// The CCN attachments and the GAAP real servers are left out, the CCN and GAAP APIs are global and serve the
+// resources of every region by any regional endpoint, and the attachment already names the region of the instance
+// it attaches by `instance_region`, so the argument would only replace them when the region of the provider changes.
+var regionalResources = []string{
+	"tencentcloud_cbs_storage",
+	"tencentcloud_cbs_storage_attachment",
+	"tencentcloud_cos_bucket",
+	"tencentcloud_cos_bucket_object",
+	"tencentcloud_eip",
+	"tencentcloud_instance",
+	"tencentcloud_key_pair",
+	"tencentcloud_nat_gateway",
+	"tencentcloud_route_table",
+	"tencentcloud_route_table_entry",
+	"tencentcloud_security_group",
+	"tencentcloud_security_group_lite_rule",
+	"tencentcloud_subnet",
+	"tencentcloud_vpc",
+}
+
+// regionalDataSources lists the data sources which can read a region other than the one of the provider
+// by their `region` argument.
+var regionalDataSources = []string{
+	"tencentcloud_availability_zones_by_product",
+	"tencentcloud_cbs_storages",
+	"tencentcloud_cos_buckets",
+	"tencentcloud_eips",
+	"tencentcloud_images",
+	"tencentcloud_instance_types",
+	"tencentcloud_instances",
+	"tencentcloud_key_pairs",
+	"tencentcloud_security_groups",
+	"tencentcloud_vpc_instances",
+	"tencentcloud_vpc_subnets",
+}
+
+// importIdRegionPattern matches the import id suffixed with the region of the resource, like `vpc-xxxxxxxx@ap-shanghai`
+var importIdRegionPattern = regexp.MustCompile(`^(.+)@([a-z]+(?:-[a-z0-9]+)+)$`)
+
+// withRegion returns the client of region, which shares the credential, default tags and ignored tags with me
+func (me *TencentCloudClient) withRegion(region string) *TencentCloudClient {
+	if region == "" || region == me.apiV3Conn.Region {
+		return me
+	}
+	return &TencentCloudClient{
+		apiV3Conn:   me.apiV3Conn.WithRegion(region),
+		defaultTags: me.defaultTags,
+		ignoreTags:  me.ignoreTags,
+	}
+}
+
+// regionalMeta returns the meta of region, meta is returned as is if it's not configured
+func regionalMeta(meta interface{}, region string) interface{} {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return meta
+	}
+	return client.withRegion(region)
+}
+
+// regionOf returns the region meta targets, or empty if meta is not configured
+func regionOf(meta interface{}) string {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return ""
+	}
+	return client.apiV3Conn.Region
+}
+
+// addRegionOverride adds the `region` argument to the regional resources and data sources of p.
+func addRegionOverride(p *schema.Provider) {
+	for _, name := range regionalResources {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			panic(fmt.Sprintf("regional resource %s is not registered", name))
+		}
+		if _, ok := r.Schema["region"]; ok {
+			panic(fmt.Sprintf("regional resource %s has its own region argument", name))
+		}
+		regionalResource(r)
+	}
+	for _, name := range regionalDataSources {
+		r, ok := p.DataSourcesMap[name]
+		if !ok {
+			panic(fmt.Sprintf("regional data source %s is not registered", name))
+		}
+		if _, ok := r.Schema["region"]; ok {
+			panic(fmt.Sprintf("regional data source %s has its own region argument", name))
+		}
+		regionalDataSource(r)
+	}
+}
+
+// regionalResource adds the `region` argument to r. It's optional and computed, so the state and configuration
+// without it see no diff, and it's planned as the region of the provider when it's not configured, so the
+// resource is replaced when the region it lives in changes, as it was before the argument is added. The state
+// written before the argument is added has no region, it's kept as is until the next read fills it, instead of
+// replacing the resource.
+func regionalResource(r *schema.Resource) {
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the resource, it defaults to the region of the provider. It can be set by suffixing the import id with `@<region>`.",
+	}
+
+	r.Create = regionalFunc(r.Create)
+	r.Read = regionalReadFunc(r.Read)
+	r.Update = regionalFunc(r.Update)
+	r.Delete = regionalFunc(r.Delete)
+	r.CreateContext = regionalContextFunc(r.CreateContext)
+	r.ReadContext = regionalReadContextFunc(r.ReadContext)
+	r.UpdateContext = regionalContextFunc(r.UpdateContext)
+	r.DeleteContext = regionalContextFunc(r.DeleteContext)
+	r.CreateWithoutTimeout = regionalContextFunc(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = regionalReadContextFunc(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = regionalContextFunc(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = regionalContextFunc(r.DeleteWithoutTimeout)
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.GetRawConfig().GetAttr("region").IsNull() {
+			old, _ := d.GetChange("region")
+			if region := regionOf(meta); region != "" && d.Get("region").(string) != region && (d.Id() == "" || old.(string) != "") {
+				if err := d.SetNew("region", region); err != nil {
+					return err
+				}
+			}
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, d, regionalMeta(meta, d.Get("region").(string)))
+	}
+
+	if r.Importer != nil {
+		r.Importer = regionalImporter(r.Importer)
+	}
+}
+
+// regionalDataSource adds the `region` argument to r, which is set to the region read when it's not configured.
+func regionalDataSource(r *schema.Resource) {
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The region to read, it defaults to the region of the provider.",
+	}
+
+	r.Read = regionalReadFunc(r.Read)
+	r.ReadContext = regionalReadContextFunc(r.ReadContext)
+	r.ReadWithoutTimeout = regionalReadContextFunc(r.ReadWithoutTimeout)
+}
+
+func regionalFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, regionalMeta(meta, d.Get("region").(string)))
+	}
+}
+
+func regionalContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, regionalMeta(meta, d.Get("region").(string)))
+	}
+}
+
+// regionalReadFunc sets `region` to the region read, so the state records where the resource lives
+func regionalReadFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		meta = regionalMeta(meta, d.Get("region").(string))
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return setRegion(d, meta)
+	}
+}
+
+func regionalReadContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta = regionalMeta(meta, d.Get("region").(string))
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if err := setRegion(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func setRegion(d *schema.ResourceData, meta interface{}) error {
+	if region := regionOf(meta); d.Id() != "" && region != "" {
+		return d.Set("region", region)
+	}
+	return nil
+}
+
+// regionalImporter imports the resource in the region suffixing the import id, like `vpc-xxxxxxxx@ap-shanghai`,
+// the import id without the suffix is imported in the region of the provider.
+func regionalImporter(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	stateContext := importer.StateContext
+	if stateContext == nil {
+		state := importer.State
+		stateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, meta)
+		}
+	}
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if m := importIdRegionPattern.FindStringSubmatch(d.Id()); m != nil {
+				d.SetId(m[1])
+				if err := d.Set("region", m[2]); err != nil {
+					return nil, err
+				}
+			}
+			return stateContext(ctx, d, regionalMeta(meta, d.Get("region").(string)))
+		},
+	}
+}
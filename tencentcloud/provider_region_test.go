@@ -0,0 +1,139 @@
+package tencentcloud
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRegionOverrideVpc(t *testing.T) {
+	t.Parallel()
+
+	_, meta := testFakeApiMeta(t)
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+	r := Provider().ResourcesMap["tencentcloud_vpc"]
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       "vpc-region",
+		"cidr_block": "10.0.0.0/16",
+		"region":     "ap-shanghai",
+	})
+	assert.Nil(t, r.Create(d, meta))
+	assert.Equal(t, "ap-shanghai", d.Get("region"))
+
+	// the vpc is created in ap-shanghai, and invisible in the region of the provider
+	_, has, err := (&VpcService{client: meta.apiV3Conn}).DescribeVpc(ctx, d.Id(), "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, has)
+	_, has, err = (&VpcService{client: meta.withRegion("ap-shanghai").apiV3Conn}).DescribeVpc(ctx, d.Id(), "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, has)
+	assert.Same(t, meta.apiV3Conn.WithRegion("ap-shanghai"), meta.withRegion("ap-shanghai").apiV3Conn)
+
+	// the region suffixing the import id is imported
+	imported := r.Data(nil)
+	imported.SetId(d.Id() + "@ap-shanghai")
+	states, err := r.Importer.StateContext(ctx, imported, meta)
+	assert.Nil(t, err)
+	assert.Len(t, states, 1)
+	assert.Equal(t, d.Id(), states[0].Id())
+	assert.Nil(t, r.Read(states[0], meta))
+	assert.Equal(t, d.Id(), states[0].Id())
+	assert.Equal(t, "vpc-region", states[0].Get("name"))
+
+	// the vpc is gone when it's read in the region of the provider
+	imported = r.Data(nil)
+	imported.SetId(d.Id())
+	assert.Nil(t, r.Read(imported, meta))
+	assert.Equal(t, "", imported.Id())
+
+	vpcs := Provider().DataSourcesMap["tencentcloud_vpc_instances"]
+	for region, count := range map[string]int{"": 0, "ap-shanghai": 1} {
+		data := schema.TestResourceDataRaw(t, vpcs.Schema, map[string]interface{}{"region": region})
+		assert.Nil(t, vpcs.Read(data, meta))
+		assert.Equal(t, count, data.Get("instance_list.#"), region)
+		if region == "" {
+			assert.Equal(t, defaultRegion, data.Get("region"))
+		}
+	}
+
+	assert.Nil(t, r.Delete(d, meta))
+	_, has, err = (&VpcService{client: meta.withRegion("ap-shanghai").apiV3Conn}).DescribeVpc(ctx, d.Id(), "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, has)
+}
+
+func TestUnitRegionOverrideDiff(t *testing.T) {
+	t.Parallel()
+
+	_, meta := testFakeApiMeta(t)
+	r := Provider().ResourcesMap["tencentcloud_vpc"]
+	config := map[string]interface{}{
+		"name":       "vpc-region",
+		"cidr_block": "10.0.0.0/16",
+	}
+	// the raw config is passed along with the prior state by the plugin protocol, the region is read from it
+	content, _ := json.Marshal(config)
+	rawConfig, err := ctyjson.Unmarshal(content, r.CoreConfigSchema().ImpliedType())
+	if !assert.Nil(t, err) {
+		return
+	}
+	state := func(region string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"id":           "vpc-00000001",
+			"name":         "vpc-region",
+			"cidr_block":   "10.0.0.0/16",
+			"is_multicast": "true",
+		}
+		if region != "" {
+			attributes["region"] = region
+		}
+		return &terraform.InstanceState{ID: "vpc-00000001", Attributes: attributes, RawConfig: rawConfig}
+	}
+
+	// the resource to be created is planned in the region of the provider
+	diff, err := testResourceDiff(r, &terraform.InstanceState{RawConfig: rawConfig}, config, meta)
+	if assert.Nil(t, err) && assert.NotNil(t, diff) {
+		assert.Equal(t, defaultRegion, diff.Attributes["region"].New)
+	}
+
+	// the state written before the region argument is added is not replaced
+	diff, err = testResourceDiff(r, state(""), config, meta)
+	assert.Nil(t, err)
+	assert.False(t, diff != nil && diff.RequiresNew())
+	assert.True(t, diff == nil || diff.Attributes["region"] == nil)
+
+	// the resource in the region of the provider sees no diff
+	diff, err = testResourceDiff(r, state(defaultRegion), config, meta)
+	assert.Nil(t, err)
+	assert.False(t, diff != nil && diff.RequiresNew())
+
+	// the resource is replaced when the region of the provider changes
+	diff, err = testResourceDiff(r, state("ap-shanghai"), config, meta)
+	if assert.Nil(t, err) && assert.NotNil(t, diff) {
+		assert.True(t, diff.RequiresNew())
+		assert.Equal(t, defaultRegion, diff.Attributes["region"].New)
+	}
+}
+
+func TestProviderRegionOverrideImportId(t *testing.T) {
+	for id, expected := range map[string][2]string{
+		"vpc-xxxxxxxx":                    {"vpc-xxxxxxxx", ""},
+		"vpc-xxxxxxxx@ap-shanghai":        {"vpc-xxxxxxxx", "ap-shanghai"},
+		"ins-xxxxxxxx@ap-shanghai-fsi":    {"ins-xxxxxxxx", "ap-shanghai-fsi"},
+		"bucket-1250000000@na-ashburn":    {"bucket-1250000000", "na-ashburn"},
+		"user@example.com":                {"user@example.com", ""},
+		"bucket-1250000000#path/to/key@1": {"bucket-1250000000#path/to/key@1", ""},
+	} {
+		m := importIdRegionPattern.FindStringSubmatch(id)
+		if m == nil {
+			m = []string{id, id, ""}
+		}
+		assert.Equal(t, expected, [2]string{m[1], m[2]}, id)
+	}
+}
@@ -0,0 +1,180 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The tests in this file lint the schemas of every registered resource and data source,
+// they never call any API so they always run, even without TF_ACC.
+
+var (
+	schemaAttributeNamePattern = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+	// schemaSensitiveNamePattern matches the attributes holding a secret by the end of their names,
+	// so `password` and `tmp_secret_key` match but `password_status` and `secret_name` don't,
+	// and every kubeconfig, like `kube_config_intranet`
+	schemaSensitiveNamePattern = regexp.MustCompile(`(^|_)((password|passwd|secret|secret_key|secret_string|secret_binary|private_key|token)$|kube_?config(_|$))`)
+)
+
+// nonSensitiveAttributes lists the attributes whose names look like a secret but are not,
+// keyed by the resource or data source name joined with the path of the attribute
+var nonSensitiveAttributes = map[string]bool{
+	// the idempotency token of the request
+	"tencentcloud_cvm_launch_template.client_token":         true,
+	"tencentcloud_cvm_launch_template_version.client_token": true,
+	"tencentcloud_lighthouse_instance.client_token":         true,
+	// `YES` or `NO`
+	"tencentcloud_lighthouse_instance.login_configuration.auto_generate_password": true,
+	// the name of the referenced secret
+	"tencentcloud_tem_workload.env_conf.secret": true,
+}
+
+// importIdTransformingResources lists the resources whose importer turns the import id into another id,
+// keyed by the resource name with an import id and the id expected in the state
+var importIdTransformingResources = map[string][2]string{
+	"tencentcloud_api_gateway_api":           {"service-xxxxxxxx#api-xxxxxxxx", "api-xxxxxxxx"},
+	"tencentcloud_ccn_attachment":            {"ccn-xxxxxxxx#VPC#ap-guangzhou#vpc-xxxxxxxx", "0c996097335af036b6184dd5938a29ab"},
+	"tencentcloud_ccn_bandwidth_limit":       {"ccn-xxxxxxxx#ap-guangzhou#ap-shanghai", "ccn-xxxxxxxx#ap-guangzhou"},
+	"tencentcloud_cfs_access_rule":           {"pgroup-xxxxxxxx#rule-xxxxxxxx", "rule-xxxxxxxx"},
+	"tencentcloud_cos_bucket_object":         {"bucket-1250000000#path/to/key", "bucket-1250000000path/to/key"},
+	"tencentcloud_gaap_domain_error_page":    {"listener-xxxxxxxx#www.example.com#errorPage-xxxxxxxx", "errorPage-xxxxxxxx"},
+	"tencentcloud_instance_set":              {"ins-xxxxxxxx,ins-yyyyyyyy", "aW5zLXh4eHh4eHh4O2lucy15eXl5eXl5eQ=="},
+	"tencentcloud_kubernetes_scale_worker":   {"cls-xxxxxxxx#ins-xxxxxxxx", "TkeScaleWorker.ad4f9a0980c8c201722b6be13d925290"},
+	"tencentcloud_mongodb_instance_backup":   {"cmgo-xxxxxxxx#backup-name", "cmgo-xxxxxxxx#backup-name"},
+	"tencentcloud_postgresql_readonly_group": {"postgres-xxxxxxxx#pgrogrp-xxxxxxxx", "pgrogrp-xxxxxxxx"},
+	"tencentcloud_tcaplus_idl":               {"19162256624#1234", `{"ClusterId":"19162256624","FileExtType":"","FileId":1234,"FileName":"","FileSize":0,"FileType":""}`},
+	"tencentcloud_tcaplus_table":             {"19162256624#tcaplus-xxxxxxxx", "tcaplus-xxxxxxxx"},
+}
+
+// testWalkProviderSchemas calls f with every attribute of every resource and data source,
+// kind is `resource` or `data source` and path is the dotted path of the attribute in its block
+func testWalkProviderSchemas(f func(kind, name, path string, s *schema.Schema)) {
+	var walk func(kind, name, prefix string, m map[string]*schema.Schema)
+	walk = func(kind, name, prefix string, m map[string]*schema.Schema) {
+		for k, s := range m {
+			f(kind, name, prefix+k, s)
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				walk(kind, name, prefix+k+".", r.Schema)
+			}
+		}
+	}
+
+	p := Provider()
+	for name, r := range p.ResourcesMap {
+		walk("resource", name, "", r.Schema)
+	}
+	for name, r := range p.DataSourcesMap {
+		walk("data source", name, "", r.Schema)
+	}
+}
+
+func testReportSorted(t *testing.T, errs []string) {
+	sort.Strings(errs)
+	for _, e := range errs {
+		t.Error(e)
+	}
+}
+
+func TestProviderSchemaAttributeNames(t *testing.T) {
+	var errs []string
+	testWalkProviderSchemas(func(kind, name, path string, s *schema.Schema) {
+		if !schemaAttributeNamePattern.MatchString(lastSchemaKey(path)) {
+			errs = append(errs, fmt.Sprintf("%s %s: attribute %s is not snake_case", kind, name, path))
+		}
+	})
+	testReportSorted(t, errs)
+}
+
+func TestProviderSchemaComputedDescriptions(t *testing.T) {
+	var errs []string
+	testWalkProviderSchemas(func(kind, name, path string, s *schema.Schema) {
+		if s.Computed && !s.Optional && !s.Required && s.Description == "" {
+			errs = append(errs, fmt.Sprintf("%s %s: computed attribute %s has no description", kind, name, path))
+		}
+	})
+	testReportSorted(t, errs)
+}
+
+func TestProviderSchemaSensitiveAttributes(t *testing.T) {
+	var errs []string
+	testWalkProviderSchemas(func(kind, name, path string, s *schema.Schema) {
+		if s.Type != schema.TypeString || s.Sensitive || nonSensitiveAttributes[name+"."+path] || !schemaSensitiveNamePattern.MatchString(lastSchemaKey(path)) {
+			return
+		}
+		errs = append(errs, fmt.Sprintf("%s %s: attribute %s holds a secret but is not sensitive", kind, name, path))
+	})
+	testReportSorted(t, errs)
+}
+
+func TestProviderDataSourcesResultOutputFile(t *testing.T) {
+	var errs []string
+	for name, r := range Provider().DataSourcesMap {
+		s, ok := r.Schema["result_output_file"]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("data source %s has no result_output_file", name))
+			continue
+		}
+		if s.Type != schema.TypeString || !s.Optional {
+			errs = append(errs, fmt.Sprintf("data source %s: result_output_file must be an optional string", name))
+		}
+	}
+	testReportSorted(t, errs)
+}
+
+func TestProviderResourcesImportIdRoundTrip(t *testing.T) {
+	var errs []string
+	for name, r := range Provider().ResourcesMap {
+		if r.Importer == nil {
+			continue
+		}
+		importId, expected := "tf-import-id", "tf-import-id"
+		if ids, ok := importIdTransformingResources[name]; ok {
+			importId, expected = ids[0], ids[1]
+		}
+		id, err := testImportId(r, importId)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("resource %s: import %s: %v", name, importId, err))
+			continue
+		}
+		if id != expected {
+			errs = append(errs, fmt.Sprintf("resource %s: import %s: got id %s, want %s", name, importId, id, expected))
+		}
+	}
+	testReportSorted(t, errs)
+}
+
+// testImportId runs the importer of r offline and returns the id it leaves in the state
+func testImportId(r *schema.Resource, importId string) (id string, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("importer panics, it must not call the API: %v", e)
+		}
+	}()
+
+	d := r.Data(nil)
+	d.SetId(importId)
+
+	var states []*schema.ResourceData
+	if r.Importer.StateContext != nil {
+		states, err = r.Importer.StateContext(context.Background(), d, nil)
+	} else {
+		states, err = r.Importer.State(d, nil)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(states) != 1 {
+		return "", fmt.Errorf("importer returns %d states, want 1", len(states))
+	}
+	return states[0].Id(), nil
+}
+
+func lastSchemaKey(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
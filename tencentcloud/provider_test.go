@@ -1,13 +1,26 @@
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,6 +29,35 @@ import (
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
+// testAccProtoV5ProviderFactories serves testAccProvider muxed with the Plugin Framework resources, which is
+// required by the tests of the resources implemented with the Plugin Framework
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"tencentcloud": func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := newProviderServerFactory(context.Background(), testAccProvider)
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	},
+}
+
+const (
+	PROVIDER_ACC_RECORD  = "TF_ACC_RECORD"
+	PROVIDER_ACC_REPLAY  = "TF_ACC_REPLAY"
+	PROVIDER_ACC_FAKEAPI = "TF_ACC_FAKEAPI"
+)
+
+// testAccCassetteDir keeps the API exchanges recorded by the tests using testAccPreCheckRecorder
+const testAccCassetteDir = "testdata/cassettes"
+
+var (
+	// testAccRecorder is the *connectivity.Recorder of the test being recorded or replayed, which is
+	// attached to the clients when testAccProvider is configured
+	testAccRecorder atomic.Value
+	// testAccRecorderMu runs the tests being recorded or replayed one by one, as they share testAccProvider
+	testAccRecorderMu sync.Mutex
+)
+
 const (
 	ACCOUNT_TYPE_INTERNATIONAL        = "INTERNATIONAL"
 	ACCOUNT_TYPE_PREPAY               = "PREPAY"
@@ -46,6 +88,14 @@ func init() {
 	testAccProviders = map[string]*schema.Provider{
 		"tencentcloud": testAccProvider,
 	}
+	configure := testAccProvider.ConfigureFunc
+	testAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		meta, err := configure(d)
+		if client, ok := meta.(*TencentCloudClient); ok && err == nil {
+			client.apiV3Conn.Recorder, _ = testAccRecorder.Load().(*connectivity.Recorder)
+		}
+		return meta, err
+	}
 	envProject := os.Getenv("QCI_JOB_ID")
 	envNum := os.Getenv("QCI_BUILD_NUMBER")
 	envId := os.Getenv("QCI_BUILD_ID")
@@ -63,6 +113,716 @@ func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
 
+// oneShotOperationResources lists the resources which only trigger an action on create
+// instead of managing a remote object, so there is nothing to import for them. The cdn
+// url purge and push are updated only to trigger the action again by `redo`, and their
+// id is a hash of the urls.
+var oneShotOperationResources = map[string]bool{
+	"tencentcloud_as_protect_instances":                                true,
+	"tencentcloud_as_remove_instances":                                 true,
+	"tencentcloud_as_scale_in_instances":                               true,
+	"tencentcloud_as_start_instances":                                  true,
+	"tencentcloud_as_stop_instances":                                   true,
+	"tencentcloud_cbs_disk_backup_rollback_operation":                  true,
+	"tencentcloud_ccn_instances_reset_attach":                          true,
+	"tencentcloud_cdn_url_purge":                                       true,
+	"tencentcloud_cdn_url_push":                                        true,
+	"tencentcloud_ckafka_consumer_group_modify_offset":                 true,
+	"tencentcloud_ckafka_renew_instance":                               true,
+	"tencentcloud_clb_replace_cert_for_lbs":                            true,
+	"tencentcloud_clickhouse_delete_backup_data":                       true,
+	"tencentcloud_clickhouse_recover_backup_job":                       true,
+	"tencentcloud_cos_bucket_generate_inventory_immediately_operation": true,
+	"tencentcloud_cos_object_abort_multipart_upload_operation":         true,
+	"tencentcloud_cos_object_copy_operation":                           true,
+	"tencentcloud_cos_object_download_operation":                       true,
+	"tencentcloud_cos_object_restore_operation":                        true,
+	"tencentcloud_css_authenticate_domain_owner_operation":             true,
+	"tencentcloud_cvm_export_images":                                   true,
+	"tencentcloud_cvm_reboot_instance":                                 true,
+	"tencentcloud_cvm_renew_host":                                      true,
+	"tencentcloud_cvm_renew_instance":                                  true,
+	"tencentcloud_cynosdb_export_instance_error_logs":                  true,
+	"tencentcloud_cynosdb_export_instance_slow_queries":                true,
+	"tencentcloud_cynosdb_read_only_instance_exclusive_access":         true,
+	"tencentcloud_dbbrain_db_diag_report_task":                         true,
+	"tencentcloud_dbbrain_modify_diag_db_instance_operation":           true,
+	"tencentcloud_dbbrain_security_audit_log_export_task":              true,
+	"tencentcloud_dbbrain_tdsql_audit_log":                             true,
+	"tencentcloud_dcdb_activate_hour_instance_operation":               true,
+	"tencentcloud_dcdb_cancel_dcn_job_operation":                       true,
+	"tencentcloud_dcdb_flush_binlog_operation":                         true,
+	"tencentcloud_dcdb_isolate_hour_instance_operation":                true,
+	"tencentcloud_dcdb_switch_db_instance_ha_operation":                true,
+	"tencentcloud_dts_compare_task_stop_operation":                     true,
+	"tencentcloud_dts_migrate_job_resume_operation":                    true,
+	"tencentcloud_dts_migrate_job_start_operation":                     true,
+	"tencentcloud_dts_sync_check_job_operation":                        true,
+	"tencentcloud_dts_sync_job_isolate_operation":                      true,
+	"tencentcloud_dts_sync_job_pause_operation":                        true,
+	"tencentcloud_dts_sync_job_recover_operation":                      true,
+	"tencentcloud_dts_sync_job_resize_operation":                       true,
+	"tencentcloud_dts_sync_job_resume_operation":                       true,
+	"tencentcloud_dts_sync_job_start_operation":                        true,
+	"tencentcloud_dts_sync_job_stop_operation":                         true,
+	"tencentcloud_eb_put_events":                                       true,
+	"tencentcloud_eip_normal_address_return":                           true,
+	"tencentcloud_eip_public_address_adjust":                           true,
+	"tencentcloud_lighthouse_apply_disk_backup":                        true,
+	"tencentcloud_lighthouse_apply_instance_snapshot":                  true,
+	"tencentcloud_lighthouse_reboot_instance":                          true,
+	"tencentcloud_lighthouse_renew_disk":                               true,
+	"tencentcloud_lighthouse_start_instance":                           true,
+	"tencentcloud_lighthouse_stop_instance":                            true,
+	"tencentcloud_mariadb_cancel_dcn_job":                              true,
+	"tencentcloud_mariadb_flush_binlog":                                true,
+	"tencentcloud_mariadb_renew_instance":                              true,
+	"tencentcloud_mariadb_restart_instance":                            true,
+	"tencentcloud_mariadb_switch_ha":                                   true,
+	"tencentcloud_mysql_db_import_job_operation":                       true,
+	"tencentcloud_mysql_instance_encryption_operation":                 true,
+	"tencentcloud_mysql_reload_balance_proxy_node":                     true,
+	"tencentcloud_mysql_renew_db_instance_operation":                   true,
+	"tencentcloud_mysql_reset_root_account":                            true,
+	"tencentcloud_mysql_restart_db_instances_operation":                true,
+	"tencentcloud_mysql_ro_group_load_operation":                       true,
+	"tencentcloud_mysql_ro_start_replication":                          true,
+	"tencentcloud_mysql_ro_stop_replication":                           true,
+	"tencentcloud_mysql_rollback":                                      true,
+	"tencentcloud_mysql_rollback_stop":                                 true,
+	"tencentcloud_mysql_switch_for_upgrade":                            true,
+	"tencentcloud_mysql_switch_master_slave_operation":                 true,
+	"tencentcloud_mysql_switch_proxy":                                  true,
+	"tencentcloud_mysql_verify_root_account":                           true,
+	"tencentcloud_postgresql_delete_log_backup_operation":              true,
+	"tencentcloud_postgresql_disisolate_db_instance_operation":         true,
+	"tencentcloud_postgresql_isolate_db_instance_operation":            true,
+	"tencentcloud_postgresql_modify_account_remark_operation":          true,
+	"tencentcloud_postgresql_rebalance_readonly_group_operation":       true,
+	"tencentcloud_postgresql_renew_db_instance_operation":              true,
+	"tencentcloud_postgresql_restart_db_instance_operation":            true,
+	"tencentcloud_redis_backup_operation":                              true,
+	"tencentcloud_redis_startup_instance_operation":                    true,
+	"tencentcloud_scf_invoke_function":                                 true,
+	"tencentcloud_scf_sync_invoke_function":                            true,
+	"tencentcloud_scf_terminate_async_event":                           true,
+	"tencentcloud_sqlserver_complete_expansion":                        true,
+	"tencentcloud_sqlserver_start_backup_full_migration":               true,
+	"tencentcloud_sqlserver_start_backup_incremental_migration":        true,
+	"tencentcloud_sqlserver_start_xevent":                              true,
+	"tencentcloud_ssl_commit_certificate_information":                  true,
+	"tencentcloud_tat_invocation_command_attachment":                   true,
+	"tencentcloud_tcr_delete_image_operation":                          true,
+	"tencentcloud_tdmq_send_rocketmq_message":                          true,
+	"tencentcloud_tsf_deploy_vm_group":                                 true,
+	"tencentcloud_tsf_release_api_group":                               true,
+	"tencentcloud_vpc_enable_end_point_connect":                        true,
+	"tencentcloud_vpc_resume_snapshot_instance":                        true,
+	"tencentcloud_vpn_connection_reset":                                true,
+}
+
+func TestProviderResourceLogFields(t *testing.T) {
+	var logged []map[string]interface{}
+	record := func(meta interface{}) {
+		logged = append(logged, meta.(*TencentCloudClient).apiV3Conn.LogFields)
+	}
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"tencentcloud_legacy": {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Optional: true},
+				},
+				Create: func(d *schema.ResourceData, meta interface{}) error {
+					record(meta)
+					d.SetId("legacy-1")
+					return nil
+				},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					record(meta)
+					return nil
+				},
+				Delete: func(d *schema.ResourceData, meta interface{}) error {
+					record(meta)
+					return nil
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"tencentcloud_context": {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Optional: true},
+				},
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					record(meta)
+					d.SetId("context-1")
+					return nil
+				},
+			},
+		},
+	}
+	addResourceLogFields(p)
+
+	_, meta := testFakeApiMeta(t)
+	resource := p.ResourcesMap["tencentcloud_legacy"]
+	state, err := testResourceApply(resource, nil, map[string]interface{}{"name": "legacy"}, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err = testResourceRefresh(resource, state, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err = testResourceDestroy(resource, state, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	dataSource := p.DataSourcesMap["tencentcloud_context"]
+	if diags := dataSource.ReadContext(context.TODO(), dataSource.TestResourceData(), meta); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{connectivity.LogFieldResourceType: "tencentcloud_legacy", connectivity.LogFieldOperation: "create", connectivity.LogFieldResourceId: ""},
+		{connectivity.LogFieldResourceType: "tencentcloud_legacy", connectivity.LogFieldOperation: "read", connectivity.LogFieldResourceId: "legacy-1"},
+		{connectivity.LogFieldResourceType: "tencentcloud_legacy", connectivity.LogFieldOperation: "delete", connectivity.LogFieldResourceId: "legacy-1"},
+		{connectivity.LogFieldResourceType: "tencentcloud_context", connectivity.LogFieldOperation: "read", connectivity.LogFieldResourceId: ""},
+	}
+	if !reflect.DeepEqual(logged, expected) {
+		t.Errorf("expect log fields %v, got %v", expected, logged)
+	}
+	if meta.apiV3Conn.LogFields != nil {
+		t.Errorf("the meta of the provider should not be changed, got log fields %v", meta.apiV3Conn.LogFields)
+	}
+}
+
+func TestProviderResourcesImporter(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if r.Importer != nil || r.DeprecationMessage != "" || oneShotOperationResources[name] {
+			continue
+		}
+		t.Errorf("resource %s has no importer, add one or mark it as a one-shot operation", name)
+	}
+}
+
+func testWriteSharedProfile(t *testing.T, dir, profile, credential, configure string) {
+	if credential != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, profile+".credential"), []byte(credential), 0600); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if configure != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, profile+".configure"), []byte(configure), 0600); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+}
+
+func TestLoadSharedProfile(t *testing.T) {
+	dir := t.TempDir()
+	testWriteSharedProfile(t, dir, "default",
+		`{"secretId": "default-id", "secretKey": "default-key"}`,
+		`{"_sys_param": {"arrayCount": 10, "output": "json", "region": "ap-shanghai"}}`)
+	testWriteSharedProfile(t, dir, "ci",
+		`{"secretId": "ci-id", "secretKey": "ci-key", "token": "ci-token", "role-arn": "qcs::cam::uin/100:roleName/ci", "role-session-name": "ci"}`,
+		"")
+	testWriteSharedProfile(t, dir, "broken", `{"secretId": `, "")
+
+	profile, err := loadSharedProfile("", dir)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if profile.SecretId != "default-id" || profile.SecretKey != "default-key" || profile.Region != "ap-shanghai" {
+		t.Errorf("unexpected default profile: %+v", profile)
+	}
+
+	profile, err = loadSharedProfile("ci", dir)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if profile.Token != "ci-token" || profile.RoleArn != "qcs::cam::uin/100:roleName/ci" || profile.RoleSessionName != "ci" || profile.Region != "" {
+		t.Errorf("unexpected ci profile: %+v", profile)
+	}
+
+	if _, err = loadSharedProfile("broken", dir); err == nil {
+		t.Errorf("expect error for a malformed credential file")
+	}
+	if _, err = loadSharedProfile("missing", dir); err == nil {
+		t.Errorf("expect error for a missing explicit profile")
+	}
+
+	profile, err = loadSharedProfile("", t.TempDir())
+	if err != nil {
+		t.Fatalf("missing default profile should be ignored, err: %s", err)
+	}
+	if *profile != (SharedProfile{}) {
+		t.Errorf("unexpected empty profile: %+v", profile)
+	}
+}
+
+func TestProviderConfigureSharedProfile(t *testing.T) {
+	dir := t.TempDir()
+	testWriteSharedProfile(t, dir, "default",
+		`{"secretId": "file-id", "secretKey": "file-key", "token": "file-token"}`,
+		`{"_sys_param": {"region": "ap-shanghai"}}`)
+
+	for _, key := range []string{
+		PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_REGION,
+		PROVIDER_PROFILE, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME,
+	} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, dir)
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		config map[string]interface{}
+		id     string
+		token  string
+		region string
+	}{
+		{
+			name:   "file",
+			id:     "file-id",
+			token:  "file-token",
+			region: "ap-shanghai",
+		},
+		{
+			name:   "env over file",
+			env:    map[string]string{PROVIDER_SECRET_ID: "env-id", PROVIDER_SECRET_KEY: "env-key", PROVIDER_REGION: "ap-beijing"},
+			id:     "env-id",
+			region: "ap-beijing",
+		},
+		{
+			name:   "config over env",
+			env:    map[string]string{PROVIDER_SECRET_ID: "env-id", PROVIDER_SECRET_KEY: "env-key"},
+			config: map[string]interface{}{"secret_id": "config-id", "secret_key": "config-key", "region": "ap-chengdu"},
+			id:     "config-id",
+			region: "ap-chengdu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.config)
+			meta, err := providerConfigure(d)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			conn := meta.(*TencentCloudClient).apiV3Conn
+			if conn.Credential.GetSecretId() != tt.id || conn.Credential.GetToken() != tt.token || conn.Region != tt.region {
+				t.Errorf("unexpected client: id=%s, token=%s, region=%s", conn.Credential.GetSecretId(), conn.Credential.GetToken(), conn.Region)
+			}
+		})
+	}
+
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, t.TempDir())
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+	if _, err := providerConfigure(d); err == nil {
+		t.Errorf("expect error without any credentials")
+	}
+}
+
+func TestProviderConfigureSharedProfileRole(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	var durations []uint64
+	server.Handle("sts", "AssumeRole", func(request *fakeapi.Request) (interface{}, error) {
+		var params struct {
+			DurationSeconds uint64
+		}
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		durations = append(durations, params.DurationSeconds)
+		return map[string]interface{}{
+			"Credentials": map[string]interface{}{
+				"TmpSecretId":  "tmp-id",
+				"TmpSecretKey": "tmp-key",
+				"Token":        "tmp-token",
+			},
+			"ExpiredTime": time.Now().Add(time.Hour).Unix(),
+		}, nil
+	})
+
+	dir := t.TempDir()
+	testWriteSharedProfile(t, dir, "default",
+		`{"secretId": "file-id", "secretKey": "file-key", "role-arn": "qcs::cam::uin/100:roleName/ci", "role-session-name": "ci"}`,
+		`{"_sys_param": {"region": "ap-shanghai"}}`)
+	testWriteSharedProfile(t, dir, "hour",
+		`{"secretId": "file-id", "secretKey": "file-key", "role-arn": "qcs::cam::uin/100:roleName/ci", "role-session-name": "ci", "duration-seconds": 3600}`,
+		`{"_sys_param": {"region": "ap-shanghai"}}`)
+	testWriteSharedProfile(t, dir, "invalid",
+		`{"secretId": "file-id", "secretKey": "file-key", "role-arn": "qcs::cam::uin/100:roleName/ci", "role-session-name": "ci", "duration-seconds": 86400}`,
+		`{"_sys_param": {"region": "ap-shanghai"}}`)
+
+	for _, key := range []string{
+		PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_REGION,
+		PROVIDER_PROFILE, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME,
+	} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, dir)
+
+	configure := func(profile string) (*TencentCloudClient, error) {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"profile":   profile,
+			"endpoints": []interface{}{map[string]interface{}{"sts": server.URL}},
+		})
+		meta, err := providerConfigure(d)
+		if err != nil {
+			return nil, err
+		}
+		return meta.(*TencentCloudClient), nil
+	}
+
+	client, err := configure("default")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id := client.apiV3Conn.Credential.GetSecretId(); id != "tmp-id" {
+		t.Errorf("expect the assumed credential, got secret id %s", id)
+	}
+	if _, err = configure("hour"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(durations, []uint64{7200, 3600}) {
+		t.Errorf("unexpected durations of AssumeRole: %v", durations)
+	}
+
+	if _, err = configure("invalid"); err == nil || !strings.Contains(err.Error(), "duration-seconds") {
+		t.Errorf("expect error for the out of range duration, got %v", err)
+	}
+	if len(durations) != 2 {
+		t.Errorf("AssumeRole should not be called with the out of range duration")
+	}
+}
+
+func TestExpandAssumeRoleChain(t *testing.T) {
+	t.Setenv(PROVIDER_ASSUME_ROLE_SESSION_DURATION, "")
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"assume_role": []interface{}{
+			map[string]interface{}{
+				"role_arn":        "qcs::cam::uin/100:roleName/admin",
+				"session_name":    "terraform",
+				"external_id":     "admin-external-id",
+				"source_identity": "100",
+				"tags":            map[string]interface{}{"team": "infra"},
+				"role_chain": []interface{}{
+					map[string]interface{}{
+						"role_arn":    "qcs::cam::uin/200:roleName/member",
+						"external_id": "member-external-id",
+					},
+					map[string]interface{}{
+						"role_arn":     "qcs::cam::uin/300:roleName/deploy",
+						"session_name": "deploy",
+					},
+				},
+			},
+		},
+	})
+
+	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
+	if len(assumeRoleList) != 1 {
+		t.Fatalf("unexpected assume_role: %v", assumeRoleList)
+	}
+	chain := expandAssumeRoleChain(assumeRoleList[0].(map[string]interface{}))
+
+	expected := []assumeRoleParams{
+		{
+			roleArn:         "qcs::cam::uin/100:roleName/admin",
+			sessionName:     "terraform",
+			sessionDuration: 7200,
+			externalId:      "admin-external-id",
+			sourceIdentity:  "100",
+			tags:            map[string]string{"team": "infra"},
+		},
+		{
+			roleArn:         "qcs::cam::uin/200:roleName/member",
+			sessionName:     "terraform",
+			sessionDuration: 7200,
+			externalId:      "member-external-id",
+			sourceIdentity:  "100",
+		},
+		{
+			roleArn:         "qcs::cam::uin/300:roleName/deploy",
+			sessionName:     "deploy",
+			sessionDuration: 7200,
+			sourceIdentity:  "100",
+		},
+	}
+	if !reflect.DeepEqual(chain, expected) {
+		t.Errorf("unexpected chain: %+v", chain)
+	}
+}
+
+func TestProviderConfigureAssumeRoleError(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	var roleArns []string
+	server.Handle("sts", "AssumeRole", func(request *fakeapi.Request) (interface{}, error) {
+		var params struct {
+			RoleArn string
+		}
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		roleArns = append(roleArns, params.RoleArn)
+		if strings.Contains(params.RoleArn, "missing") {
+			return nil, &fakeapi.Error{Code: "InvalidParameter.RoleNotExist", Message: "role not exist"}
+		}
+		return map[string]interface{}{
+			"Credentials": map[string]interface{}{
+				"TmpSecretId":  "tmp-id",
+				"TmpSecretKey": "tmp-key",
+				"Token":        "tmp-token",
+			},
+			"ExpiredTime": time.Now().Add(time.Hour).Unix(),
+		}, nil
+	})
+
+	for _, key := range []string{
+		PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_REGION,
+		PROVIDER_PROFILE, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME,
+	} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, t.TempDir())
+
+	tests := []struct {
+		name      string
+		assume    map[string]interface{}
+		roleArns  []string
+		failedArn string
+	}{
+		{
+			name: "role",
+			assume: map[string]interface{}{
+				"role_arn":     "qcs::cam::uin/100:roleName/missing",
+				"session_name": "terraform",
+			},
+			roleArns:  []string{"qcs::cam::uin/100:roleName/missing"},
+			failedArn: "qcs::cam::uin/100:roleName/missing",
+		},
+		{
+			name: "role chain",
+			assume: map[string]interface{}{
+				"role_arn":     "qcs::cam::uin/100:roleName/admin",
+				"session_name": "terraform",
+				"role_chain": []interface{}{
+					map[string]interface{}{"role_arn": "qcs::cam::uin/200:roleName/missing"},
+					map[string]interface{}{"role_arn": "qcs::cam::uin/300:roleName/deploy"},
+				},
+			},
+			roleArns:  []string{"qcs::cam::uin/100:roleName/admin", "qcs::cam::uin/200:roleName/missing"},
+			failedArn: "qcs::cam::uin/200:roleName/missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleArns = nil
+			d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+				"secret_id":   "source-id",
+				"secret_key":  "source-key",
+				"region":      "ap-guangzhou",
+				"assume_role": []interface{}{tt.assume},
+				"endpoints":   []interface{}{map[string]interface{}{"sts": server.URL}},
+			})
+			meta, err := providerConfigure(d)
+			if err == nil {
+				t.Fatalf("expect the error of AssumeRole, got client with secret id %s", meta.(*TencentCloudClient).apiV3Conn.Credential.GetSecretId())
+			}
+			if !strings.Contains(err.Error(), "InvalidParameter.RoleNotExist") || !strings.Contains(err.Error(), tt.failedArn) {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(roleArns, tt.roleArns) {
+				t.Errorf("unexpected roles assumed: %v", roleArns)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureWebIdentity(t *testing.T) {
+	for _, key := range []string{
+		PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME,
+		PROVIDER_WEB_IDENTITY_TOKEN, PROVIDER_WEB_IDENTITY_TOKEN_FILE,
+	} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, t.TempDir())
+
+	webIdentity := map[string]interface{}{
+		"provider_id":  "github",
+		"role_arn":     "qcs::cam::uin/100:roleName/ci",
+		"session_name": "ci",
+	}
+
+	// neither token nor token file
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"region":                        "ap-guangzhou",
+		"assume_role_with_web_identity": []interface{}{webIdentity},
+	})
+	if _, err := providerConfigure(d); err == nil {
+		t.Errorf("expect error without web identity token")
+	}
+
+	// the token file is read before calling sts, static credentials are not required
+	t.Setenv(PROVIDER_WEB_IDENTITY_TOKEN_FILE, filepath.Join(t.TempDir(), "missing-token"))
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"region":                        "ap-guangzhou",
+		"assume_role_with_web_identity": []interface{}{webIdentity},
+	})
+	_, err := providerConfigure(d)
+	if err == nil || !strings.Contains(err.Error(), "missing-token") {
+		t.Errorf("expect error reading the token file, got: %v", err)
+	}
+}
+
+func TestProviderConfigureDefaultTags(t *testing.T) {
+	for _, key := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, t.TempDir())
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-guangzhou",
+		"default_tags": []interface{}{map[string]interface{}{
+			"tags": map[string]interface{}{"env": "test", "owner": "terraform"},
+		}},
+		"ignore_tags": []interface{}{map[string]interface{}{
+			"keys":         []interface{}{"owner"},
+			"key_prefixes": []interface{}{"tencentcloud:"},
+		}},
+	})
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	tagsAll := mergeDefaultTags(meta, map[string]string{
+		"env":                     "prod",
+		"app":                     "web",
+		"tencentcloud:created-by": "console",
+	})
+	if expected := map[string]string{"env": "prod", "app": "web"}; !reflect.DeepEqual(tagsAll, expected) {
+		t.Errorf("expect tags_all %v, got %v", expected, tagsAll)
+	}
+
+	vpc := schema.TestResourceDataRaw(t, resourceTencentCloudVpcInstance().Schema, map[string]interface{}{
+		"name":       "vpc",
+		"cidr_block": "10.0.0.0/16",
+		"tags":       map[string]interface{}{"app": "web"},
+	})
+	setResourceTags(vpc, meta, map[string]string{
+		"env":                     "test",
+		"app":                     "web",
+		"owner":                   "someone",
+		"tencentcloud:created-by": "console",
+	})
+	if tags, expected := helper.GetTags(vpc, "tags"), map[string]string{"app": "web"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expect tags %v, got %v", expected, tags)
+	}
+	if tags, expected := helper.GetTags(vpc, "tags_all"), map[string]string{"env": "test", "app": "web"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expect tags_all %v, got %v", expected, tags)
+	}
+
+	// the resources configuring tags by other keys
+	ckafka := schema.TestResourceDataRaw(t, resourceTencentCloudCkafkaInstance().Schema, map[string]interface{}{
+		"tag_set": map[string]interface{}{"app": "web"},
+	})
+	setResourceTagsOf(ckafka, meta, "tag_set", map[string]string{
+		"env":                     "test",
+		"app":                     "web",
+		"tencentcloud:created-by": "console",
+	})
+	if tags, expected := helper.GetTags(ckafka, "tag_set"), map[string]string{"app": "web"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expect tag_set %v, got %v", expected, tags)
+	}
+	if tags, expected := helper.GetTags(ckafka, "tags_all"), map[string]string{"env": "test", "app": "web"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expect tags_all %v, got %v", expected, tags)
+	}
+
+	// the tags replaced as a whole keep the ignored ones of current tags
+	tags := keepIgnoredTags(meta, map[string]string{
+		"env":                     "test",
+		"owner":                   "someone",
+		"tencentcloud:created-by": "console",
+	}, tagsAll)
+	expected := map[string]string{"env": "prod", "app": "web", "owner": "someone", "tencentcloud:created-by": "console"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expect tags %v, got %v", expected, tags)
+	}
+}
+
+func TestProviderConfigureRetry(t *testing.T) {
+	for _, key := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(key, "")
+	}
+	t.Setenv(PROVIDER_SHARED_CREDENTIALS_DIR, t.TempDir())
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-guangzhou",
+		"retry": []interface{}{map[string]interface{}{
+			"max_attempts":          3,
+			"base_delay":            "200ms",
+			"max_delay":             "2s",
+			"extra_retryable_codes": []interface{}{"ResourceInUse"},
+		}},
+	})
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	retryer := meta.(*TencentCloudClient).apiV3Conn.Retryer
+	if retryer == nil || !retryer.Retryable("ResourceInUse.Vpc") || retryer.Retryable("ResourceNotFound") {
+		t.Fatalf("unexpected retryer: %+v", retryer)
+	}
+	if delay := retryer.Backoff(10, ""); delay > 2*time.Second {
+		t.Errorf("delay should be capped by max_delay, got %s", delay)
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	t.Setenv(PROVIDER_RATE_LIMIT, "30")
+	t.Setenv(PROVIDER_RATE_LIMITS, "cvm=50, cvm.RunInstances=5")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+	defaultLimit, limits, err := parseRateLimit(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if defaultLimit != 30 || !reflect.DeepEqual(limits, map[string]int64{"cvm": 50, "cvm.RunInstances": 5}) {
+		t.Errorf("unexpected limits from env: %d, %v", defaultLimit, limits)
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"rate_limit": []interface{}{map[string]interface{}{
+			"default_limit": 10,
+			"limits":        map[string]interface{}{"cvm.RunInstances": 2},
+		}},
+	})
+	defaultLimit, limits, err = parseRateLimit(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if defaultLimit != 10 || !reflect.DeepEqual(limits, map[string]int64{"cvm": 50, "cvm.RunInstances": 2}) {
+		t.Errorf("unexpected limits from config: %d, %v", defaultLimit, limits)
+	}
+
+	t.Setenv(PROVIDER_RATE_LIMITS, "cvm")
+	if _, _, err = parseRateLimit(d); err == nil {
+		t.Errorf("expect error for malformed %s", PROVIDER_RATE_LIMITS)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv(PROVIDER_SECRET_ID); v == "" {
 		t.Fatalf("%v must be set for acceptance tests\n", PROVIDER_SECRET_ID)
@@ -84,6 +844,175 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckRecorder is the PreCheck of the tests which can be recorded and replayed. With `TF_ACC_RECORD=1`
+// the API exchanges of the test are recorded to the cassette testdata/cassettes/<test name>.json, and with
+// `TF_ACC_REPLAY=1` they are replayed from it without credentials or network, the test is skipped if its
+// cassette is not recorded yet. No cassette of them is committed, they have to be recorded with an account first. Select the tests with `-run` when recording or replaying, as the other tests
+// share testAccProvider with them.
+func testAccPreCheckRecorder(t *testing.T) {
+	var mode connectivity.RecorderMode
+	switch {
+	case os.Getenv(PROVIDER_ACC_REPLAY) == "1":
+		mode = connectivity.RecorderModeReplay
+	case os.Getenv(PROVIDER_ACC_RECORD) == "1":
+		mode = connectivity.RecorderModeRecord
+	default:
+		testAccPreCheck(t)
+		return
+	}
+
+	cassette := filepath.Join(testAccCassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if mode == connectivity.RecorderModeReplay {
+		if _, err := os.Stat(cassette); os.IsNotExist(err) {
+			t.Skipf("cassette %s is not recorded yet, record it with %s=1", cassette, PROVIDER_ACC_RECORD)
+		}
+		// the requests are not sent in replay, but the provider still requires the credentials
+		for _, key := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, COMMON_PROVIDER_SECRET_ID, COMMON_PROVIDER_SECRET_KEY} {
+			if os.Getenv(key) == "" {
+				os.Setenv(key, "replay")
+			}
+		}
+	}
+	testAccPreCheck(t)
+
+	recorder, err := connectivity.NewRecorder(mode, cassette)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	testAccRecorderMu.Lock()
+	testAccRecorder.Store(recorder)
+	t.Cleanup(func() {
+		testAccRecorder.Store((*connectivity.Recorder)(nil))
+		testAccRecorderMu.Unlock()
+
+		if err := recorder.Save(); err != nil {
+			t.Errorf("save cassette %s failed: %s", cassette, err)
+		}
+	})
+}
+
+// testReplayMeta returns the provider meta of the TestReplayFakeApi tests, which run the CRUD of resources in process
+// without the Terraform CLI. The API exchanges are replayed from the cassette testdata/cassettes/<test name>.json by
+// default. The committed cassettes are recorded against the fake API server with `TF_ACC_RECORD=1 TF_ACC_FAKEAPI=1`,
+// so the tests check the provider against the fake API rather than TencentCloud. With `TF_ACC_RECORD=1` alone the
+// requests are sent to TencentCloud with the credentials of testAccPreCheck and recorded to the cassette.
+func testReplayMeta(t *testing.T) *TencentCloudClient {
+	mode := connectivity.RecorderModeReplay
+	config := map[string]interface{}{
+		"secret_id":  "replay",
+		"secret_key": "replay",
+		"region":     defaultRegion,
+	}
+	if os.Getenv(PROVIDER_ACC_RECORD) == "1" {
+		mode = connectivity.RecorderModeRecord
+		if os.Getenv(PROVIDER_ACC_FAKEAPI) == "1" {
+			server := fakeapi.NewServer()
+			t.Cleanup(server.Close)
+			endpoints := make(map[string]interface{}, len(fakeapi.Services))
+			for service, endpoint := range server.Endpoints() {
+				endpoints[service] = endpoint
+			}
+			config["endpoints"] = []interface{}{endpoints}
+		} else {
+			testAccPreCheck(t)
+			config = map[string]interface{}{}
+		}
+	}
+
+	cassette := filepath.Join(testAccCassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	recorder, err := connectivity.NewRecorder(mode, cassette)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := recorder.Save(); err != nil {
+			t.Errorf("save cassette %s failed: %s", cassette, err)
+		}
+	})
+
+	provider := Provider()
+	if diags := provider.Configure(context.TODO(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configure provider failed: %v", diags)
+	}
+	meta := provider.Meta().(*TencentCloudClient)
+	meta.apiV3Conn.Recorder = recorder
+	if mode == connectivity.RecorderModeReplay {
+		meta.apiV3Conn.Retryer = connectivity.NewRetryer(connectivity.DefaultRetryPolicy).WithClock(testFakeApiNoSleepClock{})
+	}
+	return meta
+}
+
+// testResourceApply plans r with the raw config against state and applies the plan, the resource is created if
+// state is nil
+func testResourceApply(r *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, meta interface{}) (*terraform.InstanceState, error) {
+	diff, err := testResourceDiff(r, state, config, meta)
+	if err != nil {
+		return nil, err
+	}
+	newState, diags := r.Apply(context.TODO(), state, diff, meta)
+	return newState, testDiagsError(diags)
+}
+
+// testResourceRefresh reads the resource of state, the returned state is nil if the resource is gone
+func testResourceRefresh(r *schema.Resource, state *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	newState, diags := r.RefreshWithoutUpgrade(context.TODO(), state, meta)
+	return newState, testDiagsError(diags)
+}
+
+// testResourceDestroy deletes the resource of state
+func testResourceDestroy(r *schema.Resource, state *terraform.InstanceState, meta interface{}) error {
+	_, diags := r.Apply(context.TODO(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+	return testDiagsError(diags)
+}
+
+func testDiagsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	return nil
+}
+
+// testFakeApiNoSleepClock makes the Retryer of the fake API client retry without waiting
+type testFakeApiNoSleepClock struct{}
+
+func (testFakeApiNoSleepClock) Now() time.Time {
+	return time.Now()
+}
+
+func (testFakeApiNoSleepClock) Sleep(ctx context.Context, d time.Duration) error {
+	return ctx.Err()
+}
+
+// testFakeApiMeta returns a fake API server of fakeapi.Services, and the provider meta whose clients are
+// pointed at it, the server is closed when the test ends. The signature is checked with the fake secret.
+func testFakeApiMeta(t *testing.T) (*fakeapi.Server, *TencentCloudClient) {
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	server.CheckSignature("AKIDfake", "fake")
+
+	client := &connectivity.TencentCloudClient{
+		Credential: common.NewCredential("AKIDfake", "fake"),
+		Region:     defaultRegion,
+		Protocol:   "HTTP",
+		Endpoints:  server.Endpoints(),
+		Retryer:    connectivity.NewRetryer(connectivity.DefaultRetryPolicy).WithClock(testFakeApiNoSleepClock{}),
+	}
+	return server, &TencentCloudClient{apiV3Conn: client}
+}
+
+// testUnknownValue is the value of the unknown attributes in the raw config of testResourceDiff, e.g. the id of
+// a resource to be created, it's hcl2shim.UnknownVariableValue of the SDK.
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// testResourceDiff plans r with the raw config against state, which is nil for the resource to be created,
+// it returns the error of the plan, e.g. the one of CustomizeDiff.
+func testResourceDiff(r *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	return r.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(config), meta)
+}
+
 func testAccStepPreConfigSetTempAKSK(t *testing.T, accountType string) {
 	testAccPreCheckCommon(t, accountType)
 }
@@ -1,34 +1,22 @@
 package ratelimit
 
-//default cgi limit
-
+// DefaultLimit is the default limit per second of each API action
 const (
-	DefaultLimit int64 = 15
+	DefaultLimit int64 = 20
 )
 
-func init() {
-
-	//old  (filename . key)
-	limitConfig["resource_tc_instance"] = 50
-	limitConfig["resource_tc_instance.create"] = 10
-	limitConfig["resource_tc_instance.update"] = 10
-	limitConfig["resource_tc_instance.delete"] = 10
+// limitConfig is the built-in limits keyed by `service` or `service.action`
+var limitConfig = map[string]int64{
+	"cvm":                          50,
+	"cvm.RunInstances":             10,
+	"cvm.ModifyInstancesAttribute": 10,
+	"cvm.TerminateInstances":       10,
 
-	//new(filename . action)
-	limitConfig["service_tencentcloud_mysql"] = 50
-	limitConfig["service_tencentcloud_mysql.CreateDBInstanceHour"] = 20
-	limitConfig["service_tencentcloud_mysql.OfflineIsolatedInstances"] = 20
-	limitConfig["service_tencentcloud_mysql.CreateBackup"] = 5
-	limitConfig["service_tencentcloud_mysql.ModifyInstanceParam"] = 20
+	"cdb":                          50,
+	"cdb.CreateDBInstanceHour":     20,
+	"cdb.OfflineIsolatedInstances": 20,
+	"cdb.CreateBackup":             5,
+	"cdb.ModifyInstanceParam":      20,
 
-	//new(filename)
-	limitConfig["service_tencentcloud_cos"] = DefaultLimit
-	limitConfig["service_tencentcloud_vpc"] = DefaultLimit
-	limitConfig["service_tencentcloud_redis"] = DefaultLimit
-	limitConfig["service_tencentcloud_mongodb"] = DefaultLimit
-	limitConfig["service_tencentcloud_dcg"] = DefaultLimit
-	limitConfig["service_tencentcloud_dc"] = 5
-	limitConfig["service_tencentcloud_ccn"] = DefaultLimit
-	limitConfig["service_tencentcloud_cbs"] = DefaultLimit
-	limitConfig["service_tencentcloud_as"] = DefaultLimit
+	"dc": 5,
 }
@@ -0,0 +1,174 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// the rate is halved when the API returns RequestLimitExceeded
+	throttledFactor = 0.5
+	// the rate grows back by this ratio of the configured limit per successful request
+	recoverRatio = 0.02
+	// the rate never shrinks below this ratio of the configured limit
+	minRateRatio = 0.1
+)
+
+// Limiter is a token bucket limiter of API requests keyed by (service, action). Each bucket shrinks
+// its rate when the API returns RequestLimitExceeded and grows back slowly when requests succeed.
+type Limiter struct {
+	defaultLimit int64
+	limits       map[string]int64
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	limit  float64
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter, the `limits` are keyed by `service` or `service.action`
+// and override the built-in ones, the others are limited by `defaultLimit` per second.
+func NewLimiter(defaultLimit int64, limits map[string]int64) *Limiter {
+	if defaultLimit <= 0 {
+		defaultLimit = DefaultLimit
+	}
+	merged := make(map[string]int64, len(limitConfig)+len(limits))
+	for k, v := range limitConfig {
+		merged[k] = v
+	}
+	for k, v := range limits {
+		merged[k] = v
+	}
+
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		limits:       merged,
+		now:          time.Now,
+		sleep:        sleep,
+		buckets:      make(map[string]*bucket),
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Limit returns the configured limit per second of the action
+func (me *Limiter) Limit(service, action string) int64 {
+	if limit, ok := me.limits[fmt.Sprintf("%s.%s", service, action)]; ok {
+		return limit
+	}
+	if limit, ok := me.limits[service]; ok {
+		return limit
+	}
+	return me.defaultLimit
+}
+
+// Rate returns the current rate per second of the action
+func (me *Limiter) Rate(service, action string) float64 {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	return me.bucket(service, action).rate
+}
+
+func (me *Limiter) bucket(service, action string) *bucket {
+	key := fmt.Sprintf("%s.%s", service, action)
+	b := me.buckets[key]
+	if b == nil {
+		limit := float64(me.Limit(service, action))
+		b = &bucket{
+			limit:  limit,
+			rate:   limit,
+			tokens: limit,
+			last:   me.now(),
+		}
+		me.buckets[key] = b
+	}
+	return b
+}
+
+// Wait blocks until a request of the action is allowed or the ctx is done
+func (me *Limiter) Wait(ctx context.Context, service, action string) error {
+	me.mu.Lock()
+	b := me.bucket(service, action)
+
+	now := me.now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+
+	// take the token in advance, so the waiting requests are served in order
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	rate := b.rate
+	me.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] %s.%s is limited to %.2f/s, wait %s", service, action, rate, wait)
+	if err := me.sleep(ctx, wait); err != nil {
+		// the request is not sent, give back the token taken in advance
+		me.mu.Lock()
+		b.tokens++
+		me.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Throttled shrinks the rate of the action after the API returns RequestLimitExceeded
+func (me *Limiter) Throttled(service, action string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	b := me.bucket(service, action)
+	b.rate *= throttledFactor
+	if min := b.limit * minRateRatio; b.rate < min {
+		b.rate = min
+	}
+	// drop the burst, the quota of current second is already used up
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+	log.Printf("[WARN] %s.%s exceeded the request limit, slow down to %.2f/s", service, action, b.rate)
+}
+
+// Succeeded grows the rate of the action back to the configured limit
+func (me *Limiter) Succeeded(service, action string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	b := me.bucket(service, action)
+	if b.rate < b.limit {
+		b.rate += b.limit * recoverRatio
+		if b.rate > b.limit {
+			b.rate = b.limit
+		}
+	}
+}
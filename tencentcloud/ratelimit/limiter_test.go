@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now    time.Time
+	slept  time.Duration
+	sleeps int
+}
+
+func (c *fakeClock) sleep(ctx context.Context, d time.Duration) error {
+	c.slept += d
+	c.sleeps++
+	return ctx.Err()
+}
+
+func newTestLimiter(defaultLimit int64, limits map[string]int64) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Now()}
+	limiter := NewLimiter(defaultLimit, limits)
+	limiter.now = func() time.Time { return clock.now }
+	limiter.sleep = clock.sleep
+	return limiter, clock
+}
+
+func TestLimiterLimit(t *testing.T) {
+	limiter, _ := newTestLimiter(0, map[string]int64{"cdb.CreateBackup": 2, "vpc": 30})
+
+	for key, expected := range map[[2]string]int64{
+		{"cvm", "DescribeInstances"}:     50,
+		{"cvm", "RunInstances"}:          10,
+		{"cdb", "CreateBackup"}:          2,
+		{"cdb", "DescribeBackups"}:       50,
+		{"vpc", "DescribeVpcs"}:          30,
+		{"cbs", "DescribeDisks"}:         DefaultLimit,
+		{"dc", "DescribeDirectConnects"}: 5,
+	} {
+		if limit := limiter.Limit(key[0], key[1]); limit != expected {
+			t.Errorf("%s.%s: expect limit %d, got %d", key[0], key[1], expected, limit)
+		}
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	limiter, clock := newTestLimiter(10, nil)
+	ctx := context.Background()
+
+	// the burst is allowed without waiting
+	for i := 0; i < 10; i++ {
+		if err := limiter.Wait(ctx, "vpc", "DescribeVpcs"); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if clock.sleeps != 0 {
+		t.Fatalf("expect no waiting within the burst, slept %d times", clock.sleeps)
+	}
+
+	// the others wait in order
+	_ = limiter.Wait(ctx, "vpc", "DescribeVpcs")
+	_ = limiter.Wait(ctx, "vpc", "DescribeVpcs")
+	if clock.sleeps != 2 || clock.slept != 300*time.Millisecond {
+		t.Fatalf("expect waiting 100ms and 200ms, slept %s in %d times", clock.slept, clock.sleeps)
+	}
+
+	// the other actions are not affected
+	_ = limiter.Wait(ctx, "vpc", "CreateVpc")
+	if clock.sleeps != 2 {
+		t.Fatalf("expect no waiting for another action")
+	}
+
+	// the token is given back when the waiting is cancelled
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.Wait(cancelled, "vpc", "DescribeVpcs"); err == nil {
+		t.Fatalf("expect error of the cancelled waiting")
+	}
+	_ = limiter.Wait(ctx, "vpc", "DescribeVpcs")
+	if clock.slept != 900*time.Millisecond {
+		t.Fatalf("expect waiting 300ms for both the cancelled and the next one, slept %s in total", clock.slept)
+	}
+
+	// tokens are refilled over time
+	clock.now = clock.now.Add(time.Second)
+	clock.sleeps = 0
+	_ = limiter.Wait(ctx, "vpc", "DescribeVpcs")
+	if clock.sleeps != 0 {
+		t.Fatalf("expect no waiting after refilled")
+	}
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	limiter, _ := newTestLimiter(20, nil)
+
+	limiter.Throttled("vpc", "DescribeVpcs")
+	if rate := limiter.Rate("vpc", "DescribeVpcs"); rate != 10 {
+		t.Fatalf("expect rate halved to 10, got %f", rate)
+	}
+	for i := 0; i < 10; i++ {
+		limiter.Throttled("vpc", "DescribeVpcs")
+	}
+	if rate := limiter.Rate("vpc", "DescribeVpcs"); rate != 2 {
+		t.Fatalf("expect rate not lower than 2, got %f", rate)
+	}
+
+	limiter.Succeeded("vpc", "DescribeVpcs")
+	if rate := limiter.Rate("vpc", "DescribeVpcs"); rate != 2.4 {
+		t.Fatalf("expect rate grows slowly to 2.4, got %f", rate)
+	}
+	for i := 0; i < 100; i++ {
+		limiter.Succeeded("vpc", "DescribeVpcs")
+	}
+	if rate := limiter.Rate("vpc", "DescribeVpcs"); rate != 20 {
+		t.Fatalf("expect rate recovered to 20, got %f", rate)
+	}
+}
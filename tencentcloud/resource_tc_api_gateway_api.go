@@ -50,18 +50,26 @@ resource "tencentcloud_api_gateway_api" "api" {
   test_limit       = 500
 }
 ```
+
+Import
+
+API gateway API can be imported using the service id and the API id, e.g.
+
+```
+terraform import tencentcloud_api_gateway_api.api service-pg6ud8pa#api-2ebb7j1i
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
@@ -70,6 +78,18 @@ func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
 		Read:   resourceTencentCloudAPIGatewayAPIRead,
 		Update: resourceTencentCloudAPIGatewayAPIUpdate,
 		Delete: resourceTencentCloudAPIGatewayAPIDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the api is described with its service, so it is imported by `service_id#api_id`
+				idSplit := strings.Split(d.Id(), FILED_SP)
+				if len(idSplit) != 2 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("service_id", idSplit[0])
+				d.SetId(idSplit[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -1159,7 +1179,6 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().CreateApi(request)
 		if err != nil {
 			return retryError(err)
@@ -1933,7 +1952,6 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().ModifyApi(request)
 		if err != nil {
 			return retryError(err)
@@ -55,6 +55,7 @@ func resourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"api_app_name": {
 				Type:        schema.TypeString,
@@ -72,6 +73,11 @@ func resourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"api_app_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -85,6 +91,7 @@ func resourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 			"api_app_secret": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "Api app secret.",
 			},
 			"created_time": {
@@ -142,7 +149,7 @@ func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta int
 
 	apiAppId = *response.Response.Result.ApiAppId
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigateway:%s:uin/:apiAppId/%s", region, apiAppId)
@@ -221,7 +228,7 @@ func resourceTencentCloudAPIGatewayAPIAppRead(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -267,10 +274,10 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigateway", "apiAppId", tcClient.Region, apiAppId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
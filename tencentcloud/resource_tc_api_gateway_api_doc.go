@@ -84,6 +84,7 @@ func resourceTencentCloudAPIGatewayAPIDoc() *schema.Resource {
 			"share_password": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "API Document Sharing Password.",
 			},
 			"api_doc_status": {
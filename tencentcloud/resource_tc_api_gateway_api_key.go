@@ -87,6 +87,7 @@ func resourceTencentCloudAPIGatewayAPIKey() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validateStringLengthInRange(10, 50),
+				Sensitive:    true,
 				Description:  "The user-defined key must be passed when the access_key_type is manual. The length is 10-50 characters, consisting of letters, numbers, and English underscores.",
 			},
 			// Computed values.
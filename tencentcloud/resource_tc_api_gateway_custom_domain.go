@@ -14,6 +14,14 @@ resource "tencentcloud_api_gateway_custom_domain" "foo" {
 	path_mappings      = ["/good#test","/root#release"]
 }
 ```
+
+Import
+
+API gateway custom domain can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_api_gateway_custom_domain.foo service-pg6ud8pa#custom.domain.com
+```
 */
 package tencentcloud
 
@@ -32,6 +40,9 @@ func resourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
 		Read:   resourceTencentCloudAPIGatewayCustomDomainRead,
 		Update: resourceTencentCloudAPIGatewayCustomDomainUpdate,
 		Delete: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -180,6 +191,7 @@ func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta
 	_ = d.Set("protocol", resultInfo.Protocol)
 	_ = d.Set("net_type", resultInfo.NetType)
 	_ = d.Set("service_id", serviceId)
+	_ = d.Set("sub_domain", subDomain)
 	_ = d.Set("is_forced_https", resultInfo.IsForcedHttps)
 
 	return nil
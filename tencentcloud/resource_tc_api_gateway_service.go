@@ -83,6 +83,7 @@ func resourceTencentCloudAPIGatewayService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -132,6 +133,11 @@ func resourceTencentCloudAPIGatewayService() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"instance_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -366,7 +372,7 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigw:%s:uin/:service/%s", region, serviceId)
@@ -535,7 +541,7 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -613,10 +619,10 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigw", "service", tcClient.Region, serviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -640,7 +646,7 @@ func resourceTencentCloudAPIGatewayServiceDelete(d *schema.ResourceData, meta in
 	)
 
 	// del tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigw:%s:uin/:service/%s", region, serviceId)
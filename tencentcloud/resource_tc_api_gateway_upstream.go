@@ -154,6 +154,7 @@ func resourceTencentCloudAPIGatewayUpstream() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"scheme": {
 				Required:     true,
@@ -229,6 +230,11 @@ func resourceTencentCloudAPIGatewayUpstream() *schema.Resource {
 							Optional:    true,
 							Description: "Dye labelNote: This field may return null, indicating that a valid value cannot be obtained.",
 						},
+						"tags_all": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+						},
 						//"healthy": {
 						//	Type:        schema.TypeString,
 						//	Optional:    true,
@@ -615,7 +621,7 @@ func resourceTencentCloudAPIGatewayUpstreamCreate(d *schema.ResourceData, meta i
 	upstreamId = *response.Response.UpstreamId
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigateway:%s:uin/:upstreamId/%s", region, upstreamId)
@@ -858,7 +864,7 @@ func resourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta int
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -1101,11 +1107,11 @@ func resourceTencentCloudAPIGatewayUpstreamUpdate(d *schema.ResourceData, meta i
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigateway", "upstreamId", tcClient.Region, upstreamId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -45,6 +45,7 @@ func resourceTencentCloudApmInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
@@ -75,6 +76,11 @@ func resourceTencentCloudApmInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -125,7 +131,7 @@ func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interfac
 	d.SetId(instanceId)
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apm:%s:uin/:apm-instance/%s", region, d.Id())
@@ -182,7 +188,7 @@ func resourceTencentCloudApmInstanceRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -244,11 +250,11 @@ func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apm", "apm-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -76,6 +76,14 @@ resource "tencentcloud_as_attachment" "attachment" {
   instance_ids     = [tencentcloud_instance.example.id]
 }
 ```
+
+Import
+
+AutoScaling attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_attachment.attachment asg-n32ymck2
+```
 */
 package tencentcloud
 
@@ -93,6 +101,9 @@ func resourceTencentCloudAsAttachment() *schema.Resource {
 		Read:   resourceTencentCloudAsAttachmentRead,
 		Update: resourceTencentCloudAsAttachmentUpdate,
 		Delete: resourceTencentCloudAsAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -159,6 +170,7 @@ func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("scaling_group_id", scalingGroupId)
 	_ = d.Set("instance_ids", instanceIds)
 	return nil
 }
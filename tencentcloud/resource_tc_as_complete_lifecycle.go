@@ -132,6 +132,7 @@ func resourceTencentCloudAsCompleteLifecycle() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Either InstanceId or LifecycleActionToken must be specified.",
 			},
 		},
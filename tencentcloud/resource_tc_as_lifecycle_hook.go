@@ -85,6 +85,13 @@ resource "tencentcloud_as_lifecycle_hook" "example" {
 }
 ```
 
+Import
+
+AutoScaling lifecycle hook can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_lifecycle_hook.example ash-8uh3a65f
+```
 */
 package tencentcloud
 
@@ -105,6 +112,9 @@ func resourceTencentCloudAsLifecycleHook() *schema.Resource {
 		Read:   resourceTencentCloudAsLifecycleHookRead,
 		Update: resourceTencentCloudAsLifecycleHookUpdate,
 		Delete: resourceTencentCloudAsLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
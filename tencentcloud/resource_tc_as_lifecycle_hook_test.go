@@ -41,6 +41,11 @@ func TestAccTencentCloudAsLifecycleHook(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_as_lifecycle_hook.lifecycle_hook", "notification_metadata", "tf lifecycle test"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_as_lifecycle_hook.lifecycle_hook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
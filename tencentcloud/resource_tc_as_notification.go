@@ -56,6 +56,14 @@ resource "tencentcloud_as_notification" "as_notification" {
   notification_user_group_ids = [tencentcloud_cam_group.example.id]
 }
 ```
+
+Import
+
+AutoScaling notification can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_notification.as_notification asn-2sestqbr
+```
 */
 package tencentcloud
 
@@ -76,6 +84,9 @@ func resourceTencentCloudAsNotification() *schema.Resource {
 		Read:   resourceTencentCloudAsNotificationRead,
 		Update: resourceTencentCloudAsNotificationUpdate,
 		Delete: resourceTencentCloudAsNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -125,7 +125,6 @@ import (
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudAsScalingGroup() *schema.Resource {
@@ -137,6 +136,7 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_name": {
@@ -290,6 +290,11 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of a scaling group.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// computed value
 			"status": {
@@ -423,7 +428,7 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			request.Tags = append(request.Tags, &as.Tag{
 				ResourceType: helper.String("auto-scaling-group"),
@@ -435,8 +440,6 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 
 	var id string
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateAutoScalingGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -574,7 +577,7 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -680,8 +683,6 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.UseAsClient().ModifyAutoScalingGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -741,8 +742,6 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 	if len(updateAttrs) > 0 {
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(balancerRequest.GetAction())
-
 			balancerResponse, err := client.UseAsClient().ModifyLoadBalancers(balancerRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -759,8 +758,8 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("as", "auto-scaling-group", region, d.Id())
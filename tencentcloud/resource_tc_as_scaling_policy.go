@@ -57,6 +57,14 @@ resource "tencentcloud_as_scaling_policy" "example" {
   cooldown            = 360
 }
 ```
+
+Import
+
+AutoScaling scaling policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_scaling_policy.example asp-kf6xp2gf
+```
 */
 package tencentcloud
 
@@ -77,6 +85,9 @@ func resourceTencentCloudAsScalingPolicy() *schema.Resource {
 		Read:   resourceTencentCloudAsScalingPolicyRead,
 		Update: resourceTencentCloudAsScalingPolicyUpdate,
 		Delete: resourceTencentCloudAsScalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -54,6 +54,14 @@ resource "tencentcloud_as_schedule" "example" {
   recurrence           = "0 0 * * *"
 }
 ```
+
+Import
+
+AutoScaling schedule can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_schedule.example asst-0fd3q6ts
+```
 */
 package tencentcloud
 
@@ -74,6 +82,9 @@ func resourceTencentCloudAsSchedule() *schema.Resource {
 		Read:   resourceTencentCloudAsScheduleRead,
 		Update: resourceTencentCloudAsScheduleUpdate,
 		Delete: resourceTencentCloudAsScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -34,7 +34,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudAudit() *schema.Resource {
@@ -135,7 +134,6 @@ func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) (
 	request.LogFilePrefix = &logFilePrefix
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().CreateAudit(request)
 		if err != nil {
 			return retryError(err)
@@ -173,10 +171,8 @@ func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) (er
 
 	request.AuditName = &auditId
 
-	ratelimit.Check(request.GetAction())
 	var response *audit.DescribeAuditResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().DescribeAudit(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -266,7 +262,6 @@ func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) (
 		request.LogFilePrefix = &logFilePrefix
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().UpdateAudit(request)
 			if err != nil {
 				return retryError(err)
@@ -325,7 +320,6 @@ func modifyAuditSwitch(auditname string, auditSwitch bool, meta interface{}) (er
 		request := audit.NewStartLoggingRequest()
 		request.AuditName = &auditname
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StartLogging(request)
 			if err != nil {
 				return retryError(err)
@@ -343,7 +337,6 @@ func modifyAuditSwitch(auditname string, auditSwitch bool, meta interface{}) (er
 		request := audit.NewStopLoggingRequest()
 		request.AuditName = &auditname
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StopLogging(request)
 			if err != nil {
 				return retryError(err)
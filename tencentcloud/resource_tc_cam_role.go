@@ -114,6 +114,7 @@ func resourceTencentCloudCamRole() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -167,6 +168,11 @@ func resourceTencentCloudCamRole() *schema.Resource {
 				Optional:    true,
 				Description: "A list of tags used to associate different resources.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -251,7 +257,7 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	//modify tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		resourceName := BuildTagResourceName("cam", "role", "", roleId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
@@ -316,7 +322,7 @@ func resourceTencentCloudCamRoleRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -395,8 +401,8 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -89,6 +89,7 @@ func resourceTencentCloudCamRoleByName() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -142,6 +143,11 @@ func resourceTencentCloudCamRoleByName() *schema.Resource {
 				Optional:    true,
 				Description: "A list of tags used to associate different resources.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -221,7 +227,7 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 	}
 
 	//modify tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		var instance *cam.RoleInfo
 		if len(instances) != 0 {
 			instance = instances[0]
@@ -296,7 +302,7 @@ func resourceTencentCloudCamRoleByNameRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -375,8 +381,8 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
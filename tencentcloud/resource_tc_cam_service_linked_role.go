@@ -13,6 +13,14 @@ resource "tencentcloud_cam_service_linked_role" "service_linked_role" {
   }
 }
 
+```
+
+Import
+
+CAM service linked role can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cam_service_linked_role.service_linked_role 4611686018441060141
 ```
 */
 package tencentcloud
@@ -34,6 +42,10 @@ func resourceTencentCloudCamServiceLinkedRole() *schema.Resource {
 		Create: resourceTencentCloudCamServiceLinkedRoleCreate,
 		Update: resourceTencentCloudCamServiceLinkedRoleUpdate,
 		Delete: resourceTencentCloudCamServiceLinkedRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"qcs_service_name": {
 				Type:        schema.TypeSet,
@@ -59,6 +71,11 @@ func resourceTencentCloudCamServiceLinkedRole() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -92,7 +109,7 @@ func resourceTencentCloudCamServiceLinkedRoleCreate(d *schema.ResourceData, meta
 		request.Description = helper.String(v.(string))
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			key := k
 			value := v
@@ -124,7 +141,7 @@ func resourceTencentCloudCamServiceLinkedRoleCreate(d *schema.ResourceData, meta
 
 	d.SetId(roleId)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cam:%s:uin/:RoleId/%s", region, roleId)
@@ -173,7 +190,7 @@ func resourceTencentCloudCamServiceLinkedRoleRead(d *schema.ResourceData, meta i
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -219,10 +236,10 @@ func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cam", "RoleId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -60,6 +60,7 @@ func resourceTencentCloudCamUser() *schema.Resource {
 				"need_reset_password": true,
 			}),
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -149,6 +150,11 @@ func resourceTencentCloudCamUser() *schema.Resource {
 				Optional:    true,
 				Description: "A list of tags used to associate different resources.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -255,7 +261,7 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	//modify tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := BuildTagResourceName("cam", "uin", region, helper.UInt64ToStr(*response.Response.Uin))
@@ -323,7 +329,7 @@ func resourceTencentCloudCamUserRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -403,7 +409,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	//tag
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		camService := CamService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
@@ -427,7 +433,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		oldInterface, newInterface := d.GetChange("tags")
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
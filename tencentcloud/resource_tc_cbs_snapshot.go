@@ -41,6 +41,7 @@ func resourceTencentCloudCbsSnapshot() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_name": {
@@ -61,6 +62,11 @@ func resourceTencentCloudCbsSnapshot() *schema.Resource {
 				Deprecated:  "cbs snapshot do not support tag now.",
 				Description: "The available tags within this CBS Snapshot.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"storage_size": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -101,7 +107,7 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 
 	var tags map[string]string
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 	cbsService := CbsService{
@@ -123,7 +129,7 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
@@ -200,7 +206,7 @@ func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -231,9 +237,9 @@ func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -9,6 +9,14 @@ resource "tencentcloud_cbs_snapshot_policy_attachment" "foo" {
   snapshot_policy_id = tencentcloud_cbs_snapshot_policy.policy.id
 }
 ```
+
+Import
+
+CBS snapshot policy attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cbs_snapshot_policy_attachment.foo disk-fesgaqxx#asp-32lk6k49
+```
 */
 package tencentcloud
 
@@ -28,6 +36,9 @@ func resourceTencentCloudCbsSnapshotPolicyAttachment() *schema.Resource {
 		Create: resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
 		Read:   resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
 		Delete: resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_id": {
@@ -27,6 +27,11 @@ func TestAccTencentCloudCbsSnapshotPolicyAttachment(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_policy_attachment.foo", "snapshot_policy_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cbs_snapshot_policy_attachment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -48,6 +48,7 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -124,6 +125,11 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 				Optional:    true,
 				Description: "The available tags within this CBS.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -206,7 +212,7 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tag := cbs.Tag{
 				Key:   helper.String(tagKey),
@@ -245,7 +251,7 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
@@ -325,7 +331,7 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -474,9 +480,9 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -6,9 +6,71 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+func TestUnitCbsStorageAttachmentCreateDelete(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	service := CbsService{client: meta.apiV3Conn}
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+
+	runRequest := cvm.NewRunInstancesRequest()
+	runRequest.Placement = &cvm.Placement{Zone: helper.String("ap-guangzhou-3")}
+	runRequest.InstanceType = helper.String("S5.MEDIUM2")
+	runRequest.ImageId = helper.String("img-test")
+	runResponse, err := meta.apiV3Conn.UseCvmClient().RunInstances(runRequest)
+	assert.Nil(t, err)
+	instanceId := *runResponse.Response.InstanceIdSet[0]
+
+	createRequest := cbs.NewCreateDisksRequest()
+	createRequest.Placement = &cbs.Placement{Zone: helper.String("ap-guangzhou-3")}
+	createRequest.DiskType = helper.String("CLOUD_PREMIUM")
+	createRequest.DiskSize = helper.Uint64(50)
+	createRequest.DiskChargeType = helper.String("POSTPAID_BY_HOUR")
+	createRequest.DiskCount = helper.Uint64(120)
+	createResponse, err := meta.apiV3Conn.UseCbsClient().CreateDisks(createRequest)
+	assert.Nil(t, err)
+	storageId := *createResponse.Response.DiskIdSet[0]
+
+	// the data disks are listed in 2 pages of 100, without the system disk of the instance
+	disks, err := service.DescribeDisksByFilter(ctx, map[string]interface{}{"disk-usage": "DATA_DISK"})
+	assert.Nil(t, err)
+	assert.Len(t, disks, 120)
+	assert.Equal(t, 2, server.Calls("cbs", "DescribeDisks"))
+
+	// the creation waits until the disk leaves ATTACHING
+	server.StateDelay = 2
+	server.InjectFault("cbs", "AttachDisks", fakeapi.Fault{Code: "RequestLimitExceeded", Times: 1})
+	d := schema.TestResourceDataRaw(t, resourceTencentCloudCbsStorageAttachment().Schema, map[string]interface{}{
+		"storage_id":  storageId,
+		"instance_id": instanceId,
+	})
+	describeCalls := server.Calls("cbs", "DescribeDisks")
+	assert.Nil(t, resourceTencentCloudCbsStorageAttachmentCreate(d, meta))
+	assert.Equal(t, storageId, d.Id())
+	assert.Equal(t, instanceId, d.Get("instance_id"))
+	assert.Equal(t, 2, server.Calls("cbs", "AttachDisks"))
+	assert.Equal(t, describeCalls+4, server.Calls("cbs", "DescribeDisks"))
+
+	// the deletion waits until the disk is detached
+	assert.Nil(t, resourceTencentCloudCbsStorageAttachmentDelete(d, meta))
+	disk, err := service.DescribeDiskById(ctx, storageId)
+	assert.Nil(t, err)
+	assert.Equal(t, CBS_STORAGE_STATUS_UNATTACHED, *disk.DiskState)
+	assert.False(t, *disk.Attached)
+
+	assert.Nil(t, resourceTencentCloudCbsStorageAttachmentRead(d, meta))
+	assert.Equal(t, "", d.Id())
+}
+
 func TestAccTencentCloudCbsStorageAttachment(t *testing.T) {
 	t.Parallel()
 
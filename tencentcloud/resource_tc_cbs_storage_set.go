@@ -15,6 +15,13 @@ resource "tencentcloud_cbs_storage_set" "storage" {
 }
 ```
 
+Import
+
+CBS storage set can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cbs_storage_set.storage disk-6rnryouz#disk-4aa5a6om
+```
 */
 package tencentcloud
 
@@ -39,6 +46,9 @@ func resourceTencentCloudCbsStorageSet() *schema.Resource {
 		Read:   resourceTencentCloudCbsStorageSetRead,
 		Update: resourceTencentCloudCbsStorageSetUpdate,
 		Delete: resourceTencentCloudCbsStorageSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
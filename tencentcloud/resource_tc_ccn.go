@@ -68,6 +68,7 @@ func resourceTencentCloudCcn() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -133,6 +134,11 @@ func resourceTencentCloudCcn() *schema.Resource {
 				Optional:    true,
 				Description: "Instance tag.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -161,7 +167,7 @@ func resourceTencentCloudCcnCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.SetId(info.ccnId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("vpc", "ccn", tcClient.Region, d.Id())
@@ -213,7 +219,7 @@ func resourceTencentCloudCcnRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -264,9 +270,9 @@ func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
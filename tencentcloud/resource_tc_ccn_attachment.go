@@ -44,6 +44,14 @@ resource "tencentcloud_ccn_attachment" "other_account" {
   ccn_uin         = var.otheruin
 }
 ```
+
+Import
+
+CCN attachment can be imported using the CCN id, the instance type, region and id, e.g.
+
+```
+terraform import tencentcloud_ccn_attachment.attachment ccn-gree226l#VPC#ap-guangzhou#vpc-r1dsl0xg
+```
 */
 package tencentcloud
 
@@ -55,7 +63,6 @@ import (
 	"strings"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,6 +74,9 @@ func resourceTencentCloudCcnAttachment() *schema.Resource {
 		Read:   resourceTencentCloudCcnAttachmentRead,
 		Update: resourceTencentCloudCcnAttachmentUpdate,
 		Delete: resourceTencentCloudCcnAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCcnAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -191,6 +201,28 @@ func resourceTencentCloudCcnAttachmentCreate(d *schema.ResourceData, meta interf
 	return resourceTencentCloudCcnAttachmentRead(d, meta)
 }
 
+// resourceTencentCloudCcnAttachmentImport imports the attachment by `ccn_id#instance_type#instance_region#instance_id`,
+// the id of the attachment is the md5 of them.
+func resourceTencentCloudCcnAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 4 {
+		return nil, fmt.Errorf("id is broken, %s", d.Id())
+	}
+	ccnId, instanceType, instanceRegion, instanceId := idSplit[0], idSplit[1], idSplit[2], idSplit[3]
+
+	m := md5.New()
+	if _, err := m.Write([]byte(ccnId + instanceType + instanceRegion + instanceId)); err != nil {
+		return nil, err
+	}
+	_ = d.Set("ccn_id", ccnId)
+	_ = d.Set("instance_type", instanceType)
+	_ = d.Set("instance_region", instanceRegion)
+	_ = d.Set("instance_id", instanceId)
+	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_ccn_attachment.read")()
 	defer inconsistentCheck(d, meta)()
@@ -337,7 +369,6 @@ func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interf
 		request.Instances = []*vpc.CcnInstance{&ccnInstance}
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyCcnAttachedInstancesAttribute(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -49,12 +49,20 @@ resource tencentcloud_ccn_bandwidth_limit limit1 {
 }
 ```
 
+Import
+
+CCN bandwidth limit can be imported using the CCN id and the region, or along with the destination region for the limit between regions, e.g.
+
+```
+terraform import tencentcloud_ccn_bandwidth_limit.limit1 ccn-gree226l#ap-guangzhou
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,6 +74,9 @@ func resourceTencentCloudCcnBandwidthLimit() *schema.Resource {
 		Read:   resourceTencentCloudCcnBandwidthLimitRead,
 		Update: resourceTencentCloudCcnBandwidthLimitUpdate,
 		Delete: resourceTencentCloudCcnBandwidthLimitDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCcnBandwidthLimitImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -168,6 +179,23 @@ func resourceTencentCloudCcnBandwidthLimitUpdate(d *schema.ResourceData, meta in
 	return resourceTencentCloudCcnBandwidthLimitRead(d, meta)
 }
 
+// resourceTencentCloudCcnBandwidthLimitImport imports the limit by `ccn_id#region`,
+// or `ccn_id#region#dst_region` for the limit between regions.
+func resourceTencentCloudCcnBandwidthLimitImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 2 && len(idSplit) != 3 {
+		return nil, fmt.Errorf("id is broken, %s", d.Id())
+	}
+	_ = d.Set("ccn_id", idSplit[0])
+	_ = d.Set("region", idSplit[1])
+	if len(idSplit) == 3 {
+		_ = d.Set("dst_region", idSplit[2])
+	}
+	d.SetId(idSplit[0] + FILED_SP + idSplit[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_ccn_bandwidth_limit.read")()
 	defer inconsistentCheck(d, meta)()
@@ -153,7 +153,6 @@ import (
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCdnDomain() *schema.Resource {
@@ -181,6 +180,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 				}},
 			}),
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -341,6 +341,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"private_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Server key information. This is required when uploading an external certificate.",
 									},
 									"message": {
@@ -485,6 +486,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"secret_key": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
 									"sign_param": {
@@ -511,6 +513,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"backup_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
 								},
@@ -526,6 +529,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"secret_key": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
 									"expire_time": {
@@ -547,6 +551,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"backup_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
 								},
@@ -562,6 +567,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"secret_key": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
 									"expire_time": {
@@ -588,6 +594,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"backup_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
 								},
@@ -603,6 +610,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"secret_key": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
 									"expire_time": {
@@ -634,6 +642,7 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 									"backup_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
 								},
@@ -1660,6 +1669,11 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of cdn domain.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// computed
 			"status": {
@@ -2531,7 +2545,6 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 	}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().AddCdnDomain(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2568,7 +2581,7 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 	}
 
 	// tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: client}
 		region := client.Region
@@ -3166,7 +3179,7 @@ func resourceTencentCloudCdnDomainRead(d *schema.ResourceData, meta interface{})
 	if errRet != nil {
 		return errRet
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -4025,7 +4038,6 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 
 	if len(updateAttrs) > 0 {
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().UpdateDomainConfig(request)
 			if err != nil {
 				if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4056,8 +4068,8 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: client}
@@ -4089,7 +4101,7 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: client}
 		region := client.Region
 		resourceName := BuildTagResourceName(CDN_SERVICE_NAME, CDN_RESOURCE_NAME_DOMAIN, region, domain)
@@ -12,6 +12,14 @@ resource "tencentcloud_cfs_access_rule" "foo" {
   user_permission = "root_squash"
 }
 ```
+
+Import
+
+CFS access rule can be imported using the access group id and the rule id, e.g.
+
+```
+terraform import tencentcloud_cfs_access_rule.foo pgroup-7nx89k7l#rule-drksy6pi
+```
 */
 package tencentcloud
 
@@ -25,7 +33,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCfsAccessRule() *schema.Resource {
@@ -34,6 +41,18 @@ func resourceTencentCloudCfsAccessRule() *schema.Resource {
 		Read:   resourceTencentCloudCfsAccessRuleRead,
 		Update: resourceTencentCloudCfsAccessRuleUpdate,
 		Delete: resourceTencentCloudCfsAccessRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the rule is described with its access group, so it is imported by `access_group_id#rule_id`
+				idSplit := strings.Split(d.Id(), FILED_SP)
+				if len(idSplit) != 2 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("access_group_id", idSplit[0])
+				d.SetId(idSplit[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -83,7 +102,6 @@ func resourceTencentCloudCfsAccessRuleCreate(d *schema.ResourceData, meta interf
 	request.UserPermission = helper.String(d.Get("user_permission").(string))
 	ruleId := ""
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsRule(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -169,7 +187,6 @@ func resourceTencentCloudCfsAccessRuleUpdate(d *schema.ResourceData, meta interf
 	}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsRule(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -81,7 +81,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCfsFileSystem() *schema.Resource {
@@ -93,6 +92,7 @@ func resourceTencentCloudCfsFileSystem() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -190,6 +190,11 @@ func resourceTencentCloudCfsFileSystem() *schema.Resource {
 				Optional:    true,
 				Description: "Instance tags.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -226,7 +231,7 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 		request.Capacity = helper.IntUint64(v.(int))
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tag := cfs.TagInfo{
 				TagKey:   helper.String(tagKey),
@@ -238,7 +243,6 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 
 	fsId := ""
 	err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsFileSystem(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -277,7 +281,7 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cfs", "filesystem", tcClient.Region, d.Id())
@@ -348,7 +352,7 @@ func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	if mountTarget != nil {
 		_ = d.Set("vpc_id", mountTarget.VpcId)
@@ -409,9 +413,9 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -44,6 +44,7 @@ func resourceTencentCloudCfsSnapshot() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Required:    true,
@@ -63,6 +64,11 @@ func resourceTencentCloudCfsSnapshot() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -86,7 +92,7 @@ func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interfac
 		request.SnapshotName = helper.String(v.(string))
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tag := cfs.TagInfo{
 				TagKey:   helper.String(tagKey),
@@ -123,7 +129,7 @@ func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interfac
 	}
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cfs:%s:uin/:snap/%s", region, d.Id())
@@ -172,7 +178,7 @@ func resourceTencentCloudCfsSnapshotRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -213,11 +219,11 @@ func resourceTencentCloudCfsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cfs", "snap", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
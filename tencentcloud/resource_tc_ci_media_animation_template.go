@@ -28,6 +28,14 @@ resource "tencentcloud_ci_media_animation_template" "media_animation_template" {
   }
 }
 ```
+
+Import
+
+ci media_animation_template can be imported using the bucket#templateId, e.g.
+
+```
+terraform import tencentcloud_ci_media_animation_template.media_animation_template terraform-ci-xxxxxx#t1ed421df8bd2140b6b73474f70f99b0f8
+```
 */
 package tencentcloud
 
@@ -50,9 +58,9 @@ func resourceTencentCloudCiMediaAnimationTemplate() *schema.Resource {
 		Read:   resourceTencentCloudCiMediaAnimationTemplateRead,
 		Update: resourceTencentCloudCiMediaAnimationTemplateUpdate,
 		Delete: resourceTencentCloudCiMediaAnimationTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
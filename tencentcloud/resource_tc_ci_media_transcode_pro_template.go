@@ -78,9 +78,9 @@ func resourceTencentCloudCiMediaTranscodeProTemplate() *schema.Resource {
 		Read:   resourceTencentCloudCiMediaTranscodeProTemplateRead,
 		Update: resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
 		Delete: resourceTencentCloudCiMediaTranscodeProTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -97,6 +97,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "The password of the Dts consumption group.",
 						},
 						"resource": {
@@ -139,6 +140,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Password for the source of the Mongo DB connection.",
 						},
 						"resource": {
@@ -191,6 +193,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Es The password of the connection source.",
 						},
 						"resource": {
@@ -243,6 +246,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Password for Clickhouse connection source.",
 						},
 						"resource": {
@@ -295,6 +299,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Mysql connection source password.",
 						},
 						"resource": {
@@ -353,6 +358,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "PostgreSQL password.",
 						},
 						"resource": {
@@ -411,6 +417,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "MariaDB password.",
 						},
 						"resource": {
@@ -458,6 +465,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "SQLServer password.",
 						},
 						"resource": {
@@ -505,6 +513,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Doris  password.",
 						},
 						"resource": {
@@ -287,6 +287,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "MongoDB database password.",
 									},
 									"listening_event": {
@@ -337,6 +338,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Es Password.",
 									},
 									"self_built": {
@@ -696,6 +698,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"group_password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Dts consumer group passwd.",
 									},
 									"tran_sql": {
@@ -780,6 +783,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "ClickHouse passwd.",
 									},
 									"service_vip": {
@@ -1674,6 +1678,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "MongoDB database password.",
 									},
 									"listening_event": {
@@ -1724,6 +1729,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Es Password.",
 									},
 									"self_built": {
@@ -2083,6 +2089,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"group_password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Dts consumer group passwd.",
 									},
 									"tran_sql": {
@@ -2167,6 +2174,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									"password": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "ClickHouse passwd.",
 									},
 									"service_vip": {
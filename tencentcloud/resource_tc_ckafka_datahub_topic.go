@@ -47,6 +47,7 @@ func resourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
@@ -78,6 +79,11 @@ func resourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of dataHub topic.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -109,7 +115,7 @@ func resourceTencentCloudCkafkaDatahubTopicCreate(d *schema.ResourceData, meta i
 		request.Note = helper.String(v.(string))
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tagInfo := ckafka.Tag{
 				TagKey:   helper.String(tagKey),
@@ -137,7 +143,7 @@ func resourceTencentCloudCkafkaDatahubTopicCreate(d *schema.ResourceData, meta i
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::ckafka:%s:uin/:dipTopic/%s", region, topicName)
@@ -194,7 +200,7 @@ func resourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta int
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -256,10 +262,10 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("ckafka", "dipTopic", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -120,13 +120,19 @@ import (
 
 func resourceTencentCloudCkafkaInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaInstanceCreate,
-		Read:   resourceTencentCloudCkafkaInstanceRead,
-		Update: resourceTencentCloudCkafkaInstanceUpdate,
-		Delete: resourceTencentCLoudCkafkaInstanceDelete,
+		Create:        resourceTencentCloudCkafkaInstanceCreate,
+		Read:          resourceTencentCloudCkafkaInstanceRead,
+		Update:        resourceTencentCloudCkafkaInstanceUpdate,
+		Delete:        resourceTencentCLoudCkafkaInstanceDelete,
+		CustomizeDiff: customizeDiffTagsAllOf("tag_set"),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * readRetryTimeout),
+			Update: schema.DefaultTimeout(5 * readRetryTimeout),
+			Delete: schema.DefaultTimeout(5 * readRetryTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -262,6 +268,11 @@ func resourceTencentCloudCkafkaInstance() *schema.Resource {
 				Description:   "Tag set of instance.",
 				ConflictsWith: []string{"tags"},
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"disk_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -502,7 +513,7 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 
 	instanceId := response.Response.Result.Data.InstanceId
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		has, ready, err := service.CheckCkafkaInstanceReady(ctx, *instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -625,7 +636,7 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 	tagService := TagService{client: client}
 	region := client.Region
 
-	if tags := helper.GetTags(d, "tag_set"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tag_set")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("ckafka", "ckafkaId", region, *instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -722,7 +733,7 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tag_set", tags)
+	setResourceTagsOf(d, meta, "tag_set", tags)
 
 	_ = d.Set("disk_type", info.DiskType)
 
@@ -920,7 +931,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 				request.GetAction(), request.ToJsonString(), err.Error())
 		}
 
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, ready, err := service.CheckCkafkaInstanceReady(ctx, instanceId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -936,13 +947,13 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tag_set") {
+	if d.HasChange("tags_all") {
 
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: client}
 		region := client.Region
 
-		oldTags, newTags := d.GetChange("tag_set")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("ckafka", "ckafkaId", region, instanceId)
@@ -977,7 +988,7 @@ func resourceTencentCLoudCkafkaInstanceDelete(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		has, _, err := service.CheckCkafkaInstanceReady(ctx, instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -39,11 +39,10 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudClbServerAttachment() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithStateUpgraders(&schema.Resource{
 		Create: resourceTencentCloudClbServerAttachmentCreate,
 		Read:   resourceTencentCloudClbServerAttachmentRead,
 		Delete: resourceTencentCloudClbServerAttachmentDelete,
@@ -110,7 +109,23 @@ func resourceTencentCloudClbServerAttachment() *schema.Resource {
 				},
 			},
 		},
+	}, helper.UpgradeStateId(resourceTencentCloudClbServerAttachmentUpgradeStateV0))
+}
+
+// resourceTencentCloudClbServerAttachmentUpgradeStateV0 rebuilds the id which is not joined with `#` in
+// the form of `locationId#listenerId#clbId` from the attributes of the state.
+func resourceTencentCloudClbServerAttachmentUpgradeStateV0(id string, rawState map[string]interface{}) (string, error) {
+	if len(strings.Split(id, FILED_SP)) == 3 {
+		return id, nil
+	}
+
+	listenerId := helper.StateString(rawState, "listener_id")
+	clbId := helper.StateString(rawState, "clb_id")
+	if listenerId == "" || clbId == "" {
+		return "", fmt.Errorf("listener_id and clb_id are required to upgrade the id of clb attachment")
 	}
+	locationId := helper.StateString(rawState, "rule_id")
+	return strings.Join([]string{locationId, listenerId, clbId}, FILED_SP), nil
 }
 
 func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
@@ -157,7 +172,6 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
 			if e != nil {
 				return retryError(e)
@@ -230,7 +244,6 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargets(request)
 			if e != nil {
 
@@ -9,8 +9,45 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestUnitClbServerAttachmentUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := resourceTencentCloudClbServerAttachment()
+	assert.Equal(t, 1, r.SchemaVersion)
+
+	state, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":          "loc-abc",
+		"clb_id":      "lb-abc",
+		"listener_id": "lbl-abc",
+		"rule_id":     "loc-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "loc-abc#lbl-abc#lb-abc", state["id"])
+
+	// the layer4 listeners have no rule
+	state, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":          "lbl-abc",
+		"clb_id":      "lb-abc",
+		"listener_id": "lbl-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "#lbl-abc#lb-abc", state["id"])
+
+	state, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":          "loc-abc#lbl-abc#lb-abc",
+		"clb_id":      "lb-abc",
+		"listener_id": "lbl-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "loc-abc#lbl-abc#lb-abc", state["id"])
+
+	_, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{"id": "loc-abc", "rule_id": "loc-abc"}, nil)
+	assert.NotNil(t, err)
+}
+
 func TestAccTencentCloudClbAttachmentResource_tcp(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
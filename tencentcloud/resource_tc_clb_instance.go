@@ -203,6 +203,7 @@ func resourceTencentCloudClbInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"network_type": {
@@ -320,6 +321,11 @@ func resourceTencentCloudClbInstance() *schema.Resource {
 				Optional:    true,
 				Description: "The available tags within this CLB.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"vip_isp": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -513,7 +519,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		request.DynamicVip = helper.Bool(v.(bool))
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			tmpKey := k
 			tmpValue := v
@@ -747,7 +753,7 @@ func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -923,9 +929,9 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
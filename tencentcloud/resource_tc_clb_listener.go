@@ -160,7 +160,7 @@ import (
 )
 
 func resourceTencentCloudClbListener() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithStateUpgraders(&schema.Resource{
 		Create: resourceTencentCloudClbListenerCreate,
 		Read:   resourceTencentCloudClbListenerRead,
 		Update: resourceTencentCloudClbListenerUpdate,
@@ -364,7 +364,20 @@ func resourceTencentCloudClbListener() *schema.Resource {
 				Description: "ID of this CLB listener.",
 			},
 		},
+	}, helper.UpgradeStateId(resourceTencentCloudClbListenerUpgradeStateV0))
+}
+
+// resourceTencentCloudClbListenerUpgradeStateV0 upgrades the old style id `listenerId` to `clbId#listenerId`.
+func resourceTencentCloudClbListenerUpgradeStateV0(id string, rawState map[string]interface{}) (string, error) {
+	if strings.Contains(id, FILED_SP) {
+		return id, nil
+	}
+
+	clbId := helper.StateString(rawState, "clb_id")
+	if clbId == "" {
+		return "", fmt.Errorf("clb_id is required to upgrade the old style listener id %s", id)
 	}
+	return clbId + FILED_SP + id, nil
 }
 
 func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interface{}) error {
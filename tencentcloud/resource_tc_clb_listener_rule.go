@@ -50,7 +50,7 @@ import (
 )
 
 func resourceTencentCloudClbListenerRule() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithStateUpgraders(&schema.Resource{
 		Create: resourceTencentCloudClbListenerRuleCreate,
 		Read:   resourceTencentCloudClbListenerRuleRead,
 		Update: resourceTencentCloudClbListenerRuleUpdate,
@@ -206,7 +206,22 @@ func resourceTencentCloudClbListenerRule() *schema.Resource {
 				Description: "ID of this CLB listener rule.",
 			},
 		},
+	}, helper.UpgradeStateId(resourceTencentCloudClbListenerRuleUpgradeStateV0))
+}
+
+// resourceTencentCloudClbListenerRuleUpgradeStateV0 upgrades the old style id `locationId` to
+// `clbId#listenerId#locationId`.
+func resourceTencentCloudClbListenerRuleUpgradeStateV0(id string, rawState map[string]interface{}) (string, error) {
+	if strings.Contains(id, FILED_SP) {
+		return id, nil
+	}
+
+	clbId := helper.StateString(rawState, "clb_id")
+	listenerId := helper.StateString(rawState, "listener_id")
+	if clbId == "" || listenerId == "" {
+		return "", fmt.Errorf("clb_id and listener_id are required to upgrade the old style rule id %s", id)
 	}
+	return strings.Join([]string{clbId, listenerId, id}, FILED_SP), nil
 }
 
 func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
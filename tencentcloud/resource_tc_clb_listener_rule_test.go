@@ -8,8 +8,35 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestUnitClbListenerRuleUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := resourceTencentCloudClbListenerRule()
+	assert.Equal(t, 1, r.SchemaVersion)
+
+	state, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":          "loc-abc",
+		"clb_id":      "lb-abc",
+		"listener_id": "lbl-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc#lbl-abc#loc-abc", state["id"])
+
+	state, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":          "lb-abc#lbl-abc#loc-abc",
+		"clb_id":      "lb-abc",
+		"listener_id": "lbl-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc#lbl-abc#loc-abc", state["id"])
+
+	_, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{"id": "loc-abc", "clb_id": "lb-abc"}, nil)
+	assert.NotNil(t, err)
+}
+
 func TestAccTencentCloudClbListenerRuleResource_basic(t *testing.T) {
 	t.Parallel()
 
@@ -9,13 +9,91 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestUnitClbListenerUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := resourceTencentCloudClbListener()
+	assert.Equal(t, 1, r.SchemaVersion)
+
+	state, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":     "lbl-abc",
+		"clb_id": "lb-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc#lbl-abc", state["id"])
+
+	state, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":     "lb-abc#lbl-abc",
+		"clb_id": "lb-abc",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc#lbl-abc", state["id"])
+
+	_, err = r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{"id": "lbl-abc"}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestReplayFakeApiClbListener(t *testing.T) {
+	meta := testReplayMeta(t)
+
+	clbState, err := testResourceApply(resourceTencentCloudClbInstance(), nil, map[string]interface{}{
+		"network_type": "OPEN",
+		"clb_name":     "tf-clb-listener-basic",
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	r := resourceTencentCloudClbListener()
+	state, err := testResourceApply(r, nil, map[string]interface{}{
+		"clb_id":              clbState.ID,
+		"port":                1,
+		"protocol":            "TCP",
+		"listener_name":       "listener_basic",
+		"session_expire_time": 30,
+		"scheduler":           "WRR",
+		"target_type":         "TARGETGROUP",
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, clbState.ID+FILED_SP+state.Attributes["listener_id"], state.ID)
+	assert.Equal(t, "TCP", state.Attributes["protocol"])
+	assert.Equal(t, "listener_basic", state.Attributes["listener_name"])
+	assert.Equal(t, "30", state.Attributes["session_expire_time"])
+	assert.Equal(t, "WRR", state.Attributes["scheduler"])
+
+	state, err = testResourceApply(r, state, map[string]interface{}{
+		"clb_id":              clbState.ID,
+		"port":                1,
+		"protocol":            "TCP",
+		"listener_name":       "listener_update",
+		"session_expire_time": 60,
+		"scheduler":           "WRR",
+		"target_type":         "TARGETGROUP",
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, "listener_update", state.Attributes["listener_name"])
+	assert.Equal(t, "60", state.Attributes["session_expire_time"])
+
+	assert.Nil(t, testResourceDestroy(r, state, meta))
+	state, err = testResourceRefresh(r, state, meta)
+	assert.Nil(t, err)
+	assert.Nil(t, state)
+
+	assert.Nil(t, testResourceDestroy(resourceTencentCloudClbInstance(), clbState, meta))
+}
+
 func TestAccTencentCloudClbListener_basic(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckRecorder(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -49,7 +49,7 @@ import (
 )
 
 func resourceTencentCloudClbRedirection() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithStateUpgraders(&schema.Resource{
 		Create: resourceTencentCloudClbRedirectionCreate,
 		Read:   resourceTencentCloudClbRedirectionRead,
 		Update: resourceTencentCloudClbRedirectionUpdate,
@@ -106,7 +106,26 @@ func resourceTencentCloudClbRedirection() *schema.Resource {
 				Description: "Indicates whether delete all auto redirection. Default is `false`. It will take effect only when this redirection is auto-rewrite and this auto-rewrite auto redirected more than one rules. All the auto-rewrite relations will be deleted when this parameter set true.",
 			},
 		},
+	}, helper.RenameStateAttributes(resourceTencentCloudClbRedirectionSchemaV0(), map[string]string{
+		"rewrite_source_rule_id": "source_rule_id",
+		"rewrite_target_rule_id": "target_rule_id",
+	}))
+}
+
+// resourceTencentCloudClbRedirectionSchemaV0 is the schema of version 0, the states written before 1.15.2
+// keep the rule ids in `rewrite_source_rule_id` and `rewrite_target_rule_id`.
+func resourceTencentCloudClbRedirectionSchemaV0() map[string]*schema.Schema {
+	schemaV0 := map[string]*schema.Schema{}
+	for _, key := range []string{
+		"clb_id", "source_listener_id", "target_listener_id", "source_rule_id", "target_rule_id",
+		"rewrite_source_rule_id", "rewrite_target_rule_id",
+	} {
+		schemaV0[key] = &schema.Schema{Type: schema.TypeString, Optional: true}
+	}
+	for _, key := range []string{"is_auto_rewrite", "delete_all_auto_rewrite"} {
+		schemaV0[key] = &schema.Schema{Type: schema.TypeBool, Optional: true}
 	}
+	return schemaV0
 }
 
 func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -6,10 +6,65 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestUnitClbRedirectionUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := resourceTencentCloudClbRedirection()
+	assert.Equal(t, 1, r.SchemaVersion)
+	assert.True(t, r.StateUpgraders[0].Type.HasAttribute("rewrite_source_rule_id"))
+	assert.False(t, r.CoreConfigSchema().ImpliedType().HasAttribute("rewrite_source_rule_id"))
+
+	state, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id":                     "loc-src#loc-dst#lbl-src#lbl-dst#lb-abc",
+		"rewrite_source_rule_id": "loc-src",
+		"rewrite_target_rule_id": "loc-dst",
+		"target_rule_id":         "loc-new",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "loc-src", state["source_rule_id"])
+	assert.Equal(t, "loc-new", state["target_rule_id"])
+	assert.NotContains(t, state, "rewrite_source_rule_id")
+	assert.NotContains(t, state, "rewrite_target_rule_id")
+
+	// the states written before 1.15.2 are in the flatmap format, which is decoded with the prior schema
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{"tencentcloud_clb_redirection": r}}
+	server := schema.NewGRPCProviderServer(provider)
+	resp, err := server.UpgradeResourceState(context.TODO(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "tencentcloud_clb_redirection",
+		Version:  0,
+		RawState: &tfprotov5.RawState{Flatmap: map[string]string{
+			"id":                     "loc-src#loc-dst#lbl-src#lbl-dst#lb-abc",
+			"clb_id":                 "lb-abc",
+			"source_listener_id":     "lbl-src",
+			"target_listener_id":     "lbl-dst",
+			"rewrite_source_rule_id": "loc-src",
+			"rewrite_target_rule_id": "loc-dst",
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Diagnostics)
+
+	schemaResp, err := server.GetProviderSchema(context.TODO(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas["tencentcloud_clb_redirection"].ValueType())
+	assert.Nil(t, err)
+	var attributes map[string]tftypes.Value
+	assert.Nil(t, value.As(&attributes))
+	for key, expected := range map[string]string{"source_rule_id": "loc-src", "target_rule_id": "loc-dst"} {
+		var actual string
+		assert.Nil(t, attributes[key].As(&actual))
+		assert.Equal(t, expected, actual, key)
+	}
+}
+
 func TestAccTencentCloudClbRedirection_basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
@@ -147,6 +147,7 @@ func resourceTencentCloudClsKafkaRecharge() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "user password.",
 						},
 					},
@@ -53,6 +53,7 @@ func resourceTencentCloudClsMachineGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:        schema.TypeString,
@@ -88,6 +89,11 @@ func resourceTencentCloudClsMachineGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list. Up to 10 tag key-value pairs are supported and must be unique.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"auto_update": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -148,7 +154,7 @@ func resourceTencentCloudClsMachineGroupCreate(d *schema.ResourceData, meta inte
 		request.MachineGroupType = machineGroupTypes[0]
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			key := k
 			value := v
@@ -233,7 +239,7 @@ func resourceTencentCloudClsMachineGroupRead(d *schema.ResourceData, meta interf
 	for _, tag := range machineGroup.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	_ = d.Set("auto_update", helper.StrToBool(*machineGroup.AutoUpdate))
 	_ = d.Set("update_start_time", machineGroup.UpdateStartTime)
@@ -271,8 +277,8 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]interface{})
+	if d.HasChange("tags_all") {
+		tags := d.Get("tags_all").(map[string]interface{})
 		request.Tags = make([]*cls.Tag, 0, len(tags))
 		for k, v := range tags {
 			key := k
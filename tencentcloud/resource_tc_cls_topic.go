@@ -48,6 +48,7 @@ func resourceTencentCloudClsTopic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"logset_id": {
 				Type:        schema.TypeString,
@@ -70,6 +71,11 @@ func resourceTencentCloudClsTopic() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list. Up to 10 tag key-value pairs are supported and must be unique.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"auto_split": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -122,7 +128,7 @@ func resourceTencentCloudClsTopicCreate(d *schema.ResourceData, meta interface{}
 		request.PartitionCount = helper.IntInt64(v.(int))
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			key := k
 			value := v
@@ -200,7 +206,7 @@ func resourceTencentCloudClsTopicRead(d *schema.ResourceData, meta interface{})
 	for _, tag := range topic.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	_ = d.Set("auto_split", topic.AutoSplit)
 	_ = d.Set("max_split_partitions", topic.MaxSplitPartitions)
 	_ = d.Set("storage_type", topic.StorageType)
@@ -228,9 +234,9 @@ func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}
 		request.TopicName = helper.String(d.Get("topic_name").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		tags := d.Get("tags").(map[string]interface{})
+		tags := d.Get("tags_all").(map[string]interface{})
 		request.Tags = make([]*cls.Tag, 0, len(tags))
 		for k, v := range tags {
 			key := k
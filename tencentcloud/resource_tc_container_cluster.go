@@ -134,6 +134,7 @@ func resourceTencentCloudContainerCluster() *schema.Resource {
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "The password of each node.",
 			},
 			"key_id": {
@@ -117,6 +117,7 @@ func resourceTencentCloudContainerClusterInstance() *schema.Resource {
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "The password of each node.",
 			},
 			"key_id": {
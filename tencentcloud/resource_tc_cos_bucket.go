@@ -378,6 +378,7 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 				"force_clean": false,
 			}),
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -691,6 +692,11 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 				Optional:    true,
 				Description: "The tags of a bucket.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Tags of the bucket, including those inherited from the provider `default_tags`.",
+			},
 			"log_enable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -780,7 +786,7 @@ func resourceTencentCloudCosBucketCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(bucket)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		if err := cosService.SetBucketTags(ctx, bucket, tags); err != nil {
 			return err
 		}
@@ -952,7 +958,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("get tags failed: %v", err)
 	}
 	if len(tags) > 0 {
-		_ = d.Set("tags", tags)
+		setResourceTags(d, meta, tags)
 	}
 
 	//read intelligent tiering
@@ -1113,11 +1119,17 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		bucket := d.Id()
 
 		cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
-		if err := cosService.SetBucketTags(ctx, bucket, helper.GetTags(d, "tags")); err != nil {
+		// the bucket tags are replaced as a whole, so keep the ignored ones
+		currentTags, err := cosService.GetBucketTags(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		tags := keepIgnoredTags(meta, currentTags, mergeDefaultTags(meta, helper.GetTags(d, "tags")))
+		if err := cosService.SetBucketTags(ctx, bucket, tags); err != nil {
 			return err
 		}
 
@@ -89,6 +89,7 @@ func resourceTencentCloudCosBucketDomainCertificateAttachment() *schema.Resource
 												"private_key": {
 													Type:        schema.TypeString,
 													Required:    true,
+													Sensitive:   true,
 													Description: "Private key of certificate.",
 												},
 											},
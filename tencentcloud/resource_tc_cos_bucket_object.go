@@ -27,6 +27,14 @@ resource "tencentcloud_cos_bucket_object" "myobject" {
   content = "the content that you want to upload."
 }
 ```
+
+Import
+
+COS bucket object can be imported using the bucket and the key, e.g.
+
+```
+terraform import tencentcloud_cos_bucket_object.myobject mycos-1258798060#new_object_key
+```
 */
 package tencentcloud
 
@@ -44,6 +52,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCosBucketObject() *schema.Resource {
@@ -52,6 +61,20 @@ func resourceTencentCloudCosBucketObject() *schema.Resource {
 		Read:   resourceTencentCloudCosBucketObjectRead,
 		Update: resourceTencentCloudCosBucketObjectUpdate,
 		Delete: resourceTencentCloudCosBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the id of the object is the bucket joined with the key, so it is imported by `bucket#key`
+				idSplit := strings.SplitN(d.Id(), FILED_SP, 2)
+				if len(idSplit) != 2 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("bucket", idSplit[0])
+				_ = d.Set("key", idSplit[1])
+				d.SetId(idSplit[0] + idSplit[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -110,6 +133,11 @@ func resourceTencentCloudCosBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Tag of the object.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -196,16 +224,11 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, "put object", request.String(), response.String())
 
-	if v, ok := d.GetOk("tags"); ok {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		service := CosService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
-		tags := make(map[string]string)
-
-		for key, val := range v.(map[string]interface{}) {
-			tags[key] = val.(string)
-		}
 
 		if err := service.SetObjectTags(ctx, bucket, key, tags); err != nil {
 			log.Printf("[WARN] set object tags error, skip processing")
@@ -269,7 +292,7 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 		}
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -310,8 +333,8 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		v := d.Get("tags").(map[string]interface{})
+	if d.HasChange("tags_all") {
+		v := d.Get("tags_all").(map[string]interface{})
 		tags := make(map[string]string)
 		for key, val := range v {
 			tags[key] = val.(string)
@@ -18,6 +18,14 @@ resource "tencentcloud_cvm_launch_template" "demo" {
   image_id = data.tencentcloud_images.my_favorite_image.images.0.image_id
 }
 ```
+
+Import
+
+CVM launch template can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cvm_launch_template.demo lt-b20scl2a
+```
 */
 package tencentcloud
 
@@ -36,6 +44,9 @@ func resourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 		Create: resourceTencentCloudCvmLaunchTemplateCreate,
 		Read:   resourceTencentCloudCvmLaunchTemplateRead,
 		Delete: resourceTencentCloudCvmLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"launch_template_name": {
 				Required:    true,
@@ -295,6 +306,7 @@ func resourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "The login password of instance.",
 						},
 						"key_ids": {
@@ -21,6 +21,12 @@ func TestAccTencentCloudCvmLaunchTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cvm_launch_template.launch_template", "image_id", "img-9qrfy1xt"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cvm_launch_template.launch_template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dry_run", "tags"},
+			},
 		},
 	})
 }
@@ -369,6 +369,7 @@ func resourceTencentCloudCvmLaunchTemplateVersion() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 							ForceNew:    true,
+							Sensitive:   true,
 							Description: "Login password of the instance.",
 						},
 						"key_ids": {
@@ -10,6 +10,14 @@ resource "tencentcloud_cynosdb_audit_log_file" "audit_log_file" {
   end_time    = "2022-08-12 10:29:20"
 }
 ```
+
+Import
+
+cynosdb audit_log_file can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_audit_log_file.audit_log_file cynosdbmysql-ins-afqx1hy0#xxx.log
+```
 */
 package tencentcloud
 
@@ -23,7 +31,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
@@ -31,6 +38,9 @@ func resourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
 		Create: resourceTencentCloudCynosdbAuditLogFileCreate,
 		Read:   resourceTencentCloudCynosdbAuditLogFileRead,
 		Delete: resourceTencentCloudCynosdbAuditLogFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -324,7 +334,6 @@ func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta
 		request := cynosdb.NewDescribeAuditLogFilesRequest()
 		request.InstanceId = helper.String(instanceId)
 		request.FileName = response.Response.FileName
-		ratelimit.Check(request.GetAction())
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeAuditLogFiles(request)
 		if e != nil {
 			return retryError(e)
@@ -372,6 +381,7 @@ func resourceTencentCloudCynosdbAuditLogFileRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("resource `CynosdbAuditLogFile` %s does not exist", d.Id())
 	}
 
+	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("file_name", *auditLogFile.FileName)
 	_ = d.Set("create_time", *auditLogFile.CreateTime)
 	_ = d.Set("file_size", *auditLogFile.FileSize)
@@ -23,6 +23,12 @@ func TestAccTencentCloudCynosdbAuditLogFileResource(t *testing.T) {
 					testAccCheckCynosdbCynosdbAuditLogFileExists("tencentcloud_cynosdb_audit_log_file.audit_log_file"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_audit_log_file.audit_log_file",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_time", "end_time", "order", "order_by", "filter"},
+			},
 		},
 	})
 }
@@ -15,6 +15,14 @@ resource "tencentcloud_cynosdb_instance_param" "instance_param" {
   }
 }
 ```
+
+Import
+
+cynosdb instance_param can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_instance_param.instance_param cynosdbmysql-bws8h88b#cynosdbmysql-ins-rikr6z4o
+```
 */
 package tencentcloud
 
@@ -37,6 +45,9 @@ func resourceTencentCloudCynosdbInstanceParam() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbInstanceParamRead,
 		Update: resourceTencentCloudCynosdbInstanceParamUpdate,
 		Delete: resourceTencentCloudCynosdbInstanceParamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -27,6 +27,12 @@ func TestAccTencentCloudCynosdbInstanceParamResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cynosdb_instance_param.instance_param", "instance_param_list.0.param_name", "init_connect"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_instance_param.instance_param",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_in_maintain_period"},
+			},
 		},
 	})
 }
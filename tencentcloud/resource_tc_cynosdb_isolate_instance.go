@@ -13,6 +13,16 @@ resource "tencentcloud_cynosdb_account" "account" {
   max_user_connections = 2
 }
 ```
+
+Import
+
+cynosdb isolate_instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_isolate_instance.isolate_instance cynosdbmysql-6gtlgm5l#cynosdbmysql-ins-9810be9i
+```
+
+The `operate` is not imported, it's set by the configuration.
 */
 package tencentcloud
 
@@ -34,6 +44,9 @@ func resourceTencentCloudCynosdbIsolateInstance() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbIsolateInstanceRead,
 		Update: resourceTencentCloudCynosdbIsolateInstanceUpdate,
 		Delete: resourceTencentCloudCynosdbIsolateInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -84,6 +97,13 @@ func resourceTencentCloudCynosdbIsolateInstanceRead(d *schema.ResourceData, meta
 	defer logElapsed("resource.tencentcloud_cynosdb_isolate_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 2 {
+		return fmt.Errorf("id is broken,%s", d.Id())
+	}
+	_ = d.Set("cluster_id", idSplit[0])
+	_ = d.Set("instance_id", idSplit[1])
+
 	return nil
 }
 
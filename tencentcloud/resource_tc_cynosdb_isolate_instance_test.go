@@ -27,6 +27,12 @@ func TestAccTencentCloudCynosdbIsolateInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_isolate_instance.isolate_instance", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_isolate_instance.isolate_instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operate"},
+			},
 		},
 	})
 }
@@ -16,6 +16,14 @@ resource "tencentcloud_cynosdb_param_template" "param_template" {
     }
 }
 ```
+
+Import
+
+cynosdb param_template can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_param_template.param_template 1001
+```
 */
 package tencentcloud
 
@@ -37,6 +45,9 @@ func resourceTencentCloudCynosdbParamTemplate() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbParamTemplateRead,
 		Update: resourceTencentCloudCynosdbParamTemplateUpdate,
 		Delete: resourceTencentCloudCynosdbParamTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -30,6 +30,11 @@ func TestAccTencentCloudCynosdbParamTemplateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_param_template.param_template", "param_list.#"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cynosdb_param_template.param_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,6 +21,14 @@ resource "tencentcloud_cynosdb_proxy" "proxy" {
   }
 }
 ```
+
+Import
+
+cynosdb proxy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_proxy.proxy cynosdbmysql-bws8h88b#cynosdbmysql-proxy-l6zf9t30
+```
 */
 package tencentcloud
 
@@ -44,6 +52,9 @@ func resourceTencentCloudCynosdbProxy() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbProxyRead,
 		Update: resourceTencentCloudCynosdbProxyUpdate,
 		Delete: resourceTencentCloudCynosdbProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -330,6 +341,8 @@ func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface
 		return nil
 	}
 
+	_ = d.Set("cluster_id", clusterId)
+
 	if proxy != nil {
 		proxyGroupRwInfo := proxy.ProxyGroupInfos[0]
 		connectionPool := proxyGroupRwInfo.ConnectionPool
@@ -134,6 +134,14 @@ resource "tencentcloud_cynosdb_proxy_end_point" "proxy_end_point" {
   }
 }
 ```
+
+Import
+
+cynosdb proxy_end_point can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_proxy_end_point.proxy_end_point cynosdbmysql-bws8h88b#cynosdbmysql-proxy-l6zf9t30#cynosdbmysql-grp-5ppd7xsk
+```
 */
 package tencentcloud
 
@@ -156,6 +164,9 @@ func resourceTencentCloudCynosdbProxyEndPoint() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbProxyEndPointRead,
 		Update: resourceTencentCloudCynosdbProxyEndPointUpdate,
 		Delete: resourceTencentCloudCynosdbProxyEndPointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
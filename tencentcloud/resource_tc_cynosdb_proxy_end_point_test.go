@@ -33,6 +33,12 @@ func TestAccTencentCloudCynosdbProxyEndPointResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy_end_point.proxy_end_point", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_proxy_end_point.proxy_end_point",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security_group_ids"},
+			},
 		},
 	})
 }
@@ -53,6 +53,12 @@ func TestAccTencentCloudCynosdbProxyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy.proxy", "description"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_proxy.proxy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security_group_ids"},
+			},
 		},
 	})
 }
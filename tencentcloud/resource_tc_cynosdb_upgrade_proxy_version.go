@@ -9,6 +9,14 @@ resource "tencentcloud_cynosdb_upgrade_proxy_version" "upgrade_proxy_version" {
   dst_proxy_version = "1.3.7"
 }
 ```
+
+Import
+
+cynosdb upgrade_proxy_version can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_cynosdb_upgrade_proxy_version.upgrade_proxy_version cynosdbmysql-bws8h88b#1.3.5
+```
 */
 package tencentcloud
 
@@ -31,6 +39,9 @@ func resourceTencentCloudCynosdbUpgradeProxyVersion() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbUpgradeProxyVersionRead,
 		Update: resourceTencentCloudCynosdbUpgradeProxyVersionUpdate,
 		Delete: resourceTencentCloudCynosdbUpgradeProxyVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -23,6 +23,11 @@ func TestAccTencentCloudNeedFixCynosdbUpgradeProxyVersionResource_basic(t *testi
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_upgrade_proxy_version.upgrade_proxy_version", "dst_proxy_version"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cynosdb_upgrade_proxy_version.upgrade_proxy_version",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -63,6 +63,14 @@ resource "tencentcloud_dayu_cc_http_policy" "test_bgp" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS CC HTTP policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_cc_http_policy.test_bgpip bgpip#bgpip-00000294#cc-00000001
+```
 */
 package tencentcloud
 
@@ -86,6 +94,9 @@ func resourceTencentCloudDayuCCHttpPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuCCHttpPolicyRead,
 		Update: resourceTencentCloudDayuCCHttpPolicyUpdate,
 		Delete: resourceTencentCloudDayuCCHttpPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -297,6 +308,8 @@ func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta inter
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("create_time", policy.CreateTime)
 	_ = d.Set("smode", policy.Smode)
@@ -22,6 +22,14 @@ resource "tencentcloud_dayu_cc_https_policy" "test_policy" {
   }
 }
 
+```
+
+Import
+
+Anti-DDoS CC HTTPS policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_cc_https_policy.test_policy bgpip#bgpip-00000294#cc-00000001
 ```
 */
 package tencentcloud
@@ -44,6 +52,9 @@ func resourceTencentCloudDayuCCHttpsPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuCCHttpsPolicyRead,
 		Update: resourceTencentCloudDayuCCHttpsPolicyUpdate,
 		Delete: resourceTencentCloudDayuCCHttpsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -236,6 +247,8 @@ func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta inte
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("name", policy.Name)
 	_ = d.Set("create_time", policy.CreateTime)
 	_ = d.Set("policy_id", policy.SetId)
@@ -51,6 +51,14 @@ resource "tencentcloud_dayu_cc_policy_v2" "demo" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS CC policy v2 can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_cc_policy_v2.demo bgp-00000ry7#bgp-multip
+```
 */
 package tencentcloud
 
@@ -70,6 +78,9 @@ func resourceTencentCloudDayuCCPolicyV2() *schema.Resource {
 		Read:   resourceTencentCloudDayuCCPolicyV2Read,
 		Update: resourceTencentCloudDayuCCPolicyV2Update,
 		Delete: resourceTencentCloudDayuCCPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -508,6 +519,8 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 		tmpThreshold["domain"] = threshold.Domain
 		resultThresholds = append(resultThresholds, tmpThreshold)
 	}
+	_ = d.Set("resource_id", instanceId)
+	_ = d.Set("business", business)
 	_ = d.Set("thresholds", resultThresholds)
 
 	ccGeoIpPolicys, err := antiddosService.DescribeCcGeoIPBlockConfigList(ctx, business, instanceId)
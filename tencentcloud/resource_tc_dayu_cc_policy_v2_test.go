@@ -28,6 +28,11 @@ func TestAccTencentCloudDayuCCPolicyV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_cc_policy_v2.demo", "thresholds.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_cc_policy_v2.demo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
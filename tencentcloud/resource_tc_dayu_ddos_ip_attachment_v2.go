@@ -14,6 +14,14 @@ resource "tencentcloud_dayu_ddos_ip_attachment_v2" "boundip" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS IP attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_ddos_ip_attachment_v2.boundip bgp-0000000o#1.1.1.1,2.2.2.2
+```
 */
 package tencentcloud
 
@@ -35,6 +43,9 @@ func resourceTencentCloudDayuDDosIpAttachmentV2() *schema.Resource {
 		Create: resourceTencentCloudDayuDDosIpAttachmentCreateV2,
 		Read:   resourceTencentCloudDayuDDosIpAttachmentReadV2,
 		Delete: resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"bgp_instance_id": {
 				Required:    true,
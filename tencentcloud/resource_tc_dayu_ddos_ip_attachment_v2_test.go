@@ -25,6 +25,11 @@ func TestAccTencentCloudDayuDdosIpAttachmentV2Resource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_ddos_ip_attachment_v2.boundip", "bound_ip_list.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_ddos_ip_attachment_v2.boundip",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -65,6 +65,14 @@ resource "tencentcloud_dayu_ddos_policy" "test_policy" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_ddos_policy.test_policy bgpip#policy-00000001
+```
 */
 package tencentcloud
 
@@ -85,6 +93,9 @@ func resourceTencentCloudDayuDdosPolicy() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyRead,
 		Update: resourceTencentCloudDayuDdosPolicyUpdate,
 		Delete: resourceTencentCloudDayuDdosPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -552,6 +563,7 @@ func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interfa
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
 	_ = d.Set("drop_options", flattenDdosDropOptionList([]*dayu.DDoSPolicyDropOption{ddosPolicy.DropOptions}))
 	_ = d.Set("port_filters", flattenDdosPortLimitList(ddosPolicy.PortLimits))
 	_ = d.Set("packet_filters", flattenDdosPacketFilterList(ddosPolicy.PacketFilters))
@@ -10,6 +10,14 @@ resource "tencentcloud_dayu_ddos_policy_attachment" "dayu_ddos_policy_attachment
   policy_id     = tencentcloud_dayu_ddos_policy.test_policy.policy_id
 }
 ```
+
+Import
+
+Anti-DDoS policy attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_ddos_policy_attachment.dayu_ddos_policy_attachment_basic bgpip-00000294#bgpip#policy-00000001
+```
 */
 package tencentcloud
 
@@ -29,6 +37,9 @@ func resourceTencentCloudDayuDdosPolicyAttachment() *schema.Resource {
 		Create: resourceTencentCloudDayuDdosPolicyAttachmentCreate,
 		Read:   resourceTencentCloudDayuDdosPolicyAttachmentRead,
 		Delete: resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
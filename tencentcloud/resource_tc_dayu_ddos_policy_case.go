@@ -31,6 +31,14 @@ resource "tencentcloud_dayu_ddos_policy_case" "foo" {
   has_vpn             = "yes"
 }
 ```
+
+Import
+
+Anti-DDoS policy case can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_ddos_policy_case.foo bgpip#sceneId
+```
 */
 package tencentcloud
 
@@ -54,6 +62,9 @@ func resourceTencentCloudDayuDdosPolicyCase() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyCaseRead,
 		Update: resourceTencentCloudDayuDdosPolicyCaseUpdate,
 		Delete: resourceTencentCloudDayuDdosPolicyCaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -353,6 +364,7 @@ func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta int
 		return nil
 	}
 
+	_ = d.Set("resource_type", resourceType)
 	for _, record := range ddosPolicyCase.Record {
 		key := *record.Key
 		if key == "CaseName" {
@@ -127,6 +127,11 @@ func TestAccTencentCloudDayuDdosPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_ddos_policy.test_policy", "watermark_filters.0.open_switch", "false"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_ddos_policy.test_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -77,6 +77,14 @@ resource "tencentcloud_dayu_ddos_policy_v2" "ddos_v2" {
 	}
 }
 
+```
+
+Import
+
+Anti-DDoS policy v2 can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_ddos_policy_v2.ddos_v2 bgp-00000ry7#bgp-multip
 ```
 */
 package tencentcloud
@@ -98,6 +106,9 @@ func resourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyV2Read,
 		Update: resourceTencentCloudDayuDdosPolicyV2Update,
 		Delete: resourceTencentCloudDayuDdosPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -714,6 +725,8 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 		return fmt.Errorf("broken ID of DDoS policy")
 	}
 	instanceId := items[0]
+	_ = d.Set("resource_id", instanceId)
+	_ = d.Set("business", items[1])
 	protectThresholdRelation, err := antiddosService.DescribeListProtectThresholdConfig(ctx, instanceId)
 	if err != nil {
 		return err
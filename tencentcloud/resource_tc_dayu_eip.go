@@ -12,6 +12,14 @@ resource "tencentcloud_dayu_eip" "test" {
   bind_resource_type = "cvm"
 }
 ```
+
+Import
+
+Anti-DDoS EIP can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_eip.test bgpip-00000294#1.1.1.1
+```
 */
 package tencentcloud
 
@@ -28,6 +36,9 @@ func resourceTencentCloudDayuEip() *schema.Resource {
 		Create: resourceTencentCloudDayuEipCreate,
 		Read:   resourceTencentCloudDayuEipRead,
 		Delete: resourceTencentCloudDayuEipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -163,6 +174,8 @@ func resourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	_ = d.Set("resource_id", resourceId)
+	_ = d.Set("eip", items[1])
 	if len(bgpIPInstances) != 0 {
 		posBGPIPInstance := bgpIPInstances[0]
 		_ = d.Set("resource_region", *posBGPIPInstance.Region.Region)
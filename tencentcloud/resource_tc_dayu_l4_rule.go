@@ -32,6 +32,14 @@ resource "tencentcloud_dayu_l4_rule" "test_rule" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS layer 4 rule can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_l4_rule.test_rule bgpip#bgpip-00000294#rule-00000001
+```
 */
 package tencentcloud
 
@@ -53,6 +61,9 @@ func resourceTencentCloudDayuL4Rule() *schema.Resource {
 		Read:   resourceTencentCloudDayuL4RuleRead,
 		Update: resourceTencentCloudDayuL4RuleUpdate,
 		Delete: resourceTencentCloudDayuL4RuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -498,6 +509,8 @@ func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("s_port", int(*rule.SourcePort))
 	_ = d.Set("d_port", int(*rule.VirtualPort))
@@ -32,6 +32,14 @@ resource "tencentcloud_dayu_l4_rule" "test_rule" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS layer 4 rule v2 can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_l4_rule_v2.example bgpip#bgpip-00000294#1.1.1.1#80
+```
 */
 package tencentcloud
 
@@ -52,6 +60,9 @@ func resourceTencentCloudDayuL4RuleV2() *schema.Resource {
 		Create: resourceTencentCloudDayuL4RuleCreateV2,
 		Read:   resourceTencentCloudDayuL4RuleReadV2,
 		Delete: resourceTencentCloudDayuL4RuleDeleteV2,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"business": {
@@ -225,13 +236,29 @@ func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	posRules := make([]dayu.NewL4RuleEntry, 0)
+	posRules := make([]map[string]interface{}, 0)
 	for _, rule := range rules {
 		if *rule.Id == resourceId {
-			posRules = append(posRules, *rule)
+			posRules = append(posRules, map[string]interface{}{
+				"protocol":      rule.Protocol,
+				"source_port":   rule.SourcePort,
+				"virtual_port":  rule.VirtualPort,
+				"keeptime":      rule.KeepTime,
+				"source_list":   flattenSourceList(rule.SourceList),
+				"lb_type":       rule.LbType,
+				"keep_enable":   *rule.KeepEnable > 0,
+				"source_type":   rule.SourceType,
+				"rule_name":     rule.RuleName,
+				"remove_switch": *rule.RemoveSwitch > 0,
+				"region":        rule.Region,
+			})
 		}
 	}
 
+	_ = d.Set("business", business)
+	_ = d.Set("resource_id", resourceId)
+	_ = d.Set("vpn", ip)
+	_ = d.Set("virtual_port", virtualPort)
 	_ = d.Set("rules", posRules)
 
 	return nil
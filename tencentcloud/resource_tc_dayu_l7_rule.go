@@ -25,6 +25,14 @@ resource "tencentcloud_dayu_l7_rule" "test_rule" {
   health_check_unhealth_num = 10
 }
 ```
+
+Import
+
+Anti-DDoS layer 7 rule can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_l7_rule.test_rule bgpip#bgpip-00000294#rule-00000001
+```
 */
 package tencentcloud
 
@@ -45,6 +53,9 @@ func resourceTencentCloudDayuL7Rule() *schema.Resource {
 		Read:   resourceTencentCloudDayuL7RuleRead,
 		Update: resourceTencentCloudDayuL7RuleUpdate,
 		Delete: resourceTencentCloudDayuL7RuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -521,6 +532,8 @@ func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("domain", rule.Domain)
 	_ = d.Set("rule_id", rule.RuleId)
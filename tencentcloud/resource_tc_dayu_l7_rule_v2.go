@@ -28,6 +28,14 @@ resource "tencentcloud_dayu_l7_rule_v2" "tencentcloud_dayu_l7_rule_v2" {
   }
 }
 ```
+
+Import
+
+Anti-DDoS layer 7 rule v2 can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dayu_l7_rule_v2.tencentcloud_dayu_l7_rule_v2 bgpip#github.com#http
+```
 */
 package tencentcloud
 
@@ -47,6 +55,9 @@ func resourceTencentCloudDayuL7RuleV2() *schema.Resource {
 		Read:   resourceTencentCloudDayuL7RuleReadV2,
 		Update: resourceTencentCloudDayuL7RuleUpdateV2,
 		Delete: resourceTencentCloudDayuL7RuleDeleteV2,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -289,7 +300,25 @@ func resourceTencentCloudDayuL7RuleReadV2(d *schema.ResourceData, meta interface
 			return err
 		}
 		if *rules[0].Status == uint64(0) {
-			_ = d.Set("resource_id", *rules[0].Id)
+			rule := rules[0]
+			_ = d.Set("resource_type", business)
+			_ = d.Set("resource_id", *rule.Id)
+			_ = d.Set("resource_ip", rule.Ip)
+			_ = d.Set("rule", []map[string]interface{}{
+				{
+					"keeptime":             rule.KeepTime,
+					"domain":               rule.Domain,
+					"protocol":             rule.Protocol,
+					"source_type":          rule.SourceType,
+					"lb_type":              rule.LbType,
+					"cert_type":            rule.CertType,
+					"ssl_id":               rule.SSLId,
+					"source_list":          flattenSourceList(rule.SourceList),
+					"keep_enable":          rule.KeepEnable,
+					"cc_enable":            rule.CCEnable,
+					"https_to_http_enable": rule.HttpsToHttpEnable,
+				},
+			})
 
 			return nil
 		} else {
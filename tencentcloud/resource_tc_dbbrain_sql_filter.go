@@ -31,6 +31,14 @@ resource "tencentcloud_dbbrain_sql_filter" "sql_filter" {
   duration = 3600
 }
 
+```
+
+Import
+
+dbbrain sql_filter can be imported using the instanceId#filterId, e.g.
+
+```
+terraform import tencentcloud_dbbrain_sql_filter.sql_filter cdb-xxxxxxxx#12345
 ```
 */
 package tencentcloud
@@ -53,9 +61,9 @@ func resourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 		Create: resourceTencentCloudDbbrainSqlFilterCreate,
 		Update: resourceTencentCloudDbbrainSqlFilterUpdate,
 		Delete: resourceTencentCloudDbbrainSqlFilterDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -78,6 +86,7 @@ func resourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "password.",
 						},
 					},
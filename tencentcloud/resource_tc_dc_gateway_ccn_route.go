@@ -27,6 +27,14 @@ resource "tencentcloud_dc_gateway_ccn_route" "route2" {
   cidr_block = "192.1.1.0/32"
 }
 ```
+
+Import
+
+Direct connect gateway CCN route can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dc_gateway_ccn_route.route1 dcg-xxxxxxxx#ccnr-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -45,6 +53,9 @@ func resourceTencentCloudDcGatewayCcnRouteInstance() *schema.Resource {
 		Create: resourceTencentCloudDcGatewayCcnRouteCreate,
 		Read:   resourceTencentCloudDcGatewayCcnRouteRead,
 		Delete: resourceTencentCloudDcGatewayCcnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
@@ -28,6 +28,14 @@ resource "tencentcloud_dts_compare_task" "compare_task" {
   }
 
 ```
+
+Import
+
+dts compare_task can be imported using the jobId#compareTaskId, e.g.
+
+```
+terraform import tencentcloud_dts_compare_task.compare_task dts-xxxxxxxx#dts-xxxxxxxx-cmp-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -49,9 +57,9 @@ func resourceTencentCloudDtsCompareTask() *schema.Resource {
 		Create: resourceTencentCloudDtsCompareTaskCreate,
 		Update: resourceTencentCloudDtsCompareTaskUpdate,
 		Delete: resourceTencentCloudDtsCompareTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
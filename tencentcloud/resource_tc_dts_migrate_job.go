@@ -145,6 +145,10 @@ func resourceTencentCloudDtsMigrateJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * readRetryTimeout),
+			Update: schema.DefaultTimeout(3 * readRetryTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Required:    true,
@@ -565,11 +569,13 @@ func resourceTencentCloudDtsMigrateJob() *schema.Resource {
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "TmpSecretKey.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "TmpToken.",
 									},
 								},
@@ -731,11 +737,13 @@ func resourceTencentCloudDtsMigrateJob() *schema.Resource {
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Tmp SecretKey.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
 										Optional:    true,
+										Sensitive:   true,
 										Description: "Tmp Token.",
 									},
 								},
@@ -802,13 +810,19 @@ func resourceTencentCloudDtsMigrateJobCreate(d *schema.ResourceData, meta interf
 		serviceId = v.(string)
 	}
 
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if d.Id() != "" {
+		// called by update
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
 	// case "modify":
 	err := handleModifyMigrate(d, tcClient, logId, serviceId)
 	if err != nil {
 		return err
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"created"}, 3*readRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(serviceId, []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"created"}, timeout, time.Second, service.DtsMigrateJobStateRefreshFunc(serviceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -819,7 +833,7 @@ func resourceTencentCloudDtsMigrateJobCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"checkPass", "checkNotPass"}, 3*readRetryTimeout, time.Second, service.DtsMigrateCheckConfigStateRefreshFunc(serviceId, []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"checkPass", "checkNotPass"}, timeout, time.Second, service.DtsMigrateCheckConfigStateRefreshFunc(serviceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
 		return e
 	}
@@ -112,6 +112,13 @@ resource "tencentcloud_dts_migrate_job_config" "config" {
 }
 ```
 
+Import
+
+dts migrate_job_config can be imported using the job id, the `action` and `complete_mode` are not read, e.g.
+
+```
+terraform import tencentcloud_dts_migrate_job_config.config dts-iy98oxba
+```
 */
 package tencentcloud
 
@@ -133,6 +140,9 @@ func resourceTencentCloudDtsMigrateJobConfig() *schema.Resource {
 		Read:   resourceTencentCloudDtsMigrateJobConfigRead,
 		Update: resourceTencentCloudDtsMigrateJobConfigUpdate,
 		Delete: resourceTencentCloudDtsMigrateJobConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -193,9 +203,9 @@ func resourceTencentCloudDtsMigrateJobConfigRead(d *schema.ResourceData, meta in
 		return nil
 	}
 
-	// if migrateJobConfig.JobId != nil {
-	// 	_ = d.Set("job_id", migrateJobConfig.JobId)
-	// }
+	if migrateJobConfig.JobId != nil {
+		_ = d.Set("job_id", migrateJobConfig.JobId)
+	}
 
 	// if migrateJobConfig.RunMode != nil {
 	// 	_ = d.Set("complete_mode", migrateJobConfig.RunMode)
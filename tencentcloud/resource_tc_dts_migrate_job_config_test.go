@@ -65,6 +65,12 @@ func TestAccTencentCloudDtsMigrateJobConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dts_migrate_job_config.config", "action", "recover"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dts_migrate_job_config.config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"action", "complete_mode"},
+			},
 		},
 	})
 }
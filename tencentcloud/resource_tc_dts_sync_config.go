@@ -582,11 +582,13 @@ func resourceTencentCloudDtsSyncConfig() *schema.Resource {
 						"tmp_secret_key": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Temporary key Key, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"tmp_token": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Temporary Token, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"encrypt_conn": {
@@ -721,11 +723,13 @@ func resourceTencentCloudDtsSyncConfig() *schema.Resource {
 						"tmp_secret_key": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Temporary key Key, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"tmp_token": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Temporary Token, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"encrypt_conn": {
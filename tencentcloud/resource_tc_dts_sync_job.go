@@ -18,6 +18,14 @@ resource "tencentcloud_dts_sync_job" "sync_job" {
   instance_class = "micro"
 }
 
+```
+
+Import
+
+dts sync_job can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_dts_sync_job.sync_job sync-xxxxxxxx
 ```
 */
 package tencentcloud
@@ -39,9 +47,9 @@ func resourceTencentCloudDtsSyncJob() *schema.Resource {
 		Read:   resourceTencentCloudDtsSyncJobRead,
 		Create: resourceTencentCloudDtsSyncJobCreate,
 		Delete: resourceTencentCloudDtsSyncJobDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"pay_mode": {
 				Type:        schema.TypeString,
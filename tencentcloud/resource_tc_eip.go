@@ -47,7 +47,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudEip() *schema.Resource {
@@ -59,6 +58,7 @@ func resourceTencentCloudEip() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -123,6 +123,11 @@ func resourceTencentCloudEip() *schema.Resource {
 				Optional:    true,
 				Description: "The tags of eip.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"bandwidth_package_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -190,7 +195,7 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 		request.AddressChargePrepaid = &addressChargePrepaid
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tag := vpc.Tag{
 				Key:   helper.String(tagKey),
@@ -211,7 +216,6 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 
 	eipId := ""
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := client.UseVpcClient().AllocateAddresses(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -232,7 +236,7 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.SetId(eipId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName(VPC_SERVICE_TYPE, EIP_RESOURCE_TYPE, region, eipId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			log.Printf("[CRITAL]%s set eip tags failed: %+v", logId, err)
@@ -304,7 +308,7 @@ func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error
 	_ = d.Set("public_ip", eip.AddressIp)
 	_ = d.Set("status", eip.AddressStatus)
 	_ = d.Set("internet_charge_type", eip.InternetChargeType)
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	if eip.Bandwidth != nil {
 		_ = d.Set("internet_max_bandwidth_out", eip.Bandwidth)
@@ -397,8 +401,8 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName(VPC_SERVICE_TYPE, EIP_RESOURCE_TYPE, region, eipId)
 
@@ -77,6 +77,7 @@ func resourceTencentCloudEksCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -213,10 +214,16 @@ func resourceTencentCloudEksCluster() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of EKS cluster.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			// computed
 			"kube_config": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "EKS cluster kubeconfig.",
 			},
 		},
@@ -246,7 +253,7 @@ func resourceTencentcloudEKSClusterRead(d *schema.ResourceData, meta interface{}
 	_ = d.Set("service_subnet_id", cluster.ServiceSubnetId)
 	_ = d.Set("subnet_ids", cluster.SubnetIds)
 	_ = d.Set("dns_servers", cluster.DnsServers)
-	_ = d.Set("tags", cluster.Tags)
+	setResourceTags(d, meta, cluster.Tags)
 	_ = d.Set("subnet_ids", cluster.SubnetIds)
 	_ = d.Set("need_delete_cbs", cluster.NeedDeleteCbs)
 	_ = d.Set("enable_vpc_core_dns", cluster.EnableVpcCoreDNS)
@@ -318,7 +325,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 		vpcId            = d.Get("vpc_id").(string)
 		clusterDesc      = d.Get("cluster_desc").(string)
 		enableVpcCoreDns = d.Get("enable_vpc_core_dns").(bool)
-		tags             = helper.GetTags(d, "tags")
+		tags             = mergeDefaultTags(meta, helper.GetTags(d, "tags"))
 		subnetIds        []*string
 		dnsServers       []*tke.DnsServerConf
 	)
@@ -473,7 +480,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		region := client.Region
 		resourceName := BuildTagResourceName("ccs", "cluster", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, []string{}); err != nil {
@@ -620,9 +627,9 @@ func resourceTencentcloudEKSClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		region := client.Region
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("ccs", "cluster", region, id)
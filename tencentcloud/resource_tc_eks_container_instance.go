@@ -799,6 +799,7 @@ func resourceTencentCloudEksContainerInstance() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Password.",
 						},
 						"name": {
@@ -162,7 +162,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	es "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudElasticsearchInstance() *schema.Resource {
@@ -176,6 +175,7 @@ func resourceTencentCloudElasticsearchInstance() *schema.Resource {
 				"basic_security_type": ES_BASIC_SECURITY_TYPE_OFF,
 			}),
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -377,6 +377,11 @@ func resourceTencentCloudElasticsearchInstance() *schema.Resource {
 				Optional:    true,
 				Description: "A mapping of tags to assign to the instance. For tag limits, please refer to [Use Limits](https://intl.cloud.tencent.com/document/product/651/13354).",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// computed
 			"elasticsearch_domain": {
@@ -509,7 +514,6 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 
 	instanceId := ""
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().CreateInstance(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -575,7 +579,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 	}
 
 	// tags
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: client}
 		region := client.Region
@@ -684,7 +688,7 @@ func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta
 		for _, tag := range instance.TagList {
 			tags[*tag.TagKey] = *tag.TagValue
 		}
-		_ = d.Set("tags", tags)
+		setResourceTags(d, meta, tags)
 	}
 
 	return nil
@@ -857,8 +861,8 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return err
 		}
 	}
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
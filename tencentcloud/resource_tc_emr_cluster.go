@@ -88,6 +88,14 @@ resource "tencentcloud_emr_cluster" "emr_cluster" {
 	sg_id=tencentcloud_security_group.emr_sg.id
 }
 ```
+
+Import
+
+EMR cluster can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_emr_cluster.emr_cluster emr-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -101,6 +109,7 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudEmrCluster() *schema.Resource {
@@ -108,7 +117,11 @@ func resourceTencentCloudEmrCluster() *schema.Resource {
 		Create: resourceTencentCloudEmrClusterCreate,
 		Read:   resourceTencentCloudEmrClusterRead,
 		Delete: resourceTencentCloudEmrClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Update: resourceTencentCloudEmrClusterUpdate,
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"display_strategy": {
 				Type:        schema.TypeString,
@@ -257,6 +270,11 @@ func resourceTencentCloudEmrCluster() *schema.Resource {
 				Computed:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -275,8 +293,8 @@ func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface
 	if !hasTimeUnit || !hasTimeSpan || !hasPayMode {
 		return innerErr.New("Time_unit, time_span or pay_mode must be set.")
 	}
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		err := emrService.ModifyResourcesTags(ctx, meta.(*TencentCloudClient).apiV3Conn.Region, instanceId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		if err != nil {
 			return err
@@ -350,7 +368,7 @@ func resourceTencentCloudEmrClusterCreate(d *schema.ResourceData, meta interface
 	emrService := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	instanceId, err := emrService.CreateInstance(ctx, d)
+	instanceId, err := emrService.CreateInstance(ctx, d, mergeDefaultTags(meta, helper.GetTags(d, "tags")))
 	if err != nil {
 		return err
 	}
@@ -463,8 +481,9 @@ func resourceTencentCloudEmrClusterRead(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	instanceId := d.Id()
+	var instance *emr.ClusterInstancesInfo
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
+		result, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -475,18 +494,27 @@ func resourceTencentCloudEmrClusterRead(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return resource.RetryableError(err)
 		}
+		if len(result) > 0 {
+			instance = result[0]
+		}
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
+	if instance != nil {
+		_ = d.Set("instance_id", instance.ClusterId)
+		_ = d.Set("instance_name", instance.ClusterName)
+		_ = d.Set("pay_mode", instance.ChargeType)
+	}
+
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "emr", "emr-instance", region, d.Id())
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
@@ -135,6 +135,12 @@ func TestAccTencentCloudEmrClusterResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testEmrClusterResourceKey, "tags.emr-key", "emr-value"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_emr_cluster.emrrrr",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_strategy", "product_id", "vpc_settings", "softwares", "resource_spec", "support_ha", "placement", "time_span", "time_unit", "login_settings", "extend_fs_field", "need_master_wan", "sg_id"},
+			},
 		},
 	})
 }
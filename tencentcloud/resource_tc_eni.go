@@ -77,6 +77,7 @@ import (
 
 func eniIpOutputResource() *schema.Resource {
 	return &schema.Resource{
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"ip": {
 				Type:        schema.TypeString,
@@ -181,6 +182,11 @@ func resourceTencentCloudEni() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the ENI.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// computed
 			"mac": {
@@ -281,7 +287,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 		return errors.New("ipv4s or ipv4_count must be set")
 	}
 
-	if raw := helper.GetTags(d, "tags"); len(raw) > 0 {
+	if raw := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(raw) > 0 {
 		tags = raw
 	}
 
@@ -436,7 +442,7 @@ func resourceTencentCloudEniRead(d *schema.ResourceData, m interface{}) error {
 	for _, tag := range eni.TagSet {
 		tags[*tag.Key] = *tag.Value
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, m, tags)
 
 	return nil
 }
@@ -655,8 +661,8 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "eni", region, id)
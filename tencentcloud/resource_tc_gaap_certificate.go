@@ -36,6 +36,9 @@ func resourceTencentCloudGaapCertificate() *schema.Resource {
 		Read:   resourceTencentCloudGaapCertificateRead,
 		Update: resourceTencentCloudGaapCertificateUpdate,
 		Delete: resourceTencentCloudGaapCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
@@ -31,11 +31,21 @@ resource tencentcloud_gaap_domain_error_page "foo" {
   body        = "bad request"
 }
 ```
+
+Import
+
+GAAP domain error page can be imported using the listener id, the domain and the error page id, e.g.
+
+```
+terraform import tencentcloud_gaap_domain_error_page.foo listener-11112222#www.qq.com#errorPage-3333
+```
 */
 package tencentcloud
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -46,6 +56,20 @@ func resourceTencentCloudGaapDomainErrorPageInfo() *schema.Resource {
 		Create: resourceTencentCloudGaapDomainErrorPageInfoCreate,
 		Read:   resourceTencentCloudGaapDomainErrorPageInfoRead,
 		Delete: resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the error page is described with its listener and domain, so it is imported by `listener_id#domain#id`
+				idSplit := strings.Split(d.Id(), FILED_SP)
+				if len(idSplit) != 3 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("listener_id", idSplit[0])
+				_ = d.Set("domain", idSplit[1])
+				d.SetId(idSplit[2])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
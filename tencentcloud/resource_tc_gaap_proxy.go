@@ -52,6 +52,7 @@ func resourceTencentCloudGaapProxy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -98,6 +99,11 @@ func resourceTencentCloudGaapProxy() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the GAAP proxy. Tags that do not exist are not created automatically.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"network_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -164,7 +170,7 @@ func resourceTencentCloudGaapProxyCreate(d *schema.ResourceData, m interface{})
 	accessRegion := d.Get("access_region").(string)
 	realserverRegion := d.Get("realserver_region").(string)
 	enable := d.Get("enable").(bool)
-	tags := helper.GetTags(d, "tags")
+	tags := mergeDefaultTags(m, helper.GetTags(d, "tags"))
 
 	if v, ok := d.GetOk("network_type"); ok {
 		params["network_type"] = v.(string)
@@ -261,7 +267,7 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 		for _, tag := range proxy.TagSet {
 			tags[*tag.TagKey] = *tag.TagValue
 		}
-		_ = d.Set("tags", tags)
+		setResourceTags(d, m, tags)
 	}
 
 	if proxy.CreateTime == nil {
@@ -364,8 +370,8 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: m.(*TencentCloudClient).apiV3Conn}
@@ -44,6 +44,7 @@ func resourceTencentCloudGaapRealserver() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"ip": {
 				Type:          schema.TypeString,
@@ -78,6 +79,11 @@ func resourceTencentCloudGaapRealserver() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the GAAP realserver.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -123,7 +129,7 @@ func resourceTencentCloudGaapRealserverCreate(d *schema.ResourceData, m interfac
 		return err
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagClient := m.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tagClient}
 		resourceName := BuildTagResourceName("gaap", "realServer", tagClient.Region, id)
@@ -195,7 +201,7 @@ func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, m, tags)
 
 	return nil
 }
@@ -220,8 +226,8 @@ func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: m.(*TencentCloudClient).apiV3Conn}
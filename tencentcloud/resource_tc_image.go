@@ -31,7 +31,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudImage() *schema.Resource {
@@ -43,6 +42,7 @@ func resourceTencentCloudImage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"image_name": {
@@ -98,6 +98,11 @@ func resourceTencentCloudImage() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the image.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -155,7 +160,7 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 			"snapshot_ids", "data_disk_ids", "data_disk_ids", "instance_id")
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		tags := make([]*cvm.Tag, 0)
 		for tagKey, tagValue := range v {
 			tag := cvm.Tag{
@@ -173,7 +178,6 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 
 	imageId := ""
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := cvmService.client.UseCvmClient().CreateImage(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -189,7 +193,7 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 	d.SetId(imageId)
 
 	// Wait for the tags attached to the vm since tags attachment it's async while vm creation.
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "image", tcClient.Region, imageId)
@@ -262,7 +266,7 @@ func resourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -286,8 +290,8 @@ func resourceTencentCloudImageUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -115,7 +115,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudInstance() *schema.Resource {
@@ -127,6 +126,7 @@ func resourceTencentCloudInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(customizeDiffTagsAll, resourceTencentCloudInstanceCustomizeDiff),
 
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -199,7 +199,7 @@ func resourceTencentCloudInstance() *schema.Resource {
 				Optional:     true,
 				Default:      CVM_CHARGE_TYPE_POSTPAID,
 				ValidateFunc: validateAllowedStringValue(CVM_CHARGE_TYPE),
-				Description:  "The charge type of instance. Valid values are `PREPAID`, `POSTPAID_BY_HOUR`, `SPOTPAID` and `CDHPAID`. The default is `POSTPAID_BY_HOUR`. Note: TencentCloud International only supports `POSTPAID_BY_HOUR` and `CDHPAID`. `PREPAID` instance may not allow to delete before expired. `SPOTPAID` instance must set `spot_instance_type` and `spot_max_price` at the same time. `CDHPAID` instance must set `cdh_instance_type` and `cdh_host_id`.",
+				Description:  "The charge type of instance. Valid values are `PREPAID`, `POSTPAID_BY_HOUR`, `SPOTPAID` and `CDHPAID`. The default is `POSTPAID_BY_HOUR`. Note: TencentCloud International only supports `POSTPAID_BY_HOUR` and `CDHPAID`. `PREPAID` instance may not allow to delete before expired. `SPOTPAID` instance must set `spot_instance_type` and `spot_max_price` at the same time. `CDHPAID` instance must set `cdh_instance_type` and `cdh_host_id`. The charge type of `SPOTPAID` and `CDHPAID` instance can not be changed, and other instances can not be changed to them.",
 			},
 			"instance_charge_type_prepaid_period": {
 				Type:         schema.TypeInt,
@@ -456,6 +456,11 @@ func resourceTencentCloudInstance() *schema.Resource {
 				Optional:    true,
 				Description: "A mapping of tags to assign to the resource. For tag limits, please refer to [Use Limits](https://intl.cloud.tencent.com/document/product/651/13354).",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Tags of the instance, including those inherited from the provider `default_tags`.",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -500,6 +505,61 @@ func resourceTencentCloudInstance() *schema.Resource {
 	}
 }
 
+// resourceTencentCloudInstanceCustomizeDiff rejects the arguments which do not match `instance_charge_type`
+// at plan time, instead of failing in the middle of apply.
+func resourceTencentCloudInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("instance_charge_type") {
+		return nil
+	}
+	chargeType := d.Get("instance_charge_type").(string)
+
+	if chargeType == CVM_CHARGE_TYPE_PREPAID && (d.Id() == "" || d.HasChange("instance_charge_type")) &&
+		d.NewValueKnown("instance_charge_type_prepaid_period") {
+		if _, ok := d.GetOk("instance_charge_type_prepaid_period"); !ok {
+			return fmt.Errorf("`instance_charge_type_prepaid_period` must be set when `instance_charge_type` is %s", chargeType)
+		}
+	}
+
+	if chargeType != CVM_CHARGE_TYPE_SPOTPAID {
+		for _, key := range []string{"spot_instance_type", "spot_max_price"} {
+			if d.Id() != "" && !d.HasChange(key) {
+				continue
+			}
+			if v, ok := d.GetOk(key); ok && v.(string) != "" {
+				return fmt.Errorf("`%s` only works when `instance_charge_type` is %s, got %s", key, CVM_CHARGE_TYPE_SPOTPAID, chargeType)
+			}
+		}
+	}
+
+	if chargeType == CVM_CHARGE_TYPE_CDHPAID && d.Id() == "" {
+		for _, key := range []string{"cdh_instance_type", "cdh_host_id"} {
+			if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+				return fmt.Errorf("`%s` can not be empty when `instance_charge_type` is %s", key, chargeType)
+			}
+		}
+	}
+
+	// spot and cdh instance can not be changed to or from other charge types.
+	if d.Id() != "" && d.HasChange("instance_charge_type") {
+		o, n := d.GetChange("instance_charge_type")
+		if MatchAny(o.(string), CVM_CHARGE_TYPE_SPOTPAID, CVM_CHARGE_TYPE_CDHPAID) ||
+			MatchAny(n.(string), CVM_CHARGE_TYPE_SPOTPAID, CVM_CHARGE_TYPE_CDHPAID) {
+			return fmt.Errorf("`instance_charge_type` can not be changed from %s to %s, please re-create the instance instead", o, n)
+		}
+	}
+
+	// the public ip is released when the instance is stopped without charging, and a new one is
+	// assigned when it starts again.
+	if d.Id() != "" {
+		stopCharging := d.Get("stopped_mode").(string) == CVM_STOP_MODE_STOP_CHARGING && d.HasChange("running_flag")
+		if d.HasChange("allocate_public_ip") || (stopCharging && d.Get("allocate_public_ip").(bool)) {
+			return d.SetNewComputed("public_ip")
+		}
+	}
+
+	return nil
+}
+
 func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_instance.create")()
 	logId := getLogId(contextNil)
@@ -717,7 +777,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		request.DisableApiTermination = helper.Bool(v.(bool))
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		tags := make([]*cvm.Tag, 0)
 		for tagKey, tagValue := range v {
 			tag := cvm.Tag{
@@ -736,8 +796,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 	instanceId := ""
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -796,7 +855,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	// Wait for the tags attached to the vm since tags attachment it's async while vm creation.
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "instance", tcClient.Region, instanceId)
@@ -896,7 +955,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	var response *cvm.DescribeImagesResponse
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		request := cvm.NewDescribeImagesRequest()
-		response, errRet = client.UseCvmClient().DescribeImages(request)
+		response, errRet = client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -955,7 +1014,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	// as attachment add tencentcloud:autoscaling:auto-scaling-group-id tag automatically
 	// we should remove this tag, otherwise it will cause terraform state change
 	delete(tags, "tencentcloud:autoscaling:auto-scaling-group-id")
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	//set data_disks
 	var hasDataDisks, isCombineDataDisks bool
@@ -1461,8 +1520,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -63,6 +63,13 @@ resource "tencentcloud_instance_set" "my_awesome_app" {
 }
 ```
 
+Import
+
+CVM instance set can be imported using the instance ids joined with `,`, e.g.
+
+```
+terraform import tencentcloud_instance_set.my_awesome_app ins-xxxxxxxx,ins-yyyyyyyy
+```
 */
 package tencentcloud
 
@@ -72,6 +79,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -79,7 +87,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudInstanceSet() *schema.Resource {
@@ -88,6 +95,16 @@ func resourceTencentCloudInstanceSet() *schema.Resource {
 		Read:   resourceTencentCloudInstanceSetRead,
 		Update: resourceTencentCloudInstanceSetUpdate,
 		Delete: resourceTencentCloudInstanceSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the instance set is imported by its instance ids joined with `,`
+				instanceIds := helper.StringsStringsPoint(strings.Split(d.Id(), COMMA_SP))
+				_ = d.Set("instance_ids", helper.StringsInterfaces(instanceIds))
+				_ = d.Set("instance_count", len(instanceIds))
+				d.SetId(helper.StrListToStr(instanceIds))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(600 * time.Second),
 			Read:   schema.DefaultTimeout(600 * time.Second),
@@ -542,7 +559,6 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	instanceIds := make([]*string, 0)
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -807,7 +823,6 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 			}
 
 			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check("create")
 				response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
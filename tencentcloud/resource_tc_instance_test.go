@@ -10,6 +10,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func init() {
@@ -61,12 +65,170 @@ func testSweepCvmInstance(region string) error {
 	return nil
 }
 
+func TestUnitCvmServiceDescribeInstances(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	service := CvmService{client: meta.apiV3Conn}
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+	server.StateDelay = 1
+
+	request := cvm.NewRunInstancesRequest()
+	request.Placement = &cvm.Placement{Zone: helper.String("ap-guangzhou-3")}
+	request.InstanceType = helper.String("S5.MEDIUM2")
+	request.ImageId = helper.String("img-test")
+	request.InstanceCount = helper.Int64(230)
+	response, err := meta.apiV3Conn.UseCvmClient().RunInstances(request)
+	assert.Nil(t, err)
+	assert.Len(t, response.Response.InstanceIdSet, 230)
+	instanceId := *response.Response.InstanceIdSet[0]
+
+	// the instances are listed in 3 pages of 100
+	instances, err := service.DescribeInstanceByFilter(ctx, nil, map[string]string{"zone": "ap-guangzhou-3"})
+	assert.Nil(t, err)
+	assert.Len(t, instances, 230)
+	assert.Equal(t, 3, server.Calls("cvm", "DescribeInstances"))
+	assert.Equal(t, "PENDING", *instances[0].InstanceState)
+
+	instance, err := service.DescribeInstanceById(ctx, instanceId)
+	assert.Nil(t, err)
+	assert.Equal(t, CVM_STATUS_RUNNING, *instance.InstanceState)
+
+	server.InjectFault("cvm", "DescribeInstances", fakeapi.Fault{Code: "InvalidParameterValue", Times: 1})
+	_, err = service.DescribeInstanceById(ctx, instanceId)
+	assert.NotNil(t, err)
+
+	// the instance is seen terminating before it disappears
+	assert.Nil(t, service.DeleteInstance(ctx, instanceId))
+	assert.NotNil(t, service.DeleteInstance(ctx, instanceId))
+	instance, err = service.DescribeInstanceById(ctx, instanceId)
+	assert.Nil(t, err)
+	assert.Equal(t, CVM_STATUS_TERMINATING, *instance.InstanceState)
+	instance, err = service.DescribeInstanceById(ctx, instanceId)
+	assert.Nil(t, err)
+	assert.Nil(t, instance)
+}
+
+func TestUnitInstanceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	meta := &TencentCloudClient{}
+	config := func(attributes map[string]interface{}) map[string]interface{} {
+		attributes["image_id"] = "img-test"
+		attributes["availability_zone"] = "ap-guangzhou-3"
+		return attributes
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{"postpaid", config(map[string]interface{}{}), ""},
+		{"prepaid without period", config(map[string]interface{}{"instance_charge_type": "PREPAID"}), "`instance_charge_type_prepaid_period` must be set"},
+		{"prepaid with period", config(map[string]interface{}{"instance_charge_type": "PREPAID", "instance_charge_type_prepaid_period": 1}), ""},
+		{"prepaid with unknown period", config(map[string]interface{}{"instance_charge_type": "PREPAID", "instance_charge_type_prepaid_period": testUnknownValue}), ""},
+		{"spot arguments of postpaid", config(map[string]interface{}{"spot_instance_type": "ONE-TIME"}), "`spot_instance_type` only works"},
+		{"spotpaid", config(map[string]interface{}{"instance_charge_type": "SPOTPAID", "spot_instance_type": "ONE-TIME", "spot_max_price": "0.5"}), ""},
+		{"cdhpaid without host", config(map[string]interface{}{"instance_charge_type": "CDHPAID", "cdh_instance_type": "CDH_10C10G"}), "`cdh_host_id` can not be empty"},
+		{"cdhpaid with unknown host", config(map[string]interface{}{"instance_charge_type": "CDHPAID", "cdh_instance_type": "CDH_10C10G", "cdh_host_id": testUnknownValue}), ""},
+		{"unknown charge type", config(map[string]interface{}{"instance_charge_type": testUnknownValue, "spot_instance_type": "ONE-TIME"}), ""},
+	}
+	for _, tt := range tests {
+		_, err := testResourceDiff(resourceTencentCloudInstance(), nil, tt.config, meta)
+		if tt.err == "" {
+			assert.Nil(t, err, tt.name)
+		} else if assert.NotNil(t, err, tt.name) {
+			assert.Contains(t, err.Error(), tt.err, tt.name)
+		}
+	}
+
+	// spot and cdh instance can not be changed to or from other charge types, but prepaid can
+	state := &terraform.InstanceState{
+		ID: "ins-test",
+		Attributes: map[string]string{
+			"id":                   "ins-test",
+			"image_id":             "img-test",
+			"availability_zone":    "ap-guangzhou-3",
+			"instance_charge_type": "POSTPAID_BY_HOUR",
+		},
+	}
+	_, err := testResourceDiff(resourceTencentCloudInstance(), state, config(map[string]interface{}{"instance_charge_type": "SPOTPAID", "spot_instance_type": "ONE-TIME"}), meta)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "can not be changed from POSTPAID_BY_HOUR to SPOTPAID")
+	}
+
+	diff, err := testResourceDiff(resourceTencentCloudInstance(), state, config(map[string]interface{}{"instance_charge_type": "PREPAID", "instance_charge_type_prepaid_period": 1}), meta)
+	assert.Nil(t, err)
+	assert.False(t, diff.Attributes["instance_charge_type"].RequiresNew)
+
+	// the spot arguments left in the state are not checked until they are changed
+	state.Attributes["spot_instance_type"] = "ONE-TIME"
+	state.Attributes["allocate_public_ip"] = "false"
+	state.Attributes["data_disks.#"] = "0"
+	_, err = testResourceDiff(resourceTencentCloudInstance(), state, config(map[string]interface{}{"spot_instance_type": "ONE-TIME", "instance_name": "test"}), meta)
+	assert.Nil(t, err)
+
+	_, err = testResourceDiff(resourceTencentCloudInstance(), state, config(map[string]interface{}{"spot_max_price": "0.5"}), meta)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "`spot_max_price` only works")
+	}
+}
+
+func TestReplayFakeApiInstance(t *testing.T) {
+	meta := testReplayMeta(t)
+
+	vpcState, err := testResourceApply(resourceTencentCloudVpcInstance(), nil, map[string]interface{}{
+		"name":       defaultInsName,
+		"cidr_block": defaultVpcCidr,
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	subnetState, err := testResourceApply(resourceTencentCloudVpcSubnet(), nil, map[string]interface{}{
+		"name":              defaultInsName,
+		"vpc_id":            vpcState.ID,
+		"cidr_block":        defaultSubnetCidr,
+		"availability_zone": defaultCvmAZone,
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	r := resourceTencentCloudInstance()
+	state, err := testResourceApply(r, nil, map[string]interface{}{
+		"instance_name":     defaultInsName,
+		"availability_zone": defaultCvmAZone,
+		"image_id":          defaultTkeOSImageId,
+		"instance_type":     "SA2.MEDIUM2",
+		"vpc_id":            vpcState.ID,
+		"subnet_id":         subnetState.ID,
+		"system_disk_type":  "CLOUD_PREMIUM",
+		"project_id":        0,
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.NotEmpty(t, state.ID)
+	assert.Equal(t, CVM_STATUS_RUNNING, state.Attributes["instance_status"])
+	assert.Equal(t, subnetState.ID, state.Attributes["subnet_id"])
+	assert.NotEmpty(t, state.Attributes["private_ip"])
+
+	assert.Nil(t, testResourceDestroy(r, state, meta))
+	state, err = testResourceRefresh(r, state, meta)
+	assert.Nil(t, err)
+	assert.Nil(t, state)
+
+	assert.Nil(t, testResourceDestroy(resourceTencentCloudVpcSubnet(), subnetState, meta))
+	assert.Nil(t, testResourceDestroy(resourceTencentCloudVpcInstance(), vpcState, meta))
+}
+
 func TestAccTencentCloudInstanceResource_Basic(t *testing.T) {
 	t.Parallel()
 
 	id := "tencentcloud_instance.cvm_basic"
 	resource.Test(t, resource.TestCase{
-		PreCheck:      func() { testAccPreCheck(t) },
+		PreCheck:      func() { testAccPreCheckRecorder(t) },
 		IDRefreshName: id,
 		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckInstanceDestroy,
@@ -12,6 +12,13 @@ resource "tencentcloud_ipv6_address_bandwidth" "ipv6_address_bandwidth" {
 }
 ```
 
+Import
+
+IPv6 address bandwidth can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_ipv6_address_bandwidth.ipv6_address_bandwidth eipv6-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -34,9 +41,9 @@ func resourceTencentCloudIpv6AddressBandwidth() *schema.Resource {
 		Update: resourceTencentCloudIpv6AddressBandwidthUpdate,
 		Delete: resourceTencentCloudIpv6AddressBandwidthDelete,
 		// it can support import because
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"ipv6_address": {
 				Required:    true,
@@ -25,6 +25,11 @@ func TestAccTencentCloudIpv6AddressBandwidthResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_ipv6_address_bandwidth.ipv6_address", "internet_max_bandwidth_out", "8"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_ipv6_address_bandwidth.ipv6_address",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -102,6 +102,11 @@ func TencentKmsBasicInfo() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Tags of CMK.",
 		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+		},
 		"key_state": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -140,6 +145,7 @@ func resourceTencentCloudKmsKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: specialInfo,
 	}
@@ -233,7 +239,7 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
@@ -298,7 +304,7 @@ func resourceTencentCloudKmsKeyRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -380,11 +386,11 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
 		if err != nil {
@@ -136,6 +136,14 @@ resource "tencentcloud_kubernetes_auth_attachment" "test_use_tke_default_auth_at
   use_tke_default                      = true
 }
 ```
+
+Import
+
+TKE auth attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_kubernetes_auth_attachment.test_auth_attach cls-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -193,6 +201,9 @@ func resourceTencentCloudTKEAuthAttachment() *schema.Resource {
 		Update: resourceTencentCloudTKEAuthAttachmentUpdate,
 		Read:   resourceTencentCloudTKEAuthAttachmentRead,
 		Delete: resourceTencentCloudTKEAuthAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 	}
 }
 
@@ -253,8 +264,16 @@ func resourceTencentCloudTKEAuthAttachmentRead(d *schema.ResourceData, meta inte
 	}
 
 	d.SetId(id)
+	_ = d.Set("cluster_id", id)
 
-	if v, ok := d.GetOk("use_tke_default"); ok && v.(bool) {
+	useTkeDefault := d.Get("use_tke_default").(bool)
+	// the field may be null in the response, the one in the state is kept then
+	if info.UseTKEDefault != nil {
+		useTkeDefault = *info.UseTKEDefault
+	}
+	_ = d.Set("use_tke_default", useTkeDefault)
+
+	if useTkeDefault {
 		_ = d.Set("tke_default_issuer", info.Issuer)
 		_ = d.Set("tke_default_jwks_uri", info.JWKSURI)
 	} else {
@@ -19,6 +19,12 @@ func TestAccTencentCloudKubernetesAuthAttachResource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_kubernetes_auth_attachment.test_auth_attach", "auto_create_discovery_anonymous_auth", "true"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_kubernetes_auth_attachment.test_auth_attach",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_create_discovery_anonymous_auth"},
+			},
 		},
 	})
 }
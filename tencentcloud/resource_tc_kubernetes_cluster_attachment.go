@@ -95,6 +95,14 @@ Example Usage
 	}
 
 ```
+
+Import
+
+TKE cluster attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_kubernetes_cluster_attachment.test_attach ins-xxxxxxxx_cls-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -109,7 +117,6 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func TKEGpuArgsSetting() map[string]*schema.Schema {
@@ -344,6 +351,9 @@ func resourceTencentCloudTkeClusterAttachment() *schema.Resource {
 		Create: resourceTencentCloudTkeClusterAttachmentCreate,
 		Read:   resourceTencentCloudTkeClusterAttachmentRead,
 		Delete: resourceTencentCloudTkeClusterAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: schemaBody,
 	}
 }
@@ -471,6 +481,8 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	} else {
 		instanceId, clusterId = items[0], items[1]
 	}
+	_ = d.Set("instance_id", instanceId)
+	_ = d.Set("cluster_id", clusterId)
 
 	/*tke has been deleted*/
 	_, has, err := tkeService.DescribeCluster(ctx, clusterId)
@@ -646,7 +658,6 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	var response *tke.AddExistedInstancesResponse
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = tkeService.client.UseTkeClient().AddExistedInstances(request)
 		if err != nil {
 			return retryError(err, InternalError)
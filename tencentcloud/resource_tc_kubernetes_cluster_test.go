@@ -149,6 +149,57 @@ func TestAccTencentCloudKubernetesClusterResourceLogsAddons(t *testing.T) {
 	})
 }
 
+func TestUnitTkeClusterCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	service := VpcService{client: meta.apiV3Conn}
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+	vpcId, _, err := service.CreateVpc(ctx, "vpc-test", "10.0.0.0/16", false, nil, nil)
+	assert.Nil(t, err)
+
+	config := func(attributes map[string]interface{}) map[string]interface{} {
+		if _, ok := attributes["vpc_id"]; !ok {
+			attributes["vpc_id"] = vpcId
+		}
+		return attributes
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{"valid", config(map[string]interface{}{"cluster_cidr": "172.16.0.0/16", "service_cidr": "192.168.0.0/24"}), ""},
+		{"no cluster cidr", config(map[string]interface{}{}), "`cluster_cidr` must be set"},
+		{"small cluster cidr", config(map[string]interface{}{"cluster_cidr": "172.16.0.0/24"}), "is too small to cover `cluster_max_pod_num` 256"},
+		{"cluster cidr overlapping service cidr", config(map[string]interface{}{"cluster_cidr": "172.16.0.0/16", "service_cidr": "172.16.255.0/24"}), "`cluster_cidr` 172.16.0.0/16 overlaps `service_cidr`"},
+		{"cluster cidr overlapping vpc", config(map[string]interface{}{"cluster_cidr": "10.0.128.0/17"}), "overlaps the CIDR block 10.0.0.0/16 of vpc"},
+		{"cluster cidr conflict ignored", config(map[string]interface{}{"cluster_cidr": "10.0.128.0/17", "ignore_cluster_cidr_conflict": true}), ""},
+		{"service cidr overlapping vpc", config(map[string]interface{}{"cluster_cidr": "172.16.0.0/16", "service_cidr": "10.0.0.0/24"}), "`service_cidr` 10.0.0.0/24 overlaps the CIDR block 10.0.0.0/16 of vpc"},
+		{"vpc-cni without eni subnets", config(map[string]interface{}{"network_type": "VPC-CNI", "service_cidr": "192.168.0.0/24"}), "`eni_subnet_ids` must be set"},
+		{"unknown cluster cidr", config(map[string]interface{}{"cluster_cidr": testUnknownValue}), ""},
+		// the static checks run without the vpc known
+		{"small cluster cidr of unknown vpc", config(map[string]interface{}{"vpc_id": testUnknownValue, "cluster_cidr": "172.16.0.0/24"}), "is too small to cover"},
+		{"overlapped cidr of unknown vpc", config(map[string]interface{}{"vpc_id": testUnknownValue, "cluster_cidr": "172.16.0.0/16", "service_cidr": "172.16.255.0/24"}), "overlaps `service_cidr`"},
+		{"valid cidr of unknown vpc", config(map[string]interface{}{"vpc_id": testUnknownValue, "cluster_cidr": "10.0.128.0/17"}), ""},
+	}
+	for _, tt := range tests {
+		_, err := testResourceDiff(resourceTencentCloudTkeCluster(), nil, tt.config, meta)
+		if tt.err == "" {
+			assert.Nil(t, err, tt.name)
+		} else if assert.NotNil(t, err, tt.name) {
+			assert.Contains(t, err.Error(), tt.err, tt.name)
+		}
+	}
+
+	// the unknown vpc is not looked up
+	calls := server.Calls("vpc", "DescribeVpcs")
+	_, err = testResourceDiff(resourceTencentCloudTkeCluster(), nil, config(map[string]interface{}{"vpc_id": testUnknownValue, "cluster_cidr": "172.16.0.0/16"}), meta)
+	assert.Nil(t, err)
+	assert.Equal(t, calls, server.Calls("vpc", "DescribeVpcs"))
+}
+
 func TestUnitTkeAddonDiff(t *testing.T) {
 	t.Parallel()
 	addons1 := []interface{}{
@@ -48,6 +48,14 @@ resource "tencentcloud_kubernetes_encryption_protection" "example" {
   }
 }
 ```
+
+Import
+
+TKE encryption protection can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_kubernetes_encryption_protection.example cls-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -67,6 +75,9 @@ func resourceTencentCloudKubernetesEncryptionProtection() *schema.Resource {
 		Create: resourceTencentCloudKubernetesEncryptionProtectionCreate,
 		Read:   resourceTencentCloudKubernetesEncryptionProtectionRead,
 		Delete: resourceTencentCloudKubernetesEncryptionProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -181,6 +192,8 @@ func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceDa
 		return nil
 	}
 
+	_ = d.Set("cluster_id", encryptionProtectionId)
+
 	if encryptionProtection.Status != nil {
 		_ = d.Set("status", encryptionProtection.Status)
 	}
@@ -28,6 +28,12 @@ func TestAccTencentCloudKubernetesEncryptionProtectionResource_basic(t *testing.
 					resource.TestCheckResourceAttrSet("tencentcloud_kubernetes_encryption_protection.example", "status"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_kubernetes_encryption_protection.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"kms_configuration"},
+			},
 		},
 	})
 }
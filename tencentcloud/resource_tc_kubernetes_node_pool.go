@@ -396,6 +396,7 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 		Read:   resourceKubernetesNodePoolRead,
 		Delete: resourceKubernetesNodePoolDelete,
 		Update: resourceKubernetesNodePoolUpdate,
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -586,6 +587,11 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 				Optional:    true,
 				Description: "Node pool tag specifications, will passthroughs to the scaling instances.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			//computed
 			"status": {
 				Type:        schema.TypeString,
@@ -621,6 +627,10 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * readRetryTimeout),
+			Delete: schema.DefaultTimeout(5 * readRetryTimeout),
+		},
 		//compare to console, miss cam_role and running_version and lock_initial_node and security_proof
 	}
 }
@@ -1131,7 +1141,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 			tag := tags[i]
 			tagMap[*tag.Key] = *tag.Value
 		}
-		_ = d.Set("tags", tagMap)
+		setResourceTags(d, meta, tagMap)
 	}
 
 	//if nodePool.DeletionProtection != nil {
@@ -1358,7 +1368,7 @@ func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(clusterId + FILED_SP + nodePoolId)
 
 	// wait for status ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		nodePool, _, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1478,7 +1488,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		nodeOsType := d.Get("node_os_type").(string)
 		labels := GetTkeLabels(d, "labels")
 		taints := GetTkeTaints(d, "taints")
-		tags := helper.GetTags(d, "tags")
+		tags := mergeDefaultTags(meta, helper.GetTags(d, "tags"))
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePool(ctx, clusterId, nodePoolId, name, enableAutoScale, minSize, maxSize, nodeOs, nodeOsType, labels, taints, tags)
 			if errRet != nil {
@@ -1632,7 +1642,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	// wait for delete ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		nodePool, has, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			errCode := errRet.(*sdkErrors.TencentCloudSDKError).Code
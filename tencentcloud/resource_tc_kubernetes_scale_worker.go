@@ -99,6 +99,14 @@ resource tencentcloud_kubernetes_scale_worker test_scale {
   }
 }
 ```
+
+Import
+
+tke scale worker can be imported using the cluster id and the ids of the instances, e.g.
+
+```
+terraform import tencentcloud_kubernetes_scale_worker.test_scale cls-godovr32#ins-3j8nxdqh#ins-ry1xlmfb
+```
 */
 package tencentcloud
 
@@ -123,6 +131,9 @@ func resourceTencentCloudTkeScaleWorker() *schema.Resource {
 		Create: resourceTencentCloudTkeScaleWorkerCreate,
 		Read:   resourceTencentCloudTkeScaleWorkerRead,
 		Delete: resourceTencentCloudTkeScaleWorkerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudTkeScaleWorkerImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -357,6 +368,36 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 	return resourceTencentCloudTkeScaleWorkerRead(d, meta)
 }
 
+// resourceTencentCloudTkeScaleWorkerImport imports the workers by `cluster_id#instance_id[#instance_id...]`,
+// the id of the workers is the md5 of them.
+func resourceTencentCloudTkeScaleWorkerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) < 2 {
+		return nil, fmt.Errorf("id is broken, %s", d.Id())
+	}
+	clusterId, instanceIds := idSplit[0], idSplit[1:]
+
+	md := md5.New()
+	if _, err := md.Write([]byte(clusterId + strings.Join(instanceIds, "#"))); err != nil {
+		return nil, err
+	}
+
+	workerInstancesList := make([]map[string]interface{}, 0, len(instanceIds))
+	for _, instanceId := range instanceIds {
+		workerInstancesList = append(workerInstancesList, map[string]interface{}{
+			"instance_id":   instanceId,
+			"instance_role": TKE_ROLE_WORKER,
+		})
+	}
+	_ = d.Set("cluster_id", clusterId)
+	if err := d.Set("worker_instances_list", workerInstancesList); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("TkeScaleWorker.%x", md.Sum(nil)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interface{}) error {
 
 	defer logElapsed("resource.tencentcloud_kubernetes_scale_worker.read")()
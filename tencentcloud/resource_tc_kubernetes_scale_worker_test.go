@@ -103,10 +103,25 @@ func TestAccTencentCloudKubernetesScaleWorkerResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testTkeScaleWorkerResourceKey, "gpu_args.#"),
 				),
 			},
+			{
+				ResourceName:            testTkeScaleWorkerResourceKey,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccTkeScaleWorkerImportStateId,
+				ImportStateVerifyIgnore: []string{"worker_config", "extra_args", "gpu_args", "unschedulable", "desired_pod_num", "docker_graph_path", "mount_target", "data_disk"},
+			},
 		},
 	})
 }
 
+func testAccTkeScaleWorkerImportStateId(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testTkeScaleWorkerResourceKey]
+	if !ok {
+		return "", fmt.Errorf("tke scale worker %s is not found", testTkeScaleWorkerResourceKey)
+	}
+	return rs.Primary.Attributes["cluster_id"] + FILED_SP + rs.Primary.Attributes["worker_instances_list.0.instance_id"], nil
+}
+
 func testAccCheckTkeScaleWorkerDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != testTkeScaleWorkerResourceName {
@@ -17,6 +17,14 @@ resource "tencentcloud_lighthouse_disk" "disk" {
   disk_name = "test"
 }
 ```
+
+Import
+
+Lighthouse disk can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_lighthouse_disk.disk lhdisk-xxxxxx
+```
 */
 package tencentcloud
 
@@ -38,6 +46,9 @@ func resourceTencentCloudLighthouseDisk() *schema.Resource {
 		Read:   resourceTencentCloudLighthouseDiskRead,
 		Update: resourceTencentCloudLighthouseDiskUpdate,
 		Delete: resourceTencentCloudLighthouseDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
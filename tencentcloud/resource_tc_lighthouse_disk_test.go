@@ -16,6 +16,12 @@ func TestAccTencentCloudLighthouseDiskResource_basic(t *testing.T) {
 				Config: testAccLighthouseDisk,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_lighthouse_disk.disk", "id")),
 			},
+			{
+				ResourceName:            "tencentcloud_lighthouse_disk.disk",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disk_charge_prepaid", "disk_count", "auto_voucher", "auto_mount_configuration"},
+			},
 		},
 	})
 }
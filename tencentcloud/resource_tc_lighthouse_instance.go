@@ -76,6 +76,13 @@ resource "tencentcloud_lighthouse_instance" "lighthouse" {
 }
 ```
 
+Import
+
+Lighthouse instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_lighthouse_instance.lighthouse lhins-xxxxxx
+```
 */
 package tencentcloud
 
@@ -97,6 +104,9 @@ func resourceTencentCloudLighthouseInstance() *schema.Resource {
 		Create: resourceTencentCloudLighthouseInstanceCreate,
 		Read:   resourceTencentCloudLighthouseInstanceRead,
 		Delete: resourceTencentCloudLighthouseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Update: resourceTencentCloudLighthouseInstanceUpdate,
 		Schema: map[string]*schema.Schema{
 			"bundle_id": {
@@ -163,6 +173,7 @@ func resourceTencentCloudLighthouseInstance() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Login password.",
 						},
 					},
@@ -55,6 +55,7 @@ func resourceTencentCloudLighthouseKeyPair() *schema.Resource {
 			"private_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Key to private key.",
 			},
 			"created_time": {
@@ -9,6 +9,14 @@ resource "tencentcloud_lighthouse_snapshot" "snapshot" {
   snapshot_name = "snap_20200903"
 }
 ```
+
+Import
+
+Lighthouse snapshot can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_lighthouse_snapshot.snapshot lhsnap-xxxxxx
+```
 */
 package tencentcloud
 
@@ -29,6 +37,9 @@ func resourceTencentCloudLighthouseSnapshot() *schema.Resource {
 		Read:   resourceTencentCloudLighthouseSnapshotRead,
 		Update: resourceTencentCloudLighthouseSnapshotUpdate,
 		Delete: resourceTencentCloudLighthouseSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
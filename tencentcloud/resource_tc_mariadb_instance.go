@@ -73,6 +73,7 @@ func resourceTencentCloudMariadbInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -371,6 +372,11 @@ func resourceTencentCloudMariadbInstance() *schema.Resource {
 				Optional:    true,
 				Description: "tag list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			"init_params": {
 				Optional:    true,
@@ -495,11 +501,11 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 		request.Ipv6Flag = helper.IntInt64(v.(int))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		for key, value := range v.(map[string]interface{}) {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
+		for key, value := range v {
 			resourceTag := mariadb.ResourceTag{
 				TagKey:   helper.String(key),
-				TagValue: helper.String(value.(string)),
+				TagValue: helper.String(value),
 			}
 			request.ResourceTags = append(request.ResourceTags, &resourceTag)
 		}
@@ -572,7 +578,7 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::mariadb:%s:uin/:instance/%s", region, instanceId)
@@ -795,7 +801,7 @@ func resourceTencentCloudMariadbInstanceRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	DbInstance, err := service.DescribeMariadbDbInstanceDetail(ctx, instanceId)
 	if err != nil {
@@ -857,10 +863,10 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("mariadb", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
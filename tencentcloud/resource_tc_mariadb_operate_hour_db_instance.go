@@ -9,6 +9,14 @@ resource "tencentcloud_mariadb_operate_hour_db_instance" "activate_hour_db_insta
   operate     = "activate"
 }
 ```
+
+Import
+
+mariadb operate_hour_db_instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mariadb_operate_hour_db_instance.activate_hour_db_instance tdsql-9vqvls95
+```
 */
 package tencentcloud
 
@@ -29,6 +37,9 @@ func resourceTencentCloudMariadbOperateHourDbInstance() *schema.Resource {
 		Read:   resourceTencentCloudMariadbActivateHourDbInstanceRead,
 		Update: resourceTencentCloudMariadbActivateHourDbInstanceUpdate,
 		Delete: resourceTencentCloudMariadbActivateHourDbInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -82,6 +93,18 @@ func resourceTencentCloudMariadbActivateHourDbInstanceRead(d *schema.ResourceDat
 			return retryError(e)
 		}
 
+		if operate == "" {
+			// the operate of the imported resource is told by the status
+			switch *result.Status {
+			case MARIADB_STATUS_RUNNING:
+				operate = "activate"
+			case MARIADB_STATUS_ISOLATE:
+				operate = "isolate"
+			case MARIADB_STATUS_FLOW:
+				return resource.RetryableError(fmt.Errorf("mariadb instance status is flow"))
+			}
+		}
+
 		if operate == "activate" {
 			if *result.Status == MARIADB_STATUS_RUNNING {
 				return nil
@@ -111,6 +134,7 @@ func resourceTencentCloudMariadbActivateHourDbInstanceRead(d *schema.ResourceDat
 		return err
 	}
 
+	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("operate", operate)
 
 	return nil
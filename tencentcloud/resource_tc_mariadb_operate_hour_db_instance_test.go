@@ -21,6 +21,11 @@ func TestAccTencentCloudMariadbOperateHourDbInstanceResource_basic(t *testing.T)
 					resource.TestCheckResourceAttrSet("tencentcloud_mariadb_operate_hour_db_instance.activate_hour_db_instance", "id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mariadb_operate_hour_db_instance.activate_hour_db_instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
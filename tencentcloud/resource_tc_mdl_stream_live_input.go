@@ -115,6 +115,7 @@ func resourceTencentCloudMdlStreamLiveInput() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "The password, which is used for authentication.Note: This field may return `null`, indicating that no valid value was found.",
 						},
 					},
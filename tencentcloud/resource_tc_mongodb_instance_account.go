@@ -16,6 +16,14 @@ resource "tencentcloud_mongodb_instance_account" "instance_account" {
   }
 }
 ```
+
+Import
+
+MongoDB instance account can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mongodb_instance_account.instance_account cmgo-xxxxxxxx#test_account
+```
 */
 package tencentcloud
 
@@ -37,6 +45,9 @@ func resourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 		Read:   resourceTencentCloudMongodbInstanceAccountRead,
 		Update: resourceTencentCloudMongodbInstanceAccountUpdate,
 		Delete: resourceTencentCloudMongodbInstanceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -55,6 +66,7 @@ func resourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 			"password": {
 				Required:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "New account password. Password complexity requirements are as follows: character length range [8,32]. Contains at least letters, numbers and special characters (exclamation point!, at@, pound sign #, percent sign %, caret ^, asterisk *, parentheses (), underscore _).",
 			},
 
@@ -62,6 +74,7 @@ func resourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 				Required:    true,
 				Type:        schema.TypeString,
 				ForceNew:    true,
+				Sensitive:   true,
 				Description: "The password corresponding to the mongouser account. mongouser is the system default account, which is the password set when creating an instance.",
 			},
 
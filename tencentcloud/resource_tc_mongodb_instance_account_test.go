@@ -18,6 +18,12 @@ func TestAccTencentCloudNeedFixMongodbInstanceAccountResource_basic(t *testing.T
 				Config: testAccMongodbInstanceAccount,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_mongodb_instance_account.instance_account", "id")),
 			},
+			{
+				ResourceName:            "tencentcloud_mongodb_instance_account.instance_account",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "mongo_user_password"},
+			},
 		},
 	})
 }
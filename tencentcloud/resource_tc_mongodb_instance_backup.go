@@ -11,12 +11,21 @@ resource "tencentcloud_mongodb_instance_backup" "instance_backup" {
 }
 ```
 
+Import
+
+mongodb instance_backup can be imported using the instance id and the backup name, e.g.
+
+```
+terraform import tencentcloud_mongodb_instance_backup.instance_backup cmgo-9d0p6umb#cmgo-9d0p6umb_2023-10-17_10:00:00
+```
 */
 package tencentcloud
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,6 +39,9 @@ func resourceTencentCloudMongodbInstanceBackup() *schema.Resource {
 		Create: resourceTencentCloudMongodbInstanceBackupCreate,
 		Read:   resourceTencentCloudMongodbInstanceBackupRead,
 		Delete: resourceTencentCloudMongodbInstanceBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMongodbInstanceBackupImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 		},
@@ -113,10 +125,55 @@ func resourceTencentCloudMongodbInstanceBackupCreate(d *schema.ResourceData, met
 	return resourceTencentCloudMongodbInstanceBackupRead(d, meta)
 }
 
+// resourceTencentCloudMongodbInstanceBackupImport imports the backup by `instance_id#backup_name`, the backup
+// created is identified by the id of its task, which can't be found once the task is done.
+func resourceTencentCloudMongodbInstanceBackupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 2 {
+		return nil, fmt.Errorf("id is broken, %s", d.Id())
+	}
+	_ = d.Set("instance_id", idSplit[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMongodbInstanceBackupRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
+	// only the backup imported is read, see resourceTencentCloudMongodbInstanceBackupImport
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 2 {
+		return nil
+	}
+	instanceId, backupName := idSplit[0], idSplit[1]
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	backups, err := service.DescribeMongodbInstanceBackupsByFilter(ctx, map[string]interface{}{
+		"instance_id": helper.String(instanceId),
+	})
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if backup.BackupName == nil || *backup.BackupName != backupName {
+			continue
+		}
+		_ = d.Set("instance_id", instanceId)
+		if backup.BackupMethod != nil {
+			_ = d.Set("backup_method", int(*backup.BackupMethod))
+		}
+		if backup.BackupDesc != nil {
+			_ = d.Set("backup_remark", backup.BackupDesc)
+		}
+		return nil
+	}
+
+	d.SetId("")
+	log.Printf("[WARN]%s resource `MongodbInstanceBackup` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
 	return nil
 }
 
@@ -66,7 +66,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMongodbStandbyInstance() *schema.Resource {
@@ -118,6 +117,7 @@ func resourceTencentCloudMongodbStandbyInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: mongodbStandbyInstanceInfo,
 	}
@@ -199,7 +199,6 @@ func mongodbCreateStandbyInstanceByUse(ctx context.Context, d *schema.ResourceDa
 	var response *mongodb.CreateDBInstanceHourResponse
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHour(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -230,7 +229,6 @@ func mongodbCreateStandbyInstanceByMonth(ctx context.Context, d *schema.Resource
 	var response *mongodb.CreateDBInstanceResponse
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstance(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -257,9 +255,7 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
-	client1 := *client
 	mongodbService := MongodbService{client: client}
-	mongodbService1 := MongodbService{client: &client1}
 	tagService := TagService{client: client}
 	region := client.Region
 
@@ -272,7 +268,7 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 		return fmt.Errorf("[CRITAL] father instance region must be specified for standby instance")
 	}
 	fatherRegion := d.Get("father_instance_region").(string)
-	mongodbService1.client.Region = fatherRegion
+	mongodbService1 := MongodbService{client: client.WithRegion(fatherRegion)}
 	masterInfoMap["father_instance_id"] = d.Get("father_instance_id").(string)
 	masterInfo, has, err := mongodbService1.DescribeInstanceById(ctx, masterInfoMap["father_instance_id"])
 	if err != nil {
@@ -339,7 +335,7 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 		return fmt.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -425,7 +421,7 @@ func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta
 
 		tags[*tag.TagKey] = *tag.TagValue
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -494,8 +490,8 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
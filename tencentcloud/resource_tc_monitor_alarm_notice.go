@@ -75,7 +75,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorAlarmNotice() *schema.Resource {
@@ -428,7 +427,6 @@ func resourceTencentMonitorAlarmNoticeCreate(d *schema.ResourceData, meta interf
 
 	var noticeId *string
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreateAlarmNotice(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -693,7 +691,6 @@ func resourceTencentMonitorAlarmNoticeUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := monitorService.client.UseMonitorClient().ModifyAlarmNotice(request)
 		if err != nil {
 			return retryError(err, InternalError)
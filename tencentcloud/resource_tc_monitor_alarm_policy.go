@@ -265,7 +265,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func AlarmPolicyRule() map[string]*schema.Schema {
@@ -673,7 +672,6 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 	var groupId *string
 	var policyId *string
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreateAlarmPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -711,7 +709,6 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		request.Tag = tagSet[0]
 
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := monitorService.client.UseMonitorClient().BindingPolicyTag(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -747,7 +744,6 @@ func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interfac
 	request.Module = helper.String("monitor")
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().DescribeAlarmPolicy(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -912,7 +908,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -931,7 +926,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -950,7 +944,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Enable = helper.IntInt64(enable)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyStatus(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1083,7 +1076,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyCondition(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1107,7 +1099,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyNotice(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1133,7 +1124,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			request.TriggerTasks = tasks
 		}
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyTasks(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1157,7 +1147,6 @@ func resourceTencentMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interf
 	request.PolicyIds = policyIds
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err := monitorService.client.UseMonitorClient().DeleteAlarmPolicy(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -46,7 +46,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorBindingObject() *schema.Resource {
@@ -140,7 +139,6 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -292,7 +290,6 @@ func resourceTencentMonitorBindingObjectDelete(d *schema.ResourceData, meta inte
 	request.UniqueId = uniqueIds
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObject(request); err != nil {
 			return retryError(err, InternalError)
 		}
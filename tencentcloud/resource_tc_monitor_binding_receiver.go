@@ -35,19 +35,27 @@ resource "tencentcloud_monitor_binding_receiver" "receiver" {
   }
 }
 ```
+
+Import
+
+Monitor binding receiver can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_binding_receiver.receiver 1234567
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
@@ -56,6 +64,9 @@ func resourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
 		Read:   resourceTencentMonitorBindingAlarmReceiverRead,
 		Update: resourceTencentMonitorBindingAlarmReceiverUpdate,
 		Delete: resourceTencentMonitorBindingAlarmReceiverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
@@ -179,7 +190,6 @@ func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -201,9 +211,14 @@ func resourceTencentMonitorBindingAlarmReceiverRead(d *schema.ResourceData, meta
 		logId          = getLogId(contextNil)
 		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-		groupId        = int64(d.Get("group_id").(int))
 	)
 
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("id is broken,%s", d.Id())
+	}
+	_ = d.Set("group_id", groupId)
+
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
 		return err
@@ -285,7 +300,6 @@ func resourceTencentMonitorBindingAlarmReceiverUpdate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -321,7 +335,6 @@ func resourceTencentMonitorBindingAlarmReceiverDelete(d *schema.ResourceData, me
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -40,6 +40,14 @@ resource "tencentcloud_monitor_grafana_integration" "grafanaIntegration" {
   content     = "{\"kind\":\"tencentcloud-monitor-app\",\"spec\":{\"dataSourceSpec\":{\"authProvider\":{\"__anyOf\":\"使用密钥\",\"useRole\":true,\"secretId\":\"arunma@tencent.com\",\"secretKey\":\"12345678\"},\"name\":\"uint-test\"},\"grafanaSpec\":{\"organizationIds\":[]}}}"
 }
 ```
+
+Import
+
+Monitor grafana integration can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_grafana_integration.grafanaIntegration integration-xxxxxxxx#grafana-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -61,6 +69,9 @@ func resourceTencentCloudMonitorGrafanaIntegration() *schema.Resource {
 		Create: resourceTencentCloudMonitorGrafanaIntegrationCreate,
 		Update: resourceTencentCloudMonitorGrafanaIntegrationUpdate,
 		Delete: resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
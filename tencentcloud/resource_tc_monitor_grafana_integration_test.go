@@ -36,6 +36,12 @@ func TestAccTencentCloudMonitorGrafanaIntegration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_grafana_integration.grafanaIntegration", "kind", "tencentcloud-monitor-app"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_grafana_integration.grafanaIntegration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
 		},
 	})
 }
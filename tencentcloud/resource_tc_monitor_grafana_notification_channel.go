@@ -69,6 +69,14 @@ resource "tencentcloud_monitor_grafana_notification_channel" "grafanaNotificatio
   extra_org_ids = ["1"]
 }
 
+```
+
+Import
+
+Monitor grafana notification channel can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_grafana_notification_channel.grafanaNotificationChannel nchannel-xxxxxxxx#grafana-xxxxxxxx
 ```
 */
 package tencentcloud
@@ -91,6 +99,9 @@ func resourceTencentCloudMonitorGrafanaNotificationChannel() *schema.Resource {
 		Create: resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
 		Update: resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
 		Delete: resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -28,6 +28,12 @@ func TestAccTencentCloudMonitorGrafanaNotificationChannel_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_grafana_notification_channel.grafanaNotificationChannel", "receivers.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_grafana_notification_channel.grafanaNotificationChannel",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"org_id", "extra_org_ids"},
+			},
 		},
 	})
 }
@@ -61,7 +61,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorPolicyGroup() *schema.Resource {
@@ -379,7 +378,6 @@ func resourceTencentMonitorPolicyGroupCreate(d *schema.ResourceData, meta interf
 
 	var groupId *int64
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreatePolicyGroup(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -425,7 +423,6 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupInfo(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -635,7 +632,6 @@ func resourceTencentMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := monitorService.client.UseMonitorClient().ModifyPolicyGroup(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -664,7 +660,6 @@ func resourceTencentMonitorPolicyGroupDelete(d *schema.ResourceData, meta interf
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().DeletePolicyGroup(request); err != nil {
 			return retryError(err, InternalError)
 		}
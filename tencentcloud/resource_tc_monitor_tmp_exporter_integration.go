@@ -50,6 +50,14 @@ resource "tencentcloud_monitor_tmp_exporter_integration" "tmpExporterIntegration
   kube_type  = 3
 }
 ```
+
+Import
+
+Monitor tmp exporter integration can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_exporter_integration.tmpExporterIntegration exporter-name#prom-xxxxxxxx#1#cls-xxxxxxxx#blackbox-exporter
+```
 */
 package tencentcloud
 
@@ -74,6 +82,9 @@ func resourceTencentCloudMonitorTmpExporterIntegration() *schema.Resource {
 		Create: resourceTencentCloudMonitorTmpExporterIntegrationCreate,
 		Update: resourceTencentCloudMonitorTmpExporterIntegrationUpdate,
 		Delete: resourceTencentCloudMonitorTmpExporterIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -234,6 +245,18 @@ func resourceTencentCloudMonitorTmpExporterIntegrationRead(d *schema.ResourceDat
 		return fmt.Errorf("resource `tmpExporterIntegration` %s does not exist", tmpExporterIntegrationId)
 	}
 
+	ids := strings.Split(tmpExporterIntegrationId, FILED_SP)
+	if len(ids) != 5 {
+		return fmt.Errorf("id is broken,%s", tmpExporterIntegrationId)
+	}
+	kubeType, err := strconv.Atoi(ids[2])
+	if err != nil {
+		return err
+	}
+	_ = d.Set("instance_id", ids[1])
+	_ = d.Set("kube_type", kubeType)
+	_ = d.Set("cluster_id", ids[3])
+
 	if tmpExporterIntegration.Kind != nil {
 		_ = d.Set("kind", tmpExporterIntegration.Kind)
 	}
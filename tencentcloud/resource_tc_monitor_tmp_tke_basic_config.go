@@ -171,6 +171,14 @@ resource "tencentcloud_monitor_tmp_tke_basic_config" "tmp_tke_basic_config" {
   depends_on = [tencentcloud_monitor_tmp_tke_cluster_agent.foo]
 }
 
+```
+
+Import
+
+Monitor tmp tke basic config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config prom-xxxxxxxx#tke#cls-xxxxxxxx#kube-apiserver
 ```
 */
 package tencentcloud
@@ -198,6 +206,9 @@ func resourceTencentCloudMonitorTmpTkeBasicConfig() *schema.Resource {
 		Read:   resourceTencentCloudMonitorTmpTkeBasicConfigRead,
 		Update: resourceTencentCloudMonitorTmpTkeBasicConfigUpdate,
 		Delete: resourceTencentCloudMonitorTmpTkeBasicConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
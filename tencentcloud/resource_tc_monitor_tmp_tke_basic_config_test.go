@@ -27,6 +27,12 @@ func TestAccTencentCloudMonitorTmpTkeBasicConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config", "metrics_name.#", "2"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metrics_name"},
+			},
 		},
 	})
 }
@@ -161,6 +161,14 @@ resource "tencentcloud_monitor_tmp_tke_cluster_agent" "foo" {
   }
 }
 ```
+
+Import
+
+Monitor tmp tke cluster agent can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_tke_cluster_agent.foo prom-xxxxxxxx#cls-xxxxxxxx#tke
+```
 */
 package tencentcloud
 
@@ -182,6 +190,9 @@ func resourceTencentCloudMonitorTmpTkeClusterAgent() *schema.Resource {
 		Create: resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
 		Update: resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
 		Delete: resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -502,6 +513,7 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentRead(d *schema.ResourceData, m
 	//	agent["external_labels"] = result
 	//}
 	agents = append(agents, agent)
+	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("agents", agents)
 
 	return nil
@@ -61,6 +61,11 @@ func TestAccTencentCloudMonitorClusterAgent_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_cluster_agent.basic", "agents.0.cluster_type", "eks"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_cluster_agent.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -182,6 +182,14 @@ resource "tencentcloud_monitor_tmp_tke_config" "foo" {
   }
 }
 
+```
+
+Import
+
+Monitor tmp tke config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_tke_config.foo prom-xxxxxxxx#tke#cls-xxxxxxxx
 ```
 */
 package tencentcloud
@@ -204,6 +212,9 @@ func resourceTencentCloudMonitorTmpTkeConfig() *schema.Resource {
 		Read:   resourceTencentCloudTkeTmpConfigRead,
 		Update: resourceTencentCloudTkeTmpConfigUpdate,
 		Delete: resourceTencentCloudTkeTmpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -326,6 +337,14 @@ func resourceTencentCloudTkeTmpConfigRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("resource `prometheus_config` %s does not exist", configId)
 	}
 
+	ids := strings.Split(configId, FILED_SP)
+	if len(ids) != 3 {
+		return fmt.Errorf("id is broken, id is %s", configId)
+	}
+	_ = d.Set("instance_id", ids[0])
+	_ = d.Set("cluster_type", ids[1])
+	_ = d.Set("cluster_id", ids[2])
+
 	if e := d.Set("config", params.Config); e != nil {
 		log.Printf("[CRITAL]%s provider set config fail, reason:%s\n", logId, e.Error())
 		return e
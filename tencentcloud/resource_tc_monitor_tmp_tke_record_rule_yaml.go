@@ -183,6 +183,14 @@ resource "tencentcloud_monitor_tmp_tke_record_rule_yaml" "foo" {
   depends_on = [tencentcloud_monitor_tmp_tke_cluster_agent.foo]
 }
 ```
+
+Import
+
+Monitor tmp tke record rule yaml can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_tke_record_rule_yaml.foo prom-xxxxxxxx#record-rule-name
+```
 */
 package tencentcloud
 
@@ -204,9 +212,9 @@ func resourceTencentCloudMonitorTmpTkeRecordRuleYaml() *schema.Resource {
 		Create: resourceTencentCloudTkeTmpRecordRuleYamlCreate,
 		Update: resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
 		Delete: resourceTencentCloudTkeTmpRecordRuleYamlDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -256,6 +256,14 @@ resource "tencentcloud_monitor_tmp_tke_template_attachment" "temp_attachment" {
   depends_on = [tencentcloud_monitor_tmp_tke_cluster_agent.foo]
 }
 ```
+
+Import
+
+Monitor tmp tke template attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_monitor_tmp_tke_template_attachment.temp_attachment temp-xxxxxxxx#prom-xxxxxxxx#ap-guangzhou
+```
 */
 package tencentcloud
 
@@ -276,6 +284,9 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachment() *schema.Resource {
 		Read:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
 		Create: resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
 		Delete: resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"template_id": {
 				Type:        schema.TypeString,
@@ -459,6 +470,7 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(d *schema.ResourceD
 			})
 		}
 	}
+	_ = d.Set("template_id", templateId)
 	_ = d.Set("targets", tempTargets)
 
 	return nil
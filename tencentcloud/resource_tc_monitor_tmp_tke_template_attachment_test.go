@@ -26,6 +26,11 @@ func TestAccTencentCloudMonitorTempAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_template_attachment.basic", "targets.0.region", "ap-guangzhou"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_template_attachment.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
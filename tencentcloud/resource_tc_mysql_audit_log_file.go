@@ -78,6 +78,13 @@ resource "tencentcloud_mysql_audit_log_file" "example" {
 }
 ```
 
+Import
+
+MySQL audit log file can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_audit_log_file.example cdb-xxxxxxxx#audit_log_file_name
+```
 */
 package tencentcloud
 
@@ -99,6 +106,9 @@ func resourceTencentCloudMysqlAuditLogFile() *schema.Resource {
 		Create: resourceTencentCloudMysqlAuditLogFileCreate,
 		Read:   resourceTencentCloudMysqlAuditLogFileRead,
 		Delete: resourceTencentCloudMysqlAuditLogFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -400,6 +410,8 @@ func resourceTencentCloudMysqlAuditLogFileRead(d *schema.ResourceData, meta inte
 		return nil
 	}
 
+	_ = d.Set("instance_id", instanceId)
+
 	if auditLogFile.FileSize != nil {
 		_ = d.Set("file_size", auditLogFile.FileSize)
 	}
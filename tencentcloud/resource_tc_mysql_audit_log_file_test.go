@@ -18,6 +18,12 @@ func TestAccTencentCloudMysqlAuditLogFileResource_basic(t *testing.T) {
 				Config: testAccMysqlAuditLogFile,
 				Check:  resource.ComposeTestCheckFunc(resource.TestCheckResourceAttrSet("tencentcloud_mysql_audit_log_file.audit_log_file", "id")),
 			},
+			{
+				ResourceName:            "tencentcloud_mysql_audit_log_file.audit_log_file",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_time", "end_time", "order", "order_by", "filter"},
+			},
 		},
 	})
 }
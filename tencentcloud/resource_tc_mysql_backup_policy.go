@@ -61,6 +61,14 @@ resource "tencentcloud_mysql_backup_policy" "example" {
   backup_time      = "01:00-05:00"
 }
 ```
+
+Import
+
+MySQL backup policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_backup_policy.example cdb-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -79,6 +87,9 @@ func resourceTencentCloudMysqlBackupPolicy() *schema.Resource {
 		Read:   resourceTencentCloudMysqlBackupPolicyRead,
 		Update: resourceTencentCloudMysqlBackupPolicyUpdate,
 		Delete: resourceTencentCloudMysqlBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -200,7 +211,7 @@ func resourceTencentCloudMysqlBackupPolicyUpdate(d *schema.ResourceData, meta in
 	return resourceTencentCloudMysqlBackupPolicyRead(d, meta)
 }
 
-//set all config to default
+// set all config to default
 func resourceTencentCloudMysqlBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_mysql_backup_policy.delete")()
 
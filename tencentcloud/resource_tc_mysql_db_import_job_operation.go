@@ -117,6 +117,7 @@ func resourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The password of the user account of the cloud database instance.",
 			},
 
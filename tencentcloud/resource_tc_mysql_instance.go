@@ -139,6 +139,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -284,6 +285,11 @@ func TencentMsyqlBasicInfo() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Instance tags.",
 		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+		},
 		"force_delete": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -417,11 +423,17 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 		specialInfo[k] = v
 	}
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlInstanceCreate,
-		Read:   resourceTencentCloudMysqlInstanceRead,
-		Update: resourceTencentCloudMysqlInstanceUpdate,
-		Delete: resourceTencentCloudMysqlInstanceDelete,
-		Schema: specialInfo,
+		CreateWithoutTimeout: resourceTencentCloudMysqlInstanceCreate,
+		ReadWithoutTimeout:   resourceTencentCloudMysqlInstanceRead,
+		UpdateWithoutTimeout: resourceTencentCloudMysqlInstanceUpdate,
+		DeleteWithoutTimeout: resourceTencentCloudMysqlInstanceDelete,
+		CustomizeDiff:        customizeDiffAll(customizeDiffTagsAll, mysqlCustomizeDiffSellSpec),
+		Schema:               specialInfo,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(7 * readRetryTimeout),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(7 * readRetryTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"charge_type":       MYSQL_CHARGE_TYPE_POSTPAID,
@@ -439,8 +451,100 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 	}
 }
 
+// mysqlCustomizeDiffSellSpec checks `mem_size`, `cpu` and `volume_size` against the sell spec of the region
+// at plan time, so the unavailable spec is reported before creating or upgrading the instance.
+func mysqlCustomizeDiffSellSpec(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	specKeys := []string{"mem_size", "cpu", "volume_size", "device_type"}
+	if d.Id() != "" && !d.HasChanges(specKeys...) {
+		return nil
+	}
+	if !d.NewValueKnown("mem_size") || !d.NewValueKnown("volume_size") {
+		return nil
+	}
+
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	var (
+		memSize    = int64(d.Get("mem_size").(int))
+		cpu        int64
+		volumeSize = int64(d.Get("volume_size").(int))
+		deviceType string
+	)
+	// `cpu` and `device_type` are unknown when they are not configured, they match any sell spec then
+	if d.NewValueKnown("cpu") {
+		cpu = int64(d.Get("cpu").(int))
+	}
+	if d.NewValueKnown("device_type") {
+		deviceType = d.Get("device_type").(string)
+	}
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sellConfigures, err := mysqlService.DescribeDBZoneConfig(ctx)
+	if err != nil {
+		log.Printf("[WARN]%s skip checking sell spec of mysql, reason[%s]\n", logId, err.Error())
+		return nil
+	}
+	if sellConfigures == nil || len(sellConfigures.Configs) == 0 {
+		return nil
+	}
+
+	var (
+		memSizes = make([]int64, 0)
+		matched  = make([]*cdb.CdbSellConfig, 0)
+	)
+	for _, sellItem := range sellConfigures.Configs {
+		if sellItem.Status == nil || *sellItem.Status != ZONE_SELL_STATUS_ONLINE || sellItem.Memory == nil {
+			continue
+		}
+		if deviceType != "" && sellItem.DeviceType != nil && *sellItem.DeviceType != deviceType {
+			continue
+		}
+		if !IsContains(memSizes, *sellItem.Memory) {
+			memSizes = append(memSizes, *sellItem.Memory)
+		}
+		if *sellItem.Memory != memSize {
+			continue
+		}
+		if cpu > 0 && sellItem.Cpu != nil && *sellItem.Cpu != cpu {
+			continue
+		}
+		matched = append(matched, sellItem)
+	}
+
+	if len(matched) == 0 {
+		if cpu > 0 {
+			return fmt.Errorf("the spec of `mem_size` %d and `cpu` %d is not on sale, available `mem_size`: %v", memSize, cpu, memSizes)
+		}
+		return fmt.Errorf("`mem_size` %d is not on sale, available `mem_size`: %v", memSize, memSizes)
+	}
+
+	for _, sellItem := range matched {
+		if sellItem.VolumeMin == nil || sellItem.VolumeMax == nil {
+			return nil
+		}
+		if volumeSize < *sellItem.VolumeMin || volumeSize > *sellItem.VolumeMax {
+			continue
+		}
+		if sellItem.VolumeStep != nil && *sellItem.VolumeStep > 0 && (volumeSize-*sellItem.VolumeMin)%*sellItem.VolumeStep != 0 {
+			continue
+		}
+		return nil
+	}
+
+	var (
+		sellItem         = matched[0]
+		volumeStep int64 = 1
+	)
+	if sellItem.VolumeStep != nil && *sellItem.VolumeStep > 0 {
+		volumeStep = *sellItem.VolumeStep
+	}
+	return fmt.Errorf("`volume_size` %d is not on sale with `mem_size` %d, it must be in [%d, %d] in steps of %d",
+		volumeSize, memSize, *sellItem.VolumeMin, *sellItem.VolumeMax, volumeStep)
+}
+
 /*
-   [master] and [dr] and [ro] all need set
+[master] and [dr] and [ro] all need set
 */
 func mysqlAllInstanceRoleSet(ctx context.Context, requestInter interface{}, d *schema.ResourceData, meta interface{}) error {
 	requestByMonth, okByMonth := requestInter.(*cdb.CreateDBInstanceRequest)
@@ -560,7 +664,7 @@ func mysqlAllInstanceRoleSet(ctx context.Context, requestInter interface{}, d *s
 }
 
 /*
- [master] need set
+[master] need set
 */
 func mysqlMasterInstanceRoleSet(ctx context.Context, requestInter interface{}, d *schema.ResourceData, meta interface{}) error {
 	requestByMonth, okByMonth := requestInter.(*cdb.CreateDBInstanceRequest)
@@ -675,9 +779,9 @@ func mysqlCreateInstancePayByMonth(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var response *cdb.CreateDBInstanceResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
-		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstance(request)
+		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceWithContext(ctx, request)
 		if inErr != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), inErr.Error())
@@ -721,9 +825,9 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var response *cdb.CreateDBInstanceHourResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
-		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHour(request)
+		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHourWithContext(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
 		}
@@ -753,11 +857,11 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	defer logElapsed("resource.tencentcloud_mysql_instance.create")()
+func resourceTencentCloudMysqlInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsedContext(ctx, "resource.tencentcloud_mysql_instance.create")()
 
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -766,29 +870,29 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 	if payType == MysqlPayByMonth {
 		err := mysqlCreateInstancePayByMonth(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if payType == MysqlPayByUse {
 		err := mysqlCreateInstancePayByUse(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("mysql not support this pay type yet.")
+		return diag.Errorf("mysql not support this pay type yet.")
 	}
 
 	mysqlID := d.Id()
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cdb", "instanceId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -809,7 +913,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql  task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//internet service
@@ -817,9 +921,9 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 	if internetService == 1 {
 		asyncRequestId, err := mysqlService.OpenWanService(ctx, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -841,11 +945,11 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 
 		if err != nil {
 			log.Printf("[CRITAL]%s open internet service   fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlInstanceRead(d, meta)
+	return resourceTencentCloudMysqlInstanceRead(ctx, d, meta)
 }
 
 func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}, master bool) (mysqlInfo *cdb.InstanceInfo,
@@ -855,8 +959,6 @@ func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta
 		return
 	}
 
-	logId := getLogId(ctx)
-
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	mysqlInfo, errRet = mysqlService.DescribeDBInstanceById(ctx, d.Id())
 	if errRet != nil {
@@ -927,10 +1029,7 @@ func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta
 		return
 	}
 
-	if err := d.Set("tags", tags); err != nil {
-		log.Printf("[CRITAL]%s provider set tags fail, reason:%s\n ", logId, err.Error())
-		return
-	}
+	setResourceTags(d, meta, tags)
 
 	_ = d.Set("intranet_ip", mysqlInfo.Vip)
 	_ = d.Set("intranet_port", int(*mysqlInfo.Vport))
@@ -945,17 +1044,17 @@ func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta
 	return
 }
 
-func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	defer logElapsed("resource.tencentcloud_mysql_instance.read")()
-	defer inconsistentCheck(d, meta)()
+func resourceTencentCloudMysqlInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsedContext(ctx, "resource.tencentcloud_mysql_instance.read")()
+	defer inconsistentCheckContext(ctx, d, meta)()
 
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var mysqlInfo *cdb.InstanceInfo
 	var e error
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		mysqlInfo, e = tencentMsyqlBasicInfoRead(ctx, d, meta, true)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -984,7 +1083,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
+		return diag.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -998,7 +1097,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			cares = append(cares, k)
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			caresParameters, e := mysqlService.DescribeCaresParameters(ctx, d.Id(), cares)
 			if e != nil {
 				if mysqlService.NotFoundMysqlInstance(e) {
@@ -1016,13 +1115,13 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if err != nil {
-			return fmt.Errorf("Describe CaresParameters Fail, reason:%s", err.Error())
+			return diag.Errorf("Describe CaresParameters Fail, reason:%s", err.Error())
 		}
 		if !onlineHas {
 			return nil
 		}
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		backConfig, e := mysqlService.DescribeDBInstanceConfig(ctx, d.Id())
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -1043,13 +1142,13 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe DBInstanceConfig Fail, reason:%s", err.Error())
+		return diag.Errorf("Describe DBInstanceConfig Fail, reason:%s", err.Error())
 	}
 	return nil
 }
 
 /*
-   [master] and [dr] and [ro] all need update
+[master] and [dr] and [ro] all need update
 */
 func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
@@ -1121,7 +1220,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 
 			if err != nil {
@@ -1199,7 +1298,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 
 			if err != nil {
@@ -1227,9 +1326,9 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -1250,7 +1349,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 /*
- [master] need set
+[master] need set
 */
 func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	logId := getLogId(ctx)
@@ -1324,7 +1423,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 				log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 				return err
 			}
-			err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+			err = resource.RetryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 				if err != nil {
 					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1369,7 +1468,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 			return err
 		}
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1408,7 +1507,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1469,11 +1568,11 @@ func mysqlUpdateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	defer logElapsed("resource.tencentcloud_mysql_instance.update")()
+func resourceTencentCloudMysqlInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsedContext(ctx, "resource.tencentcloud_mysql_instance.update")()
 
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	payType := getPayType(d).(int)
 
@@ -1481,31 +1580,39 @@ func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interf
 	if payType == MysqlPayByMonth {
 		err := mysqlUpdateInstancePayByMonth(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if payType == MysqlPayByUse {
 		err := mysqlUpdateInstancePayByUse(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("mysql not support this pay type yet.")
+		return diag.Errorf("mysql not support this pay type yet.")
 	}
 	d.Partial(false)
-	time.Sleep(7 * time.Second)
 
-	return resourceTencentCloudMysqlInstanceRead(d, meta)
+	// the changes take a while to be read
+	timer := time.NewTimer(7 * time.Second)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return diag.FromErr(ctx.Err())
+	case <-timer.C:
+	}
+
+	return resourceTencentCloudMysqlInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	defer logElapsed("resource.tencentcloud_mysql_instance.delete")()
+func resourceTencentCloudMysqlInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsedContext(ctx, "resource.tencentcloud_mysql_instance.delete")()
 
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
 		if err != nil {
 			//for the pay order wait
@@ -1515,14 +1622,14 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var hasDeleted = false
 
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -1549,7 +1656,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if payType == MysqlPayByMonth && !forceDelete {
@@ -1558,10 +1665,10 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 
 	err = mysqlService.OfflineIsolatedInstances(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeIsolatedDBInstanceById(ctx, d.Id())
 		if err != nil {
 			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1580,7 +1687,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 			return resource.RetryableError(fmt.Errorf("after OfflineIsolatedInstances mysql Status is %d", *mysqlInfo.Status))
 		}
 	})
-	return err
+	return diag.FromErr(err)
 }
 
 func getPayType(d *schema.ResourceData) (payType interface{}) {
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -97,6 +100,100 @@ func testSweepMySQLInstance(region string) error {
 }
 
 // go test -i; go test -test.run TestAccTencentCloudMysqlInstanceResource_prepaid -v
+func TestUnitMysqlInstanceCustomizeDiffSellSpec(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	meta.apiV3Conn.Endpoints["cdb"] = server.URL
+	sellConfig := func(memory, cpu int64, status int64) *cdb.CdbSellConfig {
+		return &cdb.CdbSellConfig{
+			Memory:     helper.Int64(memory),
+			Cpu:        helper.Int64(cpu),
+			VolumeMin:  helper.Int64(25),
+			VolumeMax:  helper.Int64(1000),
+			VolumeStep: helper.Int64(5),
+			Status:     helper.Int64(status),
+			DeviceType: helper.String("UNIVERSAL"),
+		}
+	}
+	server.Handle("cdb", "DescribeCdbZoneConfig", func(request *fakeapi.Request) (interface{}, error) {
+		return &cdb.DescribeCdbZoneConfigResponseParams{
+			DataResult: &cdb.CdbZoneDataResult{
+				Configs: []*cdb.CdbSellConfig{sellConfig(1000, 1, ZONE_SELL_STATUS_ONLINE), sellConfig(2000, 1, ZONE_SELL_STATUS_ONLINE), sellConfig(4000, 2, 1)},
+			},
+		}, nil
+	})
+
+	config := func(attributes map[string]interface{}) map[string]interface{} {
+		attributes["instance_name"] = "mysql-test"
+		return attributes
+	}
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+	}{
+		{"on sale", config(map[string]interface{}{"mem_size": 1000, "volume_size": 50}), ""},
+		{"cpu on sale", config(map[string]interface{}{"mem_size": 2000, "cpu": 1, "volume_size": 50}), ""},
+		{"memory not on sale", config(map[string]interface{}{"mem_size": 4000, "volume_size": 50}), "`mem_size` 4000 is not on sale, available `mem_size`: [1000 2000]"},
+		{"cpu not on sale", config(map[string]interface{}{"mem_size": 1000, "cpu": 2, "volume_size": 50}), "the spec of `mem_size` 1000 and `cpu` 2 is not on sale"},
+		{"volume out of range", config(map[string]interface{}{"mem_size": 1000, "volume_size": 2000}), "it must be in [25, 1000] in steps of 5"},
+		{"volume off step", config(map[string]interface{}{"mem_size": 1000, "volume_size": 52}), "`volume_size` 52 is not on sale"},
+		{"unknown memory", config(map[string]interface{}{"mem_size": testUnknownValue, "volume_size": 2000}), ""},
+	}
+	for _, tt := range tests {
+		_, err := testResourceDiff(resourceTencentCloudMysqlInstance(), nil, tt.config, meta)
+		if tt.err == "" {
+			assert.Nil(t, err, tt.name)
+		} else if assert.NotNil(t, err, tt.name) {
+			assert.Contains(t, err.Error(), tt.err, tt.name)
+		}
+	}
+
+	// the check is skipped when the sell spec can't be described
+	server.InjectFault("cdb", "DescribeCdbZoneConfig", fakeapi.Fault{Code: "AuthFailure.UnauthorizedOperation"})
+	_, err := testResourceDiff(resourceTencentCloudMysqlInstance(), nil, config(map[string]interface{}{"mem_size": 4000, "volume_size": 50}), meta)
+	assert.Nil(t, err)
+}
+
+func TestUnitMysqlRetryGatewayError(t *testing.T) {
+	t.Parallel()
+
+	gatewayErr := fmt.Errorf("Gateway Time-out")
+	calls := 0
+	err := mysqlRetryGatewayError(context.TODO(), []time.Duration{0, 0}, func() error {
+		calls++
+		if calls < 3 {
+			return gatewayErr
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	// the API errors are not retried
+	calls = 0
+	err = mysqlRetryGatewayError(context.TODO(), []time.Duration{0, 0}, func() error {
+		calls++
+		return errors.NewTencentCloudSDKError("ResourceNotFound", "not found", "1")
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+
+	// the retrying stops once the context is done, without waiting for the delay
+	ctx, cancel := context.WithCancel(context.TODO())
+	calls = 0
+	start := time.Now()
+	err = mysqlRetryGatewayError(ctx, []time.Duration{time.Minute, time.Minute}, func() error {
+		calls++
+		cancel()
+		return gatewayErr
+	})
+	assert.Equal(t, gatewayErr, err)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
 func TestAccTencentCloudMysqlInstanceResource_prepaid(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheckCommon(t, ACCOUNT_TYPE_PREPAY) },
@@ -57,6 +57,14 @@ resource "tencentcloud_mysql_isolate_instance" "example" {
   operate     = "recover"
 }
 ```
+
+Import
+
+mysql isolate_instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_isolate_instance.example cdb-c1nl9rpv
+```
 */
 package tencentcloud
 
@@ -78,6 +86,9 @@ func resourceTencentCloudMysqlIsolateInstance() *schema.Resource {
 		Read:   resourceTencentCloudMysqlIsolateInstanceRead,
 		Update: resourceTencentCloudMysqlIsolateInstanceUpdate,
 		Delete: resourceTencentCloudMysqlIsolateInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -145,6 +156,16 @@ func resourceTencentCloudMysqlIsolateInstanceRead(d *schema.ResourceData, meta i
 
 	if isolateInstance.Status != nil {
 		_ = d.Set("status", isolateInstance.Status)
+
+		// the operate of the imported resource is told by the status
+		if _, ok := d.GetOk("operate"); !ok {
+			switch *isolateInstance.Status {
+			case MYSQL_STATUS_ISOLATED:
+				_ = d.Set("operate", "isolate")
+			case MYSQL_STATUS_RUNNING:
+				_ = d.Set("operate", "recover")
+			}
+		}
 	}
 
 	return nil
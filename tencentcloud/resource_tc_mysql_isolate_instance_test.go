@@ -29,6 +29,11 @@ func TestAccTencentCloudMysqlIsolateInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_mysql_isolate_instance.isolate_instance", "status", "1"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_isolate_instance.isolate_instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
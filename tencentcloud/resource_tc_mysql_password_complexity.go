@@ -73,6 +73,13 @@ resource "tencentcloud_mysql_password_complexity" "example" {
 }
 ```
 
+Import
+
+MySQL password complexity can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_password_complexity.example cdb-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -93,6 +100,9 @@ func resourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
 		Read:   resourceTencentCloudMysqlPasswordComplexityRead,
 		Update: resourceTencentCloudMysqlPasswordComplexityUpdate,
 		Delete: resourceTencentCloudMysqlPasswordComplexityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
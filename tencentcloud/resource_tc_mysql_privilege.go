@@ -93,6 +93,14 @@ resource "tencentcloud_mysql_privilege" "example" {
   }
 }
 ```
+
+Import
+
+MySQL privilege can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_privilege.example '{"MysqlId":"cdb-xxxxxxxx","AccountName":"test","AccountHost":"%"}'
+```
 */
 package tencentcloud
 
@@ -109,7 +117,6 @@ import (
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type resourceTencentCloudMysqlPrivilegeId struct {
@@ -144,6 +151,9 @@ func resourceTencentCloudMysqlPrivilege() *schema.Resource {
 		Read:   resourceTencentCloudMysqlPrivilegeRead,
 		Update: resourceTencentCloudMysqlPrivilegeUpdate,
 		Delete: resourceTencentCloudMysqlPrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -393,7 +403,6 @@ func (me *resourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyAccountPrivileges(request)
 	if err != nil {
 		return err
@@ -515,7 +524,6 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 
 	var response *cdb.DescribeAccountPrivilegesResponse
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().DescribeAccountPrivileges(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
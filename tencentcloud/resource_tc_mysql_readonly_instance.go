@@ -83,6 +83,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -124,6 +125,13 @@ func resourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 		Update: resourceTencentCloudMysqlReadonlyInstanceUpdate,
 		Delete: resourceTencentCloudMysqlReadonlyInstanceDelete,
 
+		CustomizeDiff: customizeDiffAll(customizeDiffTagsAll, mysqlCustomizeDiffSellSpec),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * readRetryTimeout),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(7 * readRetryTimeout),
+		},
+
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"prepaid_period": 1,
@@ -303,7 +311,7 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 
 	mysqlID := d.Id()
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -327,7 +335,7 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 		return err
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cdb", "instanceId", tcClient.Region, d.Id())
@@ -428,10 +436,7 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 		return err
 	}
 
-	if err := d.Set("tags", tags); err != nil {
-		log.Printf("[CRITAL]%s provider set tags fail, reason:%s\n ", logId, err.Error())
-		return nil
-	}
+	setResourceTags(d, meta, tags)
 
 	_ = d.Set("intranet_ip", mysqlInfo.Vip)
 	_ = d.Set("intranet_port", int(*mysqlInfo.Vport))
@@ -513,7 +518,7 @@ func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, met
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -22,6 +22,14 @@ resource "tencentcloud_mysql_ro_group" "example" {
   is_balance_ro_load = 1
 }
 ```
+
+Import
+
+MySQL read-only group can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_ro_group.example cdbro-xxxxxxxx#cdbrg-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -43,6 +51,9 @@ func resourceTencentCloudMysqlRoGroup() *schema.Resource {
 		Read:   resourceTencentCloudMysqlRoGroupRead,
 		Update: resourceTencentCloudMysqlRoGroupUpdate,
 		Delete: resourceTencentCloudMysqlRoGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -32,6 +32,12 @@ func TestAccTencentCloudMysqlRoGroupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_mysql_ro_group.ro_group", "ro_weight_values.0.weight"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_mysql_ro_group.ro_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_balance_ro_load"},
+			},
 		},
 	})
 }
@@ -27,6 +27,14 @@ resource "tencentcloud_mysql_ro_instance_ip" "example" {
   uniq_vpc_id    = tencentcloud_vpc.vpc.id
 }
 ```
+
+Import
+
+MySQL read-only instance ip can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_mysql_ro_instance_ip.example cdbro-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -45,6 +53,9 @@ func resourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 		Create: resourceTencentCloudMysqlRoInstanceIpCreate,
 		Read:   resourceTencentCloudMysqlRoInstanceIpRead,
 		Delete: resourceTencentCloudMysqlRoInstanceIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -90,6 +90,7 @@ func resourceTencentCloudMysqlVerifyRootAccount() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The password of the ROOT account of the instance.",
 			},
 		},
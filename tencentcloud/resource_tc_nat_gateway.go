@@ -65,6 +65,7 @@ func resourceTencentCloudNatGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -114,6 +115,11 @@ func resourceTencentCloudNatGateway() *schema.Resource {
 				Optional:    true,
 				Description: "The available tags within this NAT gateway.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			//computed
 			"created_time": {
 				Type:        schema.TypeString,
@@ -151,7 +157,7 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 		request.Zone = helper.String(v.(string))
 	}
 
-	if v := helper.GetTags(d, "tags"); len(v) > 0 {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
 		for tagKey, tagValue := range v {
 			tag := vpc.Tag{
 				Key:   helper.String(tagKey),
@@ -184,7 +190,7 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 
 	//cs::vpc:ap-guangzhou:uin/12345:nat/nat-nxxx
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("vpc", "nat", tcClient.Region, d.Id())
@@ -270,7 +276,7 @@ func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -519,9 +525,9 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
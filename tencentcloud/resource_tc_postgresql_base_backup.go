@@ -12,6 +12,13 @@ resource "tencentcloud_postgresql_base_backup" "base_backup" {
 }
 ```
 
+Import
+
+PostgreSQL base backup can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_postgresql_base_backup.base_backup postgres-xxxxxxxx#base-backup-id
+```
 */
 package tencentcloud
 
@@ -33,6 +40,10 @@ func resourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlBaseBackupRead,
 		Update: resourceTencentCloudPostgresqlBaseBackupUpdate,
 		Delete: resourceTencentCloudPostgresqlBaseBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -58,6 +69,11 @@ func resourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -99,7 +115,7 @@ func resourceTencentCloudPostgresqlBaseBackupCreate(d *schema.ResourceData, meta
 	d.SetId(strings.Join([]string{dBInstanceId, baseBackupId}, FILED_SP))
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::postgres:%s:uin/:dbInstanceId/%s", region, d.Id())
@@ -125,7 +141,7 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 	if len(idSplit) != 2 {
 		return fmt.Errorf("id is broken,%s", d.Id())
 	}
-	// dBInstanceId := idSplit[0]
+	dBInstanceId := idSplit[0]
 	baseBackupId := idSplit[1]
 
 	BaseBackup, err := service.DescribePostgresqlBaseBackupById(ctx, baseBackupId)
@@ -139,6 +155,8 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
+	_ = d.Set("db_instance_id", dBInstanceId)
+
 	if BaseBackup.Id != nil {
 		_ = d.Set("base_backup_id", BaseBackup.Id)
 	}
@@ -153,7 +171,7 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -204,11 +222,11 @@ func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("postgres", "dbInstanceId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
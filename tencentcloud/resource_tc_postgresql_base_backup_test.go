@@ -45,6 +45,11 @@ func TestAccTencentCloudPostgresqlBaseBackupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccPostgresqlBaseBackupObject, "new_expire_time", newExpireTime),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_postgresql_base_backup.base_backup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -9,6 +9,14 @@ resource "tencentcloud_postgresql_readonly_attachment" "attach" {
   read_only_group_id = tencentcloud_postgresql_readonly_group.group.id
 }
 ```
+
+Import
+
+PostgreSQL readonly attachment can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_postgresql_readonly_attachment.attach pgro-xxxxxxxx#pgrogrp-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -30,9 +38,9 @@ func resourceTencentCloudPostgresqlReadonlyAttachment() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlReadOnlyAttachmentRead,
 		//Update: resourceTencentCloudPostgresqlReadOnlyAttachmentUpdate,
 		Delete: resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -96,6 +104,13 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentRead(d *schema.ResourceData
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
+	ids := helper.IdParse(d.Id())
+	if len(ids) != 2 {
+		return fmt.Errorf("id is broken,%s", d.Id())
+	}
+	_ = d.Set("db_instance_id", ids[0])
+	_ = d.Set("read_only_group_id", ids[1])
+
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	_, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, d.Id())
 	if err != nil {
@@ -18,6 +18,14 @@ resource "tencentcloud_postgresql_readonly_group" "group" {
 #  security_groups_ids = []
 }
 ```
+
+Import
+
+PostgreSQL readonly group can be imported using the master instance id and the group id, e.g.
+
+```
+terraform import tencentcloud_postgresql_readonly_group.group postgres-xxxxxxxx#pgrogrp-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -25,6 +33,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -39,9 +48,18 @@ func resourceTencentCloudPostgresqlReadonlyGroup() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlReadOnlyGroupRead,
 		Update: resourceTencentCloudPostgresqlReadOnlyGroupUpdate,
 		Delete: resourceTencentCLoudPostgresqlReadOnlyGroupDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the group can only be described by its master instance, so it is imported by `master_db_instance_id#read_only_group_id`
+				idSplit := strings.Split(d.Id(), FILED_SP)
+				if len(idSplit) != 2 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("master_db_instance_id", idSplit[0])
+				d.SetId(idSplit[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"master_db_instance_id": {
@@ -226,11 +244,36 @@ func resourceTencentCloudPostgresqlReadOnlyGroupRead(d *schema.ResourceData, met
 	}
 
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	_, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, id)
+	groups, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	var group *postgresql.ReadOnlyGroup
+	for _, g := range groups {
+		if *g.ReadOnlyGroupId == d.Id() {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		log.Printf("[WARN]%s resource `PostgresqlReadOnlyGroup` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", group.ReadOnlyGroupName)
+	_ = d.Set("project_id", group.ProjectId)
+	_ = d.Set("vpc_id", group.VpcId)
+	_ = d.Set("subnet_id", group.SubnetId)
+	_ = d.Set("replay_lag_eliminate", group.ReplayLagEliminate)
+	_ = d.Set("replay_latency_eliminate", group.ReplayLatencyEliminate)
+	if group.MaxReplayLag != nil {
+		_ = d.Set("max_replay_lag", int(*group.MaxReplayLag))
+	}
+	_ = d.Set("max_replay_latency", group.MaxReplayLatency)
+	_ = d.Set("min_delay_eliminate_reserve", group.MinDelayEliminateReserve)
+
 	return nil
 }
 
@@ -31,6 +31,14 @@ resource "tencentcloud_postgresql_security_group_config" "security_group_config"
   read_only_group_id = tencentcloud_postgresql_readonly_group.group.id
 }
 ```
+
+Import
+
+PostgreSQL security group config can be imported using `db_instance_id#read_only_group_id`, leaving the unused one empty, e.g.
+
+```
+terraform import tencentcloud_postgresql_security_group_config.security_group_config postgres-xxxxxxxx#
+```
 */
 package tencentcloud
 
@@ -52,6 +60,9 @@ func resourceTencentCloudPostgresqlSecurityGroupConfig() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlSecurityGroupConfigRead,
 		Update: resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
 		Delete: resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id_set": {
 				Required: true,
@@ -132,6 +143,12 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigRead(d *schema.ResourceDat
 			sgIDList = append(sgIDList, sg.SecurityGroupId)
 		}
 	}
+	if dBInstanceId != "" {
+		_ = d.Set("db_instance_id", dBInstanceId)
+	}
+	if readOnlyGroupId != "" {
+		_ = d.Set("read_only_group_id", readOnlyGroupId)
+	}
 	_ = d.Set("security_group_id_set", sgIDList)
 
 	return nil
@@ -29,6 +29,11 @@ func TestAccTencentCloudPostgresqlSecurityGroupConfigResource_basic(t *testing.T
 					resource.TestCheckResourceAttrSet(TestAccPostgresqlSecurityGroupConfigObject, "db_instance_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_postgresql_security_group_config.security_group_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -88,6 +88,7 @@ func resourceTencentCloudPrivateDnsZone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -123,6 +124,11 @@ func resourceTencentCloudPrivateDnsZone() *schema.Resource {
 				Description:   "Tags of the private dns zone.",
 				ConflictsWith: []string{"tag_set"},
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"vpc_set": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -278,7 +284,7 @@ func resourceTencentCloudDPrivateDnsZoneCreate(d *schema.ResourceData, meta inte
 	tagService := TagService{client: client}
 	region := client.Region
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("privatedns", "zone", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -339,7 +345,7 @@ func resourceTencentCloudDPrivateDnsZoneRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	vpcSet := make([]map[string]interface{}, 0, len(info.VpcSet))
 	for _, item := range info.VpcSet {
@@ -459,8 +465,8 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 	tagService := TagService{client: client}
 	region := client.Region
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("privatedns", "zone", region, id)
@@ -265,6 +265,12 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * readRetryTimeout),
+			Update: schema.DefaultTimeout(20 * readRetryTimeout),
+			Delete: schema.DefaultTimeout(20 * readRetryTimeout),
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -412,6 +418,11 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Instance tags.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// Computed values
 			"status": {
@@ -669,7 +680,7 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("redis api CreateInstances return empty redis id")
 	}
 	var redisId = *instanceIds[0]
-	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, 20*readRetryTimeout)
+	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create redis task fail, reason:%s\n", logId, err.Error())
@@ -677,7 +688,7 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(redisId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("redis", "instance", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -826,7 +837,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	_ = d.Set("charge_type", REDIS_CHARGE_TYPE_NAME[*info.BillingMode])
 	return nil
@@ -1042,7 +1053,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis upgradeVersionOperation fail, reason:%s\n", logId, err.Error())
 			return err
@@ -1112,7 +1123,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis networkConfig fail, reason:%s\n", logId, err.Error())
 			return err
@@ -1121,8 +1132,8 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		_ = d.Set("operation_network", operation)
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("redis", "instance", region, id)
@@ -1147,7 +1158,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 
 	// Collect infos before deleting action
 	var chargeType string
-	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), 20*readRetryTimeout)
+	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis querying before deleting task fail, reason:%s\n", logId, err.Error())
@@ -1209,7 +1220,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 		}
 
 		// Deal info only support create and renew and resize, need to check destroy status by describing api.
-		if errDestroyChecking := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		if errDestroyChecking := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			has, isolated, err := service.CheckRedisDestroyOk(ctx, d.Id())
 			if err != nil {
 				log.Printf("[CRITAL]%s CheckRedisDestroyOk fail, reason:%s\n", logId, err.Error())
@@ -60,6 +60,14 @@ resource "tencentcloud_redis_replica_readonly" "replica_readonly" {
   operate = "enable"
 }
 ```
+
+Import
+
+Redis replica readonly can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_redis_replica_readonly.replica_readonly crs-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -80,6 +88,9 @@ func resourceTencentCloudRedisReplicaReadonly() *schema.Resource {
 		Read:   resourceTencentCloudRedisReplicaReadonlyRead,
 		Update: resourceTencentCloudRedisReplicaReadonlyUpdate,
 		Delete: resourceTencentCloudRedisReplicaReadonlyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
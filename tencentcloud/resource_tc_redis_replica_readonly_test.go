@@ -31,6 +31,12 @@ func TestAccTencentCloudRedisReplicaReadonlyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_redis_replica_readonly.replica_readonly", "operate", "disable"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_redis_replica_readonly.replica_readonly",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"readonly_policy"},
+			},
 		},
 	})
 }
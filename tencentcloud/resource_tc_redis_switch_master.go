@@ -70,6 +70,13 @@ resource "tencentcloud_redis_switch_master" "switch_master" {
 }
 ```
 
+Import
+
+redis switch_master can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_redis_switch_master.switch_master crs-2yypjrnv
+```
 */
 package tencentcloud
 
@@ -91,6 +98,9 @@ func resourceTencentCloudRedisSwitchMaster() *schema.Resource {
 		Read:   resourceTencentCloudRedisSwitchMasterRead,
 		Update: resourceTencentCloudRedisSwitchMasterUpdate,
 		Delete: resourceTencentCloudRedisSwitchMasterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
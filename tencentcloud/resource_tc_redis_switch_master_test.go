@@ -31,6 +31,11 @@ func TestAccTencentCloudRedisSwitchMasterResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_redis_switch_master.switch_master", "group_id", "8924"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_redis_switch_master.switch_master",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
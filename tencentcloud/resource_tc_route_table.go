@@ -45,6 +45,7 @@ func resourceTencentCloudVpcRouteTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:        schema.TypeString,
@@ -63,6 +64,11 @@ func resourceTencentCloudVpcRouteTable() *schema.Resource {
 				Optional:    true,
 				Description: "The tags of routing table.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// Computed values
 			"subnet_ids": {
@@ -118,7 +124,7 @@ func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interf
 		name = temp.(string)
 	}
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 
@@ -128,7 +134,7 @@ func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(routeTableId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -200,7 +206,7 @@ func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interfac
 	_ = d.Set("route_entry_ids", routeEntryIds)
 	_ = d.Set("is_default", info.isDefault)
 	_ = d.Set("create_time", info.createTime)
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -226,8 +232,8 @@ func resourceTencentCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interf
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
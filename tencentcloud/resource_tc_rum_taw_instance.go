@@ -45,6 +45,7 @@ func resourceTencentCloudRumTawInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"area_id": {
 				Type:        schema.TypeInt,
@@ -75,6 +76,11 @@ func resourceTencentCloudRumTawInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list. Up to 10 tag key-value pairs are supported and must be unique.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			"instance_desc": {
 				Type:        schema.TypeString,
@@ -162,7 +168,7 @@ func resourceTencentCloudRumTawInstanceCreate(d *schema.ResourceData, meta inter
 		request.InstanceName = helper.String(v.(string))
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		for k, v := range tags {
 			key := k
 			value := v
@@ -213,7 +219,7 @@ func resourceTencentCloudRumTawInstanceCreate(d *schema.ResourceData, meta inter
 	d.SetId(instanceId)
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::rum:%s:uin/:Instance/%s", region, instanceId)
@@ -264,16 +270,13 @@ func resourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interfa
 	}
 
 	if tawInstance.Tags != nil {
-		tagsMap := map[string]interface{}{}
+		tagsMap := make(map[string]string)
 		for _, tags := range tawInstance.Tags {
 			if tags.Key != nil {
-				tagsMap["key"] = tags.Key
-			}
-			if tags.Value != nil {
-				tagsMap["value"] = tags.Value
+				tagsMap[*tags.Key] = helper.PString(tags.Value)
 			}
 		}
-		_ = d.Set("tags", tagsMap)
+		setResourceTags(d, meta, tagsMap)
 	}
 
 	if tawInstance.InstanceDesc != nil {
@@ -318,7 +321,7 @@ func resourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -372,10 +375,10 @@ func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("rum", "Instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
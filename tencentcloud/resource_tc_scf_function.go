@@ -105,6 +105,7 @@ func resourceTencentCloudScfFunction() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -235,6 +236,11 @@ func resourceTencentCloudScfFunction() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the SCF function.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"enable_public_net": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -728,7 +734,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 	}
 
 	// Pass tag as creation param instead of modify and time.Sleep
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(tags) > 0 {
 		functionInfo.tags = tags
 	}
 
@@ -849,7 +855,7 @@ func resourceTencentCloudScfFunctionRead(d *schema.ResourceData, m interface{})
 	for _, tag := range resp.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, m, tags)
 
 	_ = d.Set("modify_time", resp.ModTime)
 	_ = d.Set("code_size", resp.CodeSize)
@@ -1257,7 +1263,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		resp, err := scfService.DescribeFunction(ctx, functionInfo.name, *functionInfo.namespace)
 		if err != nil {
 			log.Printf("[CRITAL]%s get function id failed: %+v", logId, err)
@@ -1265,7 +1271,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		}
 		functionId := *resp.Response.FunctionId
 
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName(SCF_SERVICE, SCF_FUNCTION_RESOURCE, region, functionId)
 
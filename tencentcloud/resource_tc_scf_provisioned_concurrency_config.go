@@ -21,6 +21,14 @@ resource "tencentcloud_scf_provisioned_concurrency_config" "provisioned_concurre
   max_capacity                        = 2
 }
 ```
+
+Import
+
+SCF provisioned concurrency config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_scf_provisioned_concurrency_config.provisioned_concurrency_config func-name#1#default
+```
 */
 package tencentcloud
 
@@ -42,6 +50,9 @@ func resourceTencentCloudScfProvisionedConcurrencyConfig() *schema.Resource {
 		Create: resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
 		Read:   resourceTencentCloudScfProvisionedConcurrencyConfigRead,
 		Delete: resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
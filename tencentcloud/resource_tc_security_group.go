@@ -57,6 +57,7 @@ func resourceTencentCloudSecurityGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -84,6 +85,11 @@ func resourceTencentCloudSecurityGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the security group.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -110,7 +116,7 @@ func resourceTencentCloudSecurityGroupCreate(d *schema.ResourceData, m interface
 		projectId = common.IntPtr(projectIdInterface.(int))
 	}
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 
@@ -121,7 +127,7 @@ func resourceTencentCloudSecurityGroupCreate(d *schema.ResourceData, m interface
 
 	d.SetId(id)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("cvm", "sg", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -167,7 +173,7 @@ func resourceTencentCloudSecurityGroupRead(d *schema.ResourceData, m interface{}
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, m, tags)
 
 	return nil
 }
@@ -210,8 +216,8 @@ func resourceTencentCloudSecurityGroupUpdate(d *schema.ResourceData, m interface
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("cvm", "sg", region, id)
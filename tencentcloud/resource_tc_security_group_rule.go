@@ -55,6 +55,7 @@ package tencentcloud
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -65,10 +66,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSecurityGroupRule() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithStateUpgraders(&schema.Resource{
 		DeprecationMessage: "This resource will be offline and no longer supported, beacause single security rule is hardly ordered. Please use 'tencentcloud_security_group_lite_rule' instead.",
 		Create:             resourceTencentCloudSecurityGroupRuleCreate,
 		Read:               resourceTencentCloudSecurityGroupRuleRead,
@@ -222,7 +224,21 @@ func resourceTencentCloudSecurityGroupRule() *schema.Resource {
 				Description: "Description of the security group rule.",
 			},
 		},
+	}, helper.UpgradeStateId(resourceTencentCloudSecurityGroupRuleUpgradeStateV0))
+}
+
+// resourceTencentCloudSecurityGroupRuleUpgradeStateV0 upgrades the old style id in the form of query string,
+// like `sgId=sg-xxx&direction=INGRESS&...`, to the base64 encoded json of the rule.
+func resourceTencentCloudSecurityGroupRuleUpgradeStateV0(id string, rawState map[string]interface{}) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(id); err == nil {
+		return id, nil
+	}
+
+	info, err := parseSecurityGroupRuleId(id)
+	if err != nil {
+		return "", err
 	}
+	return buildSecurityGroupRuleId(info)
 }
 
 func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m interface{}) error {
@@ -8,8 +8,33 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestUnitSecurityGroupRuleUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := resourceTencentCloudSecurityGroupRule()
+	assert.Equal(t, 1, r.SchemaVersion)
+	assert.Len(t, r.StateUpgraders, 1)
+
+	oldId := "sgId=sg-abc&direction=ingress&action=ACCEPT&cidrIp=10.0.0.0/16&ipProtocol=TCP&portRange=80&description="
+	state, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{"id": oldId}, nil)
+	assert.Nil(t, err)
+
+	info, err := parseSecurityGroupRuleId(state["id"].(string))
+	assert.Nil(t, err)
+	assert.Equal(t, "sg-abc", info.SgId)
+	assert.Equal(t, "ingress", info.PolicyType)
+	assert.Equal(t, "10.0.0.0/16", *info.CidrIp)
+	assert.Equal(t, "80", *info.PortRange)
+
+	// the new style id is kept as it is
+	newState, err := r.StateUpgraders[0].Upgrade(context.TODO(), map[string]interface{}{"id": state["id"]}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, state["id"], newState["id"])
+}
+
 func TestAccTencentCloudSecurityGroupRule_basic(t *testing.T) {
 	t.Parallel()
 	var sgrId string
@@ -16,6 +16,13 @@ resource "tencentcloud_sms_sign" "example" {
 }
 ```
 
+Import
+
+SMS sign can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_sms_sign.example 12345#0
+```
 */
 package tencentcloud
 
@@ -38,6 +45,9 @@ func resourceTencentCloudSmsSign() *schema.Resource {
 		Create: resourceTencentCloudSmsSignCreate,
 		Update: resourceTencentCloudSmsSignUpdate,
 		Delete: resourceTencentCloudSmsSignDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"sign_name": {
 				Type:        schema.TypeString,
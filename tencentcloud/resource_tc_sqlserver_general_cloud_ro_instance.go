@@ -125,6 +125,14 @@ resource "tencentcloud_sqlserver_general_cloud_ro_instance" "example" {
   }
 }
 ```
+
+Import
+
+SQL Server general cloud read-only instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_sqlserver_general_cloud_ro_instance.example mssql-xxxxxxxx#mssqlro-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -143,10 +151,14 @@ import (
 
 func resourceTencentCloudSqlserverGeneralCloudRoInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
-		Update: resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
+		Create:        resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
+		Read:          resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
+		Update:        resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
+		Delete:        resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
+		CustomizeDiff: customizeDiffTagsAllOf("resource_tags"),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(CreateDefaultTimeout * time.Second),
 			Read:   schema.DefaultTimeout(ReadDefaultTimeout * time.Second),
@@ -253,6 +265,11 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"collation": {
 				Optional:    true,
 				Type:        schema.TypeString,
@@ -403,7 +420,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.Resourc
 		return err
 	}
 
-	if tags := helper.GetTags(d, "resource_tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "resource_tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::sqlserver:%s:uin/:instance/%s", region, roInstanceId)
@@ -502,7 +519,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d *schema.ResourceD
 		return err
 	}
 
-	_ = d.Set("resource_tags", tags)
+	setResourceTagsOf(d, meta, "resource_tags", tags)
 
 	securityGroupList, err := service.DescribeInstanceSecurityGroups(ctx, roInstanceId)
 	if err != nil {
@@ -580,7 +597,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.Resourc
 	}
 	roInstanceId := idSplit[1]
 
-	immutableArgs := []string{"instance_id", "zone", "read_only_group_type", "machine_type", "read_only_group_forced_upgrade", "read_only_group_id", "read_only_group_name", "read_only_group_is_offline_delay", "read_only_group_max_delay_time", "read_only_group_min_in_group", "instance_charge_type", "subnet_id", "vpc_id", "period", "security_group_list", "auto_voucher", "voucher_ids", "resource_tags", "collation", "time_zone"}
+	immutableArgs := []string{"instance_id", "zone", "read_only_group_type", "machine_type", "read_only_group_forced_upgrade", "read_only_group_id", "read_only_group_name", "read_only_group_is_offline_delay", "read_only_group_max_delay_time", "read_only_group_min_in_group", "instance_charge_type", "subnet_id", "vpc_id", "period", "security_group_list", "auto_voucher", "voucher_ids", "collation", "time_zone"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
@@ -627,6 +644,16 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.Resourc
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagService := TagService{client: client}
+		oldTags, newTags := d.GetChange("tags_all")
+		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+		resourceName := BuildTagResourceName("sqlserver", "instance", client.Region, roInstanceId)
+		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d, meta)
 }
 
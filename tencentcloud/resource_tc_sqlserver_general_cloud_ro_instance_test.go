@@ -36,6 +36,12 @@ func TestAccTencentCloudNeedFixSqlserverGeneralCloudRoInstanceResource_basic(t *
 					resource.TestCheckResourceAttrSet("tencentcloud_sqlserver_general_cloud_ro_instance.example", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_sqlserver_general_cloud_ro_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"read_only_group_type", "period"},
+			},
 		},
 	})
 }
@@ -156,6 +156,11 @@ func TencentSqlServerBasicInfo(isROInstance bool) map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags of the SQL Server.",
 		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+		},
 		"wait_switch": {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -239,6 +244,7 @@ func resourceTencentCloudSqlserverInstance() *schema.Resource {
 				"auto_voucher": 0,
 			}),
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: specialInfo,
 	}
 }
@@ -370,7 +376,7 @@ func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta in
 		return outErr
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -452,8 +458,8 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
@@ -592,8 +598,8 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 		}
 
 	}
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
@@ -721,7 +727,7 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
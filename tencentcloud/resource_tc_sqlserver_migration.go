@@ -216,6 +216,7 @@ func resourceTencentCloudSqlserverMigration() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Password, MigrateType=1 or MigrateType=2.",
 						},
 						"ip": {
@@ -239,6 +240,7 @@ func resourceTencentCloudSqlserverMigration() *schema.Resource {
 						"url_password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "The source backup password for offline migration, MigrateType=4 or MigrateType=5.",
 						},
 					},
@@ -265,6 +267,7 @@ func resourceTencentCloudSqlserverMigration() *schema.Resource {
 						"password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Password of the migration target instance.",
 						},
 					},
@@ -150,6 +150,10 @@ func resourceTencentCloudSqlserverReadonlyInstance() *schema.Resource {
 		Read:   resourceTencentCloudSqlserverReadonlyInstanceRead,
 		Update: resourceTencentCloudSqlserverReadonlyInstanceUpdate,
 		Delete: resourceTencentCloudSqlserverReadonlyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: readonlyInstanceInfo,
 	}
@@ -280,7 +284,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData,
 		return outErr
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -338,7 +342,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, m
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
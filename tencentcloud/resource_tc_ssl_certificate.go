@@ -80,6 +80,7 @@ func resourceTencentCloudSslCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -144,6 +145,11 @@ func resourceTencentCloudSslCertificate() *schema.Resource {
 				Computed:    true,
 				Description: "Tags of the SSL certificate.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			// computed
 			"product_zh_name": {
 				Type:        schema.TypeString,
@@ -248,7 +254,7 @@ func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interfac
 		return outErr
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(m, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagClient := m.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tagClient}
 		resourceName := BuildTagResourceName("ssl", "certificate", tagClient.Region, id)
@@ -342,7 +348,7 @@ func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, m, tags)
 	return nil
 }
 
@@ -404,8 +410,8 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldInterface, newInterface := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagClient := m.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: tagClient}
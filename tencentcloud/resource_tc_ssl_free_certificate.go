@@ -159,6 +159,7 @@ func resourceTencentCloudSSLFreeCertificate() *schema.Resource {
 			"certificate_private_key": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "Certificate private key.",
 			},
 			"certificate_public_key": {
@@ -287,6 +287,7 @@ func resourceTencentCloudSSLInstance() *schema.Resource {
 						"key_password": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "Private key password.",
 						},
 					},
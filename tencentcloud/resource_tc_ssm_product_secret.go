@@ -85,6 +85,14 @@ resource "tencentcloud_ssm_product_secret" "example" {
   }
 }
 ```
+
+Import
+
+SSM product secret can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_ssm_product_secret.example secret-name
+```
 */
 package tencentcloud
 
@@ -106,6 +114,10 @@ func resourceTencentCloudSsmProductSecret() *schema.Resource {
 		Read:   resourceTencentCloudSsmProductSecretRead,
 		Update: resourceTencentCloudSsmProductSecretUpdate,
 		Delete: resourceTencentCloudSsmProductSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
 				Required:    true,
@@ -143,6 +155,11 @@ func resourceTencentCloudSsmProductSecret() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of secret.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"domains": {
 				Required:    true,
 				Type:        schema.TypeSet,
@@ -345,7 +362,7 @@ func resourceTencentCloudSsmProductSecretCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 			secretInfo, err = service.DescribeSecretByName(ctx, secretName)
 			if err != nil {
@@ -457,7 +474,7 @@ func resourceTencentCloudSsmProductSecretRead(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -557,11 +574,11 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
@@ -102,6 +102,7 @@ func resourceTencentCloudSsmSecret() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
@@ -138,6 +139,11 @@ func resourceTencentCloudSsmSecret() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of secret.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"is_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -227,7 +233,7 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 			secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 			if inErr != nil {
@@ -303,7 +309,7 @@ func resourceTencentCloudSsmSecretRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -357,11 +363,11 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
@@ -51,73 +51,140 @@ import (
 	"log"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 )
 
-func resourceTencentCloudSsmSecretVersion() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTencentCloudSsmSecretVersionCreate,
-		Read:   resourceTencentCloudSsmSecretVersionRead,
-		Update: resourceTencentCloudSsmSecretVersionUpdate,
-		Delete: resourceTencentCloudSsmSecretVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+var (
+	_ resource.ResourceWithConfigure      = &ssmSecretVersionResource{}
+	_ resource.ResourceWithImportState    = &ssmSecretVersionResource{}
+	_ resource.ResourceWithValidateConfig = &ssmSecretVersionResource{}
+)
+
+// ssmSecretVersionResource is implemented with the Plugin Framework, and served along with the sdk
+// resources by the muxed provider server
+type ssmSecretVersionResource struct {
+	client *TencentCloudClient
+}
+
+type ssmSecretVersionResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	SecretName   types.String `tfsdk:"secret_name"`
+	VersionId    types.String `tfsdk:"version_id"`
+	SecretBinary types.String `tfsdk:"secret_binary"`
+	SecretString types.String `tfsdk:"secret_string"`
+}
+
+func newSsmSecretVersionResource() resource.Resource {
+	return &ssmSecretVersionResource{}
+}
+
+func (me *ssmSecretVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssm_secret_version"
+}
 
-		Schema: map[string]*schema.Schema{
-			"secret_name": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
+func (me *ssmSecretVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_name": schema.StringAttribute{
 				Required:    true,
 				Description: "Name of secret which cannot be repeated in the same region. The maximum length is 128 bytes. The name can only contain English letters, numbers, underscore and hyphen '-'. The first character must be a letter or number.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"version_id": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
+			"version_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Version of secret. The maximum length is 64 bytes. The version_id can only contain English letters, numbers, underscore and hyphen '-'. The first character must be a letter or number.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"secret_binary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"secret_string"},
-				Description:  "The base64-encoded binary secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
+			"secret_binary": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded binary secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
 			},
-			"secret_string": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"secret_binary"},
-				Description:  "The string text of secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
+			"secret_string": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The string text of secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
 			},
 		},
 	}
 }
 
-func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func (me *ssmSecretVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TencentCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *TencentCloudClient, got %T", req.ProviderData))
+		return
+	}
+	me.client = client
+}
+
+func (me *ssmSecretVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ssmSecretVersionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SecretBinary.IsUnknown() || config.SecretString.IsUnknown() {
+		return
+	}
+	if config.SecretBinary.IsNull() == config.SecretString.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_binary"), "Invalid combination of arguments",
+			"only one of `secret_binary,secret_string` can be specified, and one must be specified")
+	}
+}
+
+func (me *ssmSecretVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.create")()
 
+	var plan ssmSecretVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
-		ssmService            = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
+		ssmService            = SsmService{client: me.client.apiV3Conn}
 		outErr, inErr         error
 		secretName, versionId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	param := make(map[string]interface{})
-	param["secret_name"] = d.Get("secret_name").(string)
-	param["version_id"] = d.Get("version_id").(string)
-	if v, ok := d.GetOk("secret_binary"); ok {
-		param["secret_binary"] = v.(string)
+	param["secret_name"] = plan.SecretName.ValueString()
+	param["version_id"] = plan.VersionId.ValueString()
+	if v := plan.SecretBinary.ValueString(); v != "" {
+		param["secret_binary"] = v
 	}
 
-	if v, ok := d.GetOk("secret_string"); ok {
-		param["secret_string"] = v.(string)
+	if v := plan.SecretString.ValueString(); v != "" {
+		param["secret_string"] = v
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = sdkresource.Retry(writeRetryTimeout, func() *sdkresource.RetryError {
 		secretName, versionId, inErr = ssmService.PutSecretValue(ctx, param)
 		if inErr != nil {
 			return retryError(inErr)
@@ -127,32 +194,64 @@ func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		resp.Diagnostics.AddError("Create SSM secret version failed", outErr.Error())
+		return
 	}
 
-	d.SetId(strings.Join([]string{secretName, versionId}, FILED_SP))
-	return resourceTencentCloudSsmSecretVersionRead(d, meta)
+	plan.Id = types.StringValue(strings.Join([]string{secretName, versionId}, FILED_SP))
+	// save the id at once, so the secret version is not lost if it fails to be read
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := me.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read SSM secret version failed", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+func (me *ssmSecretVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.read")()
 
+	var state ssmSecretVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	has, err := me.read(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Read SSM secret version failed", err.Error())
+		return
+	}
+	if !has {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read refreshes model by the secret version of its id, the content is kept as is when the secret is not
+// enabled, because it can't be read then.
+func (me *ssmSecretVersionResource) read(ctx context.Context, model *ssmSecretVersionResourceModel) (has bool, errRet error) {
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
-		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
+		ssmService    = SsmService{client: me.client.apiV3Conn}
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := strings.Split(model.Id.ValueString(), FILED_SP)
 	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
+		return false, fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", model.Id.ValueString())
 	}
 	secretName := ids[0]
 	versionId := ids[1]
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = sdkresource.Retry(readRetryTimeout, func() *sdkresource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -162,11 +261,11 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	})
 
 	if outErr != nil {
-		return outErr
+		return false, outErr
 	}
 
 	var versionIds []string
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = sdkresource.Retry(readRetryTimeout, func() *sdkresource.RetryError {
 		versionIds, inErr = ssmService.DescribeSecretVersionIdsByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -176,7 +275,7 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	})
 
 	if outErr != nil {
-		return outErr
+		return false, outErr
 	}
 
 	var hasVersionId bool
@@ -188,13 +287,19 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	}
 
 	if !hasVersionId {
-		d.SetId("")
-		return nil
+		log.Printf("[WARN]%s SSM secret version %s is not found", logId, model.Id.ValueString())
+		return false, nil
 	}
 
+	model.SecretName = types.StringValue(secretName)
+	model.VersionId = types.StringValue(versionId)
+	// the sdk implementation saved the unset content as empty string
+	model.SecretBinary = ssmSecretVersionContent(model.SecretBinary.ValueString())
+	model.SecretString = ssmSecretVersionContent(model.SecretString.ValueString())
+
 	if secretInfo.status == SSM_STATUS_ENABLED {
 		var secretVersionInfo *SecretVersionInfo
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = sdkresource.Retry(readRetryTimeout, func() *sdkresource.RetryError {
 			secretVersionInfo, inErr = ssmService.DescribeSecretVersion(ctx, secretName, versionId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -204,37 +309,40 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 		})
 
 		if outErr != nil {
-			return outErr
+			return false, outErr
 		}
 
-		_ = d.Set("secret_name", secretVersionInfo.secretName)
-		_ = d.Set("version_id", secretVersionInfo.versionId)
-		_ = d.Set("secret_binary", secretVersionInfo.secretBinary)
-		_ = d.Set("secret_string", secretVersionInfo.secretString)
+		model.SecretName = types.StringValue(secretVersionInfo.secretName)
+		model.VersionId = types.StringValue(secretVersionInfo.versionId)
+		model.SecretBinary = ssmSecretVersionContent(secretVersionInfo.secretBinary)
+		model.SecretString = ssmSecretVersionContent(secretVersionInfo.secretString)
 	}
 
-	return nil
+	return true, nil
 }
 
-func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func (me *ssmSecretVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.update")()
 
+	var plan, state ssmSecretVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
-		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
+		ssmService    = SsmService{client: me.client.apiV3Conn}
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
-	}
-	secretName := ids[0]
-	versionId := ids[1]
+	secretName := plan.SecretName.ValueString()
+	versionId := plan.VersionId.ValueString()
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = sdkresource.Retry(readRetryTimeout, func() *sdkresource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -244,25 +352,24 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		resp.Diagnostics.AddError("Read SSM secret failed", outErr.Error())
+		return
 	}
 
 	if secretInfo.status == SSM_STATUS_ENABLED {
-		d.Partial(true)
-
 		param := make(map[string]interface{})
 		param["secret_name"] = secretName
 		param["version_id"] = versionId
-		if v, ok := d.GetOk("secret_binary"); ok {
-			param["secret_binary"] = v.(string)
+		if v := plan.SecretBinary.ValueString(); v != "" {
+			param["secret_binary"] = v
 		}
 
-		if v, ok := d.GetOk("secret_string"); ok {
-			param["secret_string"] = v.(string)
+		if v := plan.SecretString.ValueString(); v != "" {
+			param["secret_string"] = v
 		}
 
-		if d.HasChange("secret_binary") || d.HasChange("secret_string") {
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if !plan.SecretBinary.Equal(state.SecretBinary) || !plan.SecretString.Equal(state.SecretString) {
+			err := sdkresource.Retry(writeRetryTimeout, func() *sdkresource.RetryError {
 				e := ssmService.UpdateSecret(ctx, param)
 				if e != nil {
 					return retryError(e)
@@ -273,33 +380,44 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify SSM secret content failed, reason:%+v", logId, err)
-				return err
+				resp.Diagnostics.AddError("Modify SSM secret content failed", err.Error())
+				return
 			}
 		}
-
-		d.Partial(false)
 	}
 
-	return resourceTencentCloudSsmSecretVersionRead(d, meta)
+	if _, err := me.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read SSM secret version failed", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceTencentCloudSsmSecretVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func (me *ssmSecretVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.delete")()
 
+	var state ssmSecretVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
-		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
+		ssmService = SsmService{client: me.client.apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := strings.Split(state.Id.ValueString(), FILED_SP)
 	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
+		resp.Diagnostics.AddError("Delete SSM secret version failed",
+			fmt.Sprintf("SSM secret version id can't read, id is borken, id is %s", state.Id.ValueString()))
+		return
 	}
 	secretName := ids[0]
 	versionId := ids[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := sdkresource.Retry(writeRetryTimeout, func() *sdkresource.RetryError {
 		e := ssmService.DeleteSecretVersion(ctx, secretName, versionId)
 		if e != nil {
 			return retryError(e)
@@ -310,19 +428,36 @@ func resourceTencentCloudSsmSecretVersionDelete(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete SSM secret version failed, reason:%+v", logId, err)
-		return err
+		resp.Diagnostics.AddError("Delete SSM secret version failed", err.Error())
+		return
 	}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = sdkresource.Retry(readRetryTimeout, func() *sdkresource.RetryError {
 		_, e := ssmService.DescribeSecretVersion(ctx, secretName, versionId)
 		if e != nil {
 			if sdkError, ok := e.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
 				return nil
 			}
 
-			return retryError(err)
+			return retryError(e)
 		}
 
-		return resource.RetryableError(fmt.Errorf("delete fail"))
+		return sdkresource.RetryableError(fmt.Errorf("delete fail"))
 	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete SSM secret version failed", err.Error())
+	}
+}
+
+func (me *ssmSecretVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ssmSecretVersionContent returns null for the unset content, as the other one of secret_binary and
+// secret_string is not configured
+func ssmSecretVersionContent(content string) types.String {
+	if content == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(content)
 }
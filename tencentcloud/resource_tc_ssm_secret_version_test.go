@@ -4,13 +4,264 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+func TestUnitSsmSecretVersionResource(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	meta.apiV3Conn.Endpoints["ssm"] = server.URL
+	secret := testFakeApiSsmSecret(server, "tf-unit-secret")
+
+	sdkProvider := Provider()
+	sdkProvider.SetMeta(meta)
+	providerServer := providerserver.NewProtocol5(newFrameworkProvider(sdkProvider))()
+
+	ctx := context.TODO()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+	typ := schemaResp.ResourceSchemas["tencentcloud_ssm_secret_version"].ValueType()
+	value := func(attributes map[string]tftypes.Value) *tfprotov5.DynamicValue {
+		for _, name := range []string{"id", "secret_name", "version_id", "secret_binary", "secret_string"} {
+			if _, ok := attributes[name]; !ok {
+				attributes[name] = tftypes.NewValue(tftypes.String, nil)
+			}
+		}
+		dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+		assert.Nil(t, err)
+		return &dv
+	}
+	attributes := func(dv *tfprotov5.DynamicValue) map[string]*string {
+		v, err := dv.Unmarshal(typ)
+		assert.Nil(t, err)
+		if v.IsNull() {
+			return nil
+		}
+		var values map[string]tftypes.Value
+		assert.Nil(t, v.As(&values))
+		result := make(map[string]*string, len(values))
+		for name, value := range values {
+			var s *string
+			assert.Nil(t, value.As(&s))
+			result[name] = s
+		}
+		return result
+	}
+
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+
+	// exactly one of secret_binary and secret_string must be set
+	validateResp, err := providerServer.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName: "tencentcloud_ssm_secret_version",
+		Config: value(map[string]tftypes.Value{
+			"secret_name": tftypes.NewValue(tftypes.String, "tf-unit-secret"),
+			"version_id":  tftypes.NewValue(tftypes.String, "v1"),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, validateResp.Diagnostics, 1)
+
+	config := value(map[string]tftypes.Value{
+		"secret_name":   tftypes.NewValue(tftypes.String, "tf-unit-secret"),
+		"version_id":    tftypes.NewValue(tftypes.String, "v1"),
+		"secret_string": tftypes.NewValue(tftypes.String, "this is secret string"),
+	})
+	validateResp, err = providerServer.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName: "tencentcloud_ssm_secret_version",
+		Config:   config,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, validateResp.Diagnostics)
+
+	nullState, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	assert.Nil(t, err)
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "tencentcloud_ssm_secret_version",
+		PriorState:       &nullState,
+		ProposedNewState: config,
+		Config:           config,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, planResp.Diagnostics)
+
+	applyResp, err := providerServer.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "tencentcloud_ssm_secret_version",
+		PriorState:   &nullState,
+		PlannedState: planResp.PlannedState,
+		Config:       config,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, applyResp.Diagnostics)
+	state := attributes(applyResp.NewState)
+	assert.Equal(t, "tf-unit-secret"+FILED_SP+"v1", *state["id"])
+	assert.Equal(t, "this is secret string", *state["secret_string"])
+	assert.Nil(t, state["secret_binary"])
+	assert.Equal(t, "this is secret string", secret.content("v1"))
+
+	// the state of the sdk implementation saved the unset content as empty string
+	legacyState := value(map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, *state["id"]),
+		"secret_name":   tftypes.NewValue(tftypes.String, "tf-unit-secret"),
+		"version_id":    tftypes.NewValue(tftypes.String, "v1"),
+		"secret_binary": tftypes.NewValue(tftypes.String, ""),
+		"secret_string": tftypes.NewValue(tftypes.String, "this is secret string"),
+	})
+	secret.setStatus(SSM_STATUS_DISABLED)
+	readResp, err := providerServer.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "tencentcloud_ssm_secret_version",
+		CurrentState: legacyState,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, readResp.Diagnostics)
+	state = attributes(readResp.NewState)
+	assert.Nil(t, state["secret_binary"])
+	assert.Equal(t, "this is secret string", *state["secret_string"])
+
+	secret.setStatus(SSM_STATUS_ENABLED)
+	secret.setContent("v1", "rotated")
+	readResp, err = providerServer.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "tencentcloud_ssm_secret_version",
+		CurrentState: applyResp.NewState,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, readResp.Diagnostics)
+	assert.Equal(t, "rotated", *attributes(readResp.NewState)["secret_string"])
+
+	applyResp, err = providerServer.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "tencentcloud_ssm_secret_version",
+		PriorState:   readResp.NewState,
+		PlannedState: &nullState,
+		Config:       &nullState,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, applyResp.Diagnostics)
+	assert.Equal(t, 1, server.Calls("ssm", "DeleteSecretVersion"))
+
+	// the deleted version is removed from the state
+	readResp, err = providerServer.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "tencentcloud_ssm_secret_version",
+		CurrentState: legacyState,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, readResp.Diagnostics)
+	assert.Nil(t, attributes(readResp.NewState))
+}
+
+// testFakeSsmSecret is a secret served by the ssm actions registered to the fake API server
+type testFakeSsmSecret struct {
+	mu       sync.Mutex
+	name     string
+	status   string
+	versions map[string]string
+}
+
+func (me *testFakeSsmSecret) setStatus(status string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	me.status = status
+}
+
+func (me *testFakeSsmSecret) setContent(versionId, content string) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	me.versions[versionId] = content
+}
+
+func (me *testFakeSsmSecret) content(versionId string) string {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	return me.versions[versionId]
+}
+
+func testFakeApiSsmSecret(server *fakeapi.Server, name string) *testFakeSsmSecret {
+	secret := &testFakeSsmSecret{name: name, status: SSM_STATUS_ENABLED, versions: make(map[string]string)}
+	notFound := func(versionId string) error {
+		return &fakeapi.Error{Code: fakeapi.CodeResourceNotFound, Message: fmt.Sprintf("version %s is not found", versionId)}
+	}
+
+	server.Handle("ssm", "DescribeSecret", func(request *fakeapi.Request) (interface{}, error) {
+		secret.mu.Lock()
+		defer secret.mu.Unlock()
+		return &ssm.DescribeSecretResponseParams{
+			SecretName:       helper.String(secret.name),
+			Description:      helper.String(""),
+			KmsKeyId:         helper.String(""),
+			SecretType:       helper.Int64(0),
+			AdditionalConfig: helper.String(""),
+			CreateUin:        helper.Uint64(100000000001),
+			Status:           helper.String(secret.status),
+			DeleteTime:       helper.Uint64(0),
+			CreateTime:       helper.Uint64(0),
+		}, nil
+	})
+	server.Handle("ssm", "ListSecretVersionIds", func(request *fakeapi.Request) (interface{}, error) {
+		secret.mu.Lock()
+		defer secret.mu.Unlock()
+		response := &ssm.ListSecretVersionIdsResponseParams{SecretName: helper.String(secret.name)}
+		for versionId := range secret.versions {
+			response.Versions = append(response.Versions, &ssm.VersionInfo{VersionId: helper.String(versionId)})
+		}
+		return response, nil
+	})
+	server.Handle("ssm", "PutSecretValue", func(request *fakeapi.Request) (interface{}, error) {
+		var params ssm.PutSecretValueRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		secret.mu.Lock()
+		defer secret.mu.Unlock()
+		secret.versions[*params.VersionId] = helper.PString(params.SecretString)
+		return &ssm.PutSecretValueResponseParams{SecretName: params.SecretName, VersionId: params.VersionId}, nil
+	})
+	server.Handle("ssm", "GetSecretValue", func(request *fakeapi.Request) (interface{}, error) {
+		var params ssm.GetSecretValueRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		secret.mu.Lock()
+		defer secret.mu.Unlock()
+		content, ok := secret.versions[*params.VersionId]
+		if !ok {
+			return nil, notFound(*params.VersionId)
+		}
+		return &ssm.GetSecretValueResponseParams{
+			SecretName:   params.SecretName,
+			VersionId:    params.VersionId,
+			SecretBinary: helper.String(""),
+			SecretString: helper.String(content),
+		}, nil
+	})
+	server.Handle("ssm", "DeleteSecretVersion", func(request *fakeapi.Request) (interface{}, error) {
+		var params ssm.DeleteSecretVersionRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		secret.mu.Lock()
+		defer secret.mu.Unlock()
+		if _, ok := secret.versions[*params.VersionId]; !ok {
+			return nil, notFound(*params.VersionId)
+		}
+		delete(secret.versions, *params.VersionId)
+		return &ssm.DeleteSecretVersionResponseParams{}, nil
+	})
+	return secret
+}
+
 // go test -i; go test -test.run TestAccTencentCloudSsmSecretVersion_basic -v
 func TestAccTencentCloudSsmSecretVersion_basic(t *testing.T) {
 	t.Parallel()
@@ -18,9 +269,9 @@ func TestAccTencentCloudSsmSecretVersion_basic(t *testing.T) {
 	resourceV2Name := "tencentcloud_ssm_secret_version.v2"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSsmSecretVersionDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSsmSecretVersionDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: TestAccTencentCloudSsmSecretVersionBinaryConfig,
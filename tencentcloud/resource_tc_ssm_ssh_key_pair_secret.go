@@ -60,6 +60,7 @@ func resourceTencentCloudSsmSshKeyPairSecret() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
 				Required:    true,
@@ -92,6 +93,11 @@ func resourceTencentCloudSsmSshKeyPairSecret() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of secret.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			"status": {
 				Optional:     true,
 				Type:         schema.TypeString,
@@ -184,7 +190,7 @@ func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
 			secretInfo, err = ssmService.DescribeSecretByName(ctx, secretName)
 			if err != nil {
@@ -285,7 +291,7 @@ func resourceTencentCloudSsmSshKeyPairSecretRead(d *schema.ResourceData, meta in
 		return err
 	}
 
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
@@ -356,11 +362,11 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
@@ -50,6 +50,7 @@ func resourceTencentCloudVpcSubnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(customizeDiffTagsAll, resourceTencentCloudVpcSubnetCustomizeDiff),
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -94,6 +95,11 @@ func resourceTencentCloudVpcSubnet() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the subnet.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 
 			// Computed values
 			"is_default": {
@@ -115,6 +121,40 @@ func resourceTencentCloudVpcSubnet() *schema.Resource {
 	}
 }
 
+// resourceTencentCloudVpcSubnetCustomizeDiff checks the `cidr_block` is in the CIDR blocks of the VPC at plan time.
+func resourceTencentCloudVpcSubnetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("cidr_block") && !d.HasChange("vpc_id") {
+		return nil
+	}
+	if !d.NewValueKnown("vpc_id") || !d.NewValueKnown("cidr_block") {
+		return nil
+	}
+
+	logId := getLogId(ctx)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	vpcId := d.Get("vpc_id").(string)
+	cidrBlock := d.Get("cidr_block").(string)
+
+	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	info, has, err := vpcService.DescribeVpc(ctx, vpcId, "", "")
+	if err != nil {
+		log.Printf("[WARN]%s skip checking cidr_block of subnet, describe vpc [%s] fail, reason[%s]\n", logId, vpcId, err.Error())
+		return nil
+	}
+	if has == 0 {
+		return nil
+	}
+
+	vpcCidrs := append([]string{info.cidr}, info.assistantCidrs...)
+	for _, vpcCidr := range vpcCidrs {
+		if contained, err := cidrContained(vpcCidr, cidrBlock); err == nil && contained {
+			return nil
+		}
+	}
+	return fmt.Errorf("`cidr_block` %s is not in the CIDR blocks %v of vpc %s", cidrBlock, vpcCidrs, vpcId)
+}
+
 func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_subnet.create")()
 
@@ -172,7 +212,7 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 
@@ -194,7 +234,7 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -264,7 +304,7 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("is_default", info.isDefault)
 	_ = d.Set("available_ip_count", info.availableIpCount)
 	_ = d.Set("create_time", info.createTime)
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -324,8 +364,8 @@ func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
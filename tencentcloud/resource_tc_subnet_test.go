@@ -10,6 +10,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
 )
 
 func init() {
@@ -63,10 +65,48 @@ func testSweepSubnet(region string) error {
 	return nil
 }
 
+func TestUnitVpcSubnetCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	service := VpcService{client: meta.apiV3Conn}
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+	vpcId, _, err := service.CreateVpc(ctx, "vpc-test", "10.0.0.0/16", false, nil, nil)
+	assert.Nil(t, err)
+
+	config := func(vpcId, cidrBlock string) map[string]interface{} {
+		return map[string]interface{}{
+			"vpc_id":            vpcId,
+			"availability_zone": "ap-guangzhou-3",
+			"name":              "subnet-test",
+			"cidr_block":        cidrBlock,
+		}
+	}
+
+	_, err = testResourceDiff(resourceTencentCloudVpcSubnet(), nil, config(vpcId, "10.0.1.0/24"), meta)
+	assert.Nil(t, err)
+
+	_, err = testResourceDiff(resourceTencentCloudVpcSubnet(), nil, config(vpcId, "10.1.0.0/24"), meta)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "`cidr_block` 10.1.0.0/24 is not in the CIDR blocks [10.0.0.0/16]")
+	}
+
+	// the vpc to be created is not looked up
+	calls := server.Calls("vpc", "DescribeVpcs")
+	_, err = testResourceDiff(resourceTencentCloudVpcSubnet(), nil, config(testUnknownValue, "10.1.0.0/24"), meta)
+	assert.Nil(t, err)
+	assert.Equal(t, calls, server.Calls("vpc", "DescribeVpcs"))
+
+	// the check is skipped when the vpc can't be described
+	server.InjectFault("vpc", "DescribeVpcs", fakeapi.Fault{Code: "AuthFailure.UnauthorizedOperation"})
+	_, err = testResourceDiff(resourceTencentCloudVpcSubnet(), nil, config(vpcId, "10.1.0.0/24"), meta)
+	assert.Nil(t, err)
+}
+
 func TestAccTencentCloudVpcV3SubnetBasic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckRecorder(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckVpcSubnetDestroy,
 		Steps: []resource.TestStep{
@@ -71,6 +71,14 @@ resource "tencentcloud_tcaplus_idl" "main" {
     EOF
 }
 ```
+
+Import
+
+tcaplus idl can be imported using the cluster id and the file id, e.g.
+
+```
+terraform import tencentcloud_tcaplus_idl.main 19162256624#1234
+```
 */
 package tencentcloud
 
@@ -78,10 +86,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tcaplusdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcaplusdb/v20190823"
 )
 
 type TcaplusIdlId struct {
@@ -98,6 +108,9 @@ func resourceTencentCloudTcaplusIdl() *schema.Resource {
 		Create: resourceTencentCloudTcaplusIdlCreate,
 		Read:   resourceTencentCloudTcaplusIdlRead,
 		Delete: resourceTencentCloudTcaplusIdlDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudTcaplusIdlImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -266,6 +279,27 @@ func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface
 	return resourceTencentCloudTcaplusIdlRead(d, meta)
 }
 
+// resourceTencentCloudTcaplusIdlImport imports the idl file by `cluster_id#file_id`, the rest of the id
+// is filled by the file info read, `tablegroup_id` can't be read.
+func resourceTencentCloudTcaplusIdlImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), FILED_SP)
+	if len(idSplit) != 2 {
+		return nil, fmt.Errorf("id is broken, %s", d.Id())
+	}
+	fileId, err := strconv.ParseInt(idSplit[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("file id is broken, %s", idSplit[1])
+	}
+
+	id, err := json.Marshal(TcaplusIdlId{ClusterId: idSplit[0], FileId: fileId})
+	if err != nil {
+		return nil, fmt.Errorf("format idl id fail,%s", err.Error())
+	}
+	d.SetId(string(id))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudTcaplusIdlRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_tcaplus_idl.read")()
 	defer inconsistentCheck(d, meta)()
@@ -281,6 +315,42 @@ func resourceTencentCloudTcaplusIdlRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("idl id is broken,%s", err.Error())
 	}
 
+	// the id imported only has the cluster and the file id
+	if tcaplusIdlId.FileName == "" {
+		infos, err := tcaplusService.DescribeIdlFileInfos(ctx, tcaplusIdlId.ClusterId)
+		if err != nil {
+			return err
+		}
+		var fileInfo *tcaplusdb.IdlFileInfo
+		for _, info := range infos {
+			if info.FileId != nil && *info.FileId == tcaplusIdlId.FileId {
+				fileInfo = info
+				break
+			}
+		}
+		if fileInfo == nil {
+			d.SetId("")
+			return nil
+		}
+		tcaplusIdlId.FileName = *fileInfo.FileName
+		tcaplusIdlId.FileType = *fileInfo.FileType
+		tcaplusIdlId.FileExtType = *fileInfo.FileExtType
+		tcaplusIdlId.FileSize = *fileInfo.FileSize
+		id, err := json.Marshal(tcaplusIdlId)
+		if err != nil {
+			return fmt.Errorf("format idl id fail,%s", err.Error())
+		}
+		d.SetId(string(id))
+
+		_ = d.Set("cluster_id", tcaplusIdlId.ClusterId)
+		_ = d.Set("file_name", tcaplusIdlId.FileName)
+		_ = d.Set("file_type", tcaplusIdlId.FileType)
+		_ = d.Set("file_ext_type", tcaplusIdlId.FileExtType)
+		if fileInfo.FileContent != nil {
+			_ = d.Set("file_content", fileInfo.FileContent)
+		}
+	}
+
 	parseTableInfos, err := tcaplusService.DesOldIdlFiles(ctx, tcaplusIdlId)
 	if err != nil {
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
@@ -40,10 +40,29 @@ func TestAccTencentCloudTcaplusIdlResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTcaplusIdlResourceNameResourceKey, "table_infos.0.error", ""),
 				),
 			},
+			{
+				ResourceName:            testTcaplusIdlResourceNameResourceKey,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccTcaplusIdlImportStateId,
+				ImportStateVerifyIgnore: []string{"tablegroup_id", "file_content"},
+			},
 		},
 	})
 }
 
+func testAccTcaplusIdlImportStateId(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testTcaplusIdlResourceNameResourceKey]
+	if !ok {
+		return "", fmt.Errorf("tcaplus idl %s is not found", testTcaplusIdlResourceNameResourceKey)
+	}
+	var tcaplusIdlId TcaplusIdlId
+	if err := json.Unmarshal([]byte(rs.Primary.ID), &tcaplusIdlId); err != nil {
+		return "", fmt.Errorf("idl id is broken,%s", err.Error())
+	}
+	return fmt.Sprintf("%s%s%d", tcaplusIdlId.ClusterId, FILED_SP, tcaplusIdlId.FileId), nil
+}
+
 func TestAccTencentCloudTcaplusTdrIdlResource(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
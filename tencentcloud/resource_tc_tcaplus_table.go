@@ -84,6 +84,14 @@ resource "tencentcloud_tcaplus_table" "example" {
   reserved_volume   = 1
 }
 ```
+
+Import
+
+TcaplusDB table can be imported using the cluster id and the table instance id, e.g.
+
+```
+terraform import tencentcloud_tcaplus_table.example 26655801#tcaplus-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -91,6 +99,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -103,6 +112,19 @@ func resourceTencentCloudTcaplusTable() *schema.Resource {
 		Read:   resourceTencentCloudTcaplusTableRead,
 		Update: resourceTencentCloudTcaplusTableUpdate,
 		Delete: resourceTencentCloudTcaplusTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the table is described with its cluster, so it is imported by `cluster_id#table_instance_id`
+				idSplit := strings.Split(d.Id(), FILED_SP)
+				if len(idSplit) != 2 {
+					return nil, fmt.Errorf("id is broken, %s", d.Id())
+				}
+				_ = d.Set("cluster_id", idSplit[0])
+				d.SetId(idSplit[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
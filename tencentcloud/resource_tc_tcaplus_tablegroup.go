@@ -34,6 +34,14 @@ resource "tencentcloud_tcaplus_tablegroup" "example" {
   tablegroup_name = "tf_example_group_name"
 }
 ```
+
+Import
+
+TcaplusDB table group can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tcaplus_tablegroup.example 26655801:1
+```
 */
 package tencentcloud
 
@@ -41,6 +49,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,6 +61,9 @@ func resourceTencentCloudTcaplusTableGroup() *schema.Resource {
 		Read:   resourceTencentCloudTcaplusTableGroupRead,
 		Update: resourceTencentCloudTcaplusTableGroupUpdate,
 		Delete: resourceTencentCloudTcaplusTableGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -114,8 +126,12 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	clusterId := d.Get("cluster_id").(string)
 	groupId := d.Id()
+	items := strings.Split(groupId, ":")
+	if len(items) != 2 {
+		return fmt.Errorf("group id is broken,%s", groupId)
+	}
+	clusterId := items[0]
 
 	info, has, err := tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 	if err != nil {
@@ -135,6 +151,7 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 		return nil
 	}
 
+	_ = d.Set("cluster_id", clusterId)
 	_ = d.Set("tablegroup_name", info.TableGroupName)
 	_ = d.Set("table_count", int(*info.TableCount))
 	_ = d.Set("total_size", int(*info.TotalSize))
@@ -272,6 +272,7 @@ func resourceTencentCloudTcmMesh() *schema.Resource {
 													Type:        schema.TypeString,
 													Optional:    true,
 													Computed:    true,
+													Sensitive:   true,
 													Description: "Password of the prometheus, used in basic authentication type.",
 												},
 											},
@@ -225,6 +225,7 @@ func resourceTencentCloudTcrManageReplicationOperation() *schema.Resource {
 						"peer_registry_token": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "access permanent token of the instance to be synchronized.",
 						},
 						"enable_peer_replication": {
@@ -42,6 +42,14 @@ resource "tencentcloud_tcr_tag_retention_execution_config" "example" {
   dry_run      = false
 }
 ```
+
+Import
+
+tcr tag_retention_execution_config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tcr_tag_retention_execution_config.example tcr-s1jud21h#1
+```
 */
 package tencentcloud
 
@@ -64,6 +72,9 @@ func resourceTencentCloudTcrTagRetentionExecutionConfig() *schema.Resource {
 		Read:   resourceTencentCloudTcrTagRetentionExecutionConfigRead,
 		Update: resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
 		Delete: resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
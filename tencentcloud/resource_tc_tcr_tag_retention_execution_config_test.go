@@ -28,6 +28,12 @@ func TestAccTencentCloudTcrTagRetentionExecutionConfigResource_basic(t *testing.
 					resource.TestCheckResourceAttrSet("tencentcloud_tcr_tag_retention_execution_config.config", "execution_id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tcr_tag_retention_execution_config.config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dry_run"},
+			},
 		},
 	})
 }
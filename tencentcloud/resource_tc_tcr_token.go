@@ -78,6 +78,7 @@ func resourceTencentCloudTcrToken() *schema.Resource {
 			"token": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The content of the token.",
 			},
 			"user_name": {
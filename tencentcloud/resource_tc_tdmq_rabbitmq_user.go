@@ -14,6 +14,14 @@ resource "tencentcloud_tdmq_rabbitmq_user" "rabbitmq_user" {
   max_channels    = 3
 }
 ```
+
+Import
+
+TDMQ RabbitMQ user can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tdmq_rabbitmq_user.rabbitmq_user amqp-xxxxxxxx#user
+```
 */
 package tencentcloud
 
@@ -35,6 +43,9 @@ func resourceTencentCloudTdmqRabbitmqUser() *schema.Resource {
 		Read:   resourceTencentCloudTdmqRabbitmqUserRead,
 		Update: resourceTencentCloudTdmqRabbitmqUserUpdate,
 		Delete: resourceTencentCloudTdmqRabbitmqUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
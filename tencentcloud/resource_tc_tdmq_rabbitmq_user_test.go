@@ -39,6 +39,12 @@ func TestAccTencentCloudNeedFixTdmqRabbitmqUserResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tdmq_rabbitmq_user.rabbitmq_user", "max_channels"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tdmq_rabbitmq_user.rabbitmq_user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_connections", "max_channels"},
+			},
 		},
 	})
 }
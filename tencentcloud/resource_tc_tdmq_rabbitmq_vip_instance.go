@@ -32,6 +32,14 @@ resource "tencentcloud_tdmq_rabbitmq_vip_instance" "example" {
   time_span                             = 1
 }
 ```
+
+Import
+
+TDMQ RabbitMQ vip instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tdmq_rabbitmq_vip_instance.example amqp-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -52,6 +60,9 @@ func resourceTencentCloudTdmqRabbitmqVipInstance() *schema.Resource {
 		Read:   resourceTencentCloudTdmqRabbitmqVipInstanceRead,
 		Update: resourceTencentCloudTdmqRabbitmqVipInstanceUpdate,
 		Delete: resourceTencentCloudTdmqRabbitmqVipInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone_ids": {
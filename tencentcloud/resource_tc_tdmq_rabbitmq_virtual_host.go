@@ -11,6 +11,14 @@ resource "tencentcloud_tdmq_rabbitmq_virtual_host" "rabbitmq_virtual_host" {
   trace_flag   = false
 }
 ```
+
+Import
+
+TDMQ RabbitMQ virtual host can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host amqp-xxxxxxxx#vh-name
+```
 */
 package tencentcloud
 
@@ -32,6 +40,9 @@ func resourceTencentCloudTdmqRabbitmqVirtualHost() *schema.Resource {
 		Read:   resourceTencentCloudTdmqRabbitmqVirtualHostRead,
 		Update: resourceTencentCloudTdmqRabbitmqVirtualHostUpdate,
 		Delete: resourceTencentCloudTdmqRabbitmqVirtualHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
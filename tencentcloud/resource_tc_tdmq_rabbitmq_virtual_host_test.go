@@ -42,6 +42,12 @@ func TestAccTencentCloudNeedFixTdmqRabbitmqVirtualHostResource_basic(t *testing.
 					resource.TestCheckResourceAttrSet("tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host", "trace_flag"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trace_flag"},
+			},
 		},
 	})
 }
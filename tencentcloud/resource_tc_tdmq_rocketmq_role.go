@@ -67,6 +67,7 @@ func resourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 			"token": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "Value of the role token.",
 			},
 
@@ -40,6 +40,14 @@ resource "tencentcloud_tdmq_rocketmq_vip_instance" "example" {
   time_span = 1
 }
 ```
+
+Import
+
+TDMQ RocketMQ vip instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tdmq_rocketmq_vip_instance.example rmq-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -61,6 +69,9 @@ func resourceTencentCloudTdmqRocketmqVipInstance() *schema.Resource {
 		Read:   resourceTencentCloudTdmqRocketmqVipInstanceRead,
 		Update: resourceTencentCloudTdmqRocketmqVipInstanceUpdate,
 		Delete: resourceTencentCloudTdmqRocketmqVipInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -17,6 +17,14 @@ resource "tencentcloud_tem_application" "application" {
   }
 }
 ```
+
+Import
+
+tem application can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tem_application.application application-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -37,9 +45,10 @@ func resourceTencentCloudTemApplication() *schema.Resource {
 		Create: resourceTencentCloudTemApplicationCreate,
 		Update: resourceTencentCloudTemApplicationUpdate,
 		Delete: resourceTencentCloudTemApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Type:        schema.TypeString,
@@ -95,6 +104,11 @@ func resourceTencentCloudTemApplication() *schema.Resource {
 				Optional:    true,
 				Description: "application tag list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -141,11 +155,11 @@ func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta inter
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		for key, value := range v.(map[string]interface{}) {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
+		for key, value := range v {
 			tag := tem.Tag{
 				TagKey:   helper.String(key),
-				TagValue: helper.String(value.(string)),
+				TagValue: helper.String(value),
 			}
 			request.Tags = append(request.Tags, &tag)
 		}
@@ -174,7 +188,7 @@ func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(applicationId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tem:%s:uin/:application/%s", region, applicationId)
@@ -245,7 +259,7 @@ func resourceTencentCloudTemApplicationRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -308,10 +322,10 @@ func resourceTencentCloudTemApplicationUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tem", "application", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -44,6 +44,7 @@ func resourceTencentCloudTemEnvironment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"environment_name": {
 				Type:        schema.TypeString,
@@ -76,6 +77,11 @@ func resourceTencentCloudTemEnvironment() *schema.Resource {
 				Optional:    true,
 				Description: "environment tag list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -111,11 +117,11 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		for key, value := range v.(map[string]interface{}) {
+	if v := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(v) > 0 {
+		for key, value := range v {
 			tag := tem.Tag{
 				TagKey:   helper.String(key),
-				TagValue: helper.String(value.(string)),
+				TagValue: helper.String(value),
 			}
 			request.Tags = append(request.Tags, &tag)
 		}
@@ -162,7 +168,7 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(environmentId)
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tem:%s:uin/:environment/%s", region, environmentId)
@@ -219,7 +225,7 @@ func resourceTencentCloudTemEnvironmentRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -276,10 +282,10 @@ func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tem", "environment", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -232,6 +232,7 @@ func resourceTencentCloudTseCngwRouteRateLimit() *schema.Resource {
 									"redis_password": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "redis password, maybe null.",
 									},
 									"redis_port": {
@@ -208,6 +208,7 @@ func resourceTencentCloudTseCngwServiceRateLimit() *schema.Resource {
 									"redis_password": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "redis password, maybe null.",
 									},
 									"redis_port": {
@@ -23,6 +23,14 @@ resource "tencentcloud_tsf_application" "application" {
   ignore_create_image_repository = true
 }
 ```
+
+Import
+
+tsf application can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_application.application application-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -43,9 +51,9 @@ func resourceTencentCloudTsfApplication() *schema.Resource {
 		Read:   resourceTencentCloudTsfApplicationRead,
 		Update: resourceTencentCloudTsfApplicationUpdate,
 		Delete: resourceTencentCloudTsfApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Required:    true,
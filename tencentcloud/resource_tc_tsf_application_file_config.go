@@ -17,6 +17,14 @@ resource "tencentcloud_tsf_application_file_config" "application_file_config" {
   encode_with_base64 = true
 }
 ```
+
+Import
+
+TSF application file config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_application_file_config.application_file_config dcfg-f-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -35,6 +43,9 @@ func resourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 		Create: resourceTencentCloudTsfApplicationFileConfigCreate,
 		Read:   resourceTencentCloudTsfApplicationFileConfigRead,
 		Delete: resourceTencentCloudTsfApplicationFileConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"config_name": {
@@ -14,6 +14,14 @@ resource "tencentcloud_tsf_application_public_config" "application_public_config
   # program_id_list =
 }
 ```
+
+Import
+
+tsf application_public_config can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_application_public_config.application_public_config dcfg-p-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -32,9 +40,9 @@ func resourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 		Create: resourceTencentCloudTsfApplicationPublicConfigCreate,
 		Read:   resourceTencentCloudTsfApplicationPublicConfigRead,
 		Delete: resourceTencentCloudTsfApplicationPublicConfigDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"config_name": {
 				Required:    true,
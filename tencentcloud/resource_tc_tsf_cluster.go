@@ -20,6 +20,13 @@ resource "tencentcloud_tsf_cluster" "cluster" {
 }
 ```
 
+Import
+
+tsf cluster can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_cluster.cluster cluster-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -41,9 +48,10 @@ func resourceTencentCloudTsfCluster() *schema.Resource {
 		Read:   resourceTencentCloudTsfClusterRead,
 		Update: resourceTencentCloudTsfClusterUpdate,
 		Delete: resourceTencentCloudTsfClusterDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Computed:    true,
@@ -138,6 +146,7 @@ func resourceTencentCloudTsfCluster() *schema.Resource {
 			"kubernete_native_secret": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "native secret.",
 			},
 
@@ -370,6 +379,11 @@ func resourceTencentCloudTsfCluster() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -484,7 +498,7 @@ func resourceTencentCloudTsfClusterCreate(d *schema.ResourceData, meta interface
 	}
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tsf:%s:uin/:cluster/%s", region, d.Id())
@@ -741,7 +755,7 @@ func resourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -798,11 +812,11 @@ func resourceTencentCloudTsfClusterUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tsf", "cluster", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -18,6 +18,13 @@ resource "tencentcloud_tsf_config_template" "config_template" {
 }
 ```
 
+Import
+
+tsf config_template can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_config_template.config_template dcfg-t-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -38,9 +45,9 @@ func resourceTencentCloudTsfConfigTemplate() *schema.Resource {
 		Read:   resourceTencentCloudTsfConfigTemplateRead,
 		Update: resourceTencentCloudTsfConfigTemplateUpdate,
 		Delete: resourceTencentCloudTsfConfigTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"config_template_name": {
 				Required:    true,
@@ -65,6 +65,14 @@ resource "tencentcloud_tsf_deploy_container_group" "deploy_container_group" {
 	}
 }
 ```
+
+Import
+
+tsf deploy_container_group can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_deploy_container_group.deploy_container_group group-yqml6w3a
+```
 */
 package tencentcloud
 
@@ -85,6 +93,9 @@ func resourceTencentCloudTsfDeployContainerGroup() *schema.Resource {
 		Read:   resourceTencentCloudTsfDeployContainerGroupRead,
 		Update: resourceTencentCloudTsfDeployContainerGroupUpdate,
 		Delete: resourceTencentCloudTsfDeployContainerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -71,6 +71,12 @@ func TestAccTencentCloudTsfDeployContainerGroupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tsf_deploy_container_group.deploy_container_group", "warmup_setting.0.enabled"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tsf_deploy_container_group.deploy_container_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"incremental_deployment", "max_surge", "max_unavailable", "scheduling_strategy", "service_setting"},
+			},
 		},
 	})
 }
@@ -22,6 +22,14 @@ resource "tencentcloud_tsf_instances_attachment" "instances_attachment" {
   security_group_ids = [""]
 }
 ```
+
+Import
+
+tsf instances_attachment can be imported using the clusterId#instanceId, e.g.
+
+```
+terraform import tencentcloud_tsf_instances_attachment.instances_attachment cluster-xxxxxxxx#ins-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -42,9 +50,9 @@ func resourceTencentCloudTsfInstancesAttachment() *schema.Resource {
 		Create: resourceTencentCloudTsfInstancesAttachmentCreate,
 		Read:   resourceTencentCloudTsfInstancesAttachmentRead,
 		Delete: resourceTencentCloudTsfInstancesAttachmentDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
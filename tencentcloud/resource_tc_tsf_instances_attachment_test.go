@@ -34,6 +34,11 @@ func TestAccTencentCloudTsfInstancesAttachmentResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_tsf_instances_attachment.instances_attachment", "instance_advanced_settings.0.mount_target", "/mnt/data"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tsf_instances_attachment.instances_attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
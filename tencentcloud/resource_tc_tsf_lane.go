@@ -14,6 +14,13 @@ resource "tencentcloud_tsf_lane" "lane" {
 }
 ```
 
+Import
+
+tsf lane can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_lane.lane lane-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -34,9 +41,9 @@ func resourceTencentCloudTsfLane() *schema.Resource {
 		Read:   resourceTencentCloudTsfLaneRead,
 		Update: resourceTencentCloudTsfLaneUpdate,
 		Delete: resourceTencentCloudTsfLaneDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"lane_id": {
 				Type:        schema.TypeString,
@@ -18,6 +18,13 @@ resource "tencentcloud_tsf_lane_rule" "lane_rule" {
 }
 ```
 
+Import
+
+tsf lane_rule can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_lane_rule.lane_rule rule-xxxxxxxx
+```
 */
 package tencentcloud
 
@@ -38,9 +45,9 @@ func resourceTencentCloudTsfLaneRule() *schema.Resource {
 		Read:   resourceTencentCloudTsfLaneRuleRead,
 		Update: resourceTencentCloudTsfLaneRuleUpdate,
 		Delete: resourceTencentCloudTsfLaneRuleDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Computed:    true,
@@ -9,6 +9,16 @@ resource "tencentcloud_tsf_operate_container_group" "operate_container_group" {
   operate = "stop"
 }
 ```
+
+Import
+
+tsf operate_container_group can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_operate_container_group.operate_container_group group-ynd95rea
+```
+
+The `operate` is not imported, it's set by the configuration.
 */
 package tencentcloud
 
@@ -28,6 +38,9 @@ func resourceTencentCloudTsfOperateContainerGroup() *schema.Resource {
 		Read:   resourceTencentCloudTsfOperateContainerGroupRead,
 		Update: resourceTencentCloudTsfOperateContainerGroupUpdate,
 		Delete: resourceTencentCloudTsfOperateContainerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -31,6 +31,12 @@ func TestAccTencentCloudTsfOperateContainerGroupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tsf_operate_container_group.operate_container_group", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tsf_operate_container_group.operate_container_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operate"},
+			},
 		},
 	})
 }
@@ -9,6 +9,16 @@ resource "tencentcloud_tsf_operate_group" "operate_group" {
   operate  = "start"
 }
 ```
+
+Import
+
+tsf operate_group can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_tsf_operate_group.operate_group group-ynd95rea
+```
+
+The `operate` is not imported, it's set by the configuration.
 */
 package tencentcloud
 
@@ -28,6 +38,9 @@ func resourceTencentCloudTsfOperateGroup() *schema.Resource {
 		Read:   resourceTencentCloudTsfOperateGroupRead,
 		Update: resourceTencentCloudTsfOperateGroupUpdate,
 		Delete: resourceTencentCloudTsfOperateGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
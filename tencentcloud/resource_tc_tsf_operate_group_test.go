@@ -32,6 +32,12 @@ func TestAccTencentCloudTsfOperateGroupResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tsf_operate_group.operate_group", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tsf_operate_group.operate_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operate"},
+			},
 		},
 	})
 }
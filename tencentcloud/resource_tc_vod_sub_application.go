@@ -30,7 +30,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudVodSubApplication() *schema.Resource {
@@ -91,7 +90,6 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateSubAppId(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -111,7 +109,6 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 		statusResquest.Status = helper.String(v.(string))
 
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusResquest.GetAction())
 			_, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusResquest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -204,7 +201,6 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 	if changeFlag {
 		var err error
 		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdInfo(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -224,7 +220,6 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 		statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
 		var err error
 		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusRequest.GetAction())
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
@@ -250,7 +245,6 @@ func resourceTencentCloudVodSubApplicationDelete(d *schema.ResourceData, meta in
 	statusRequest.Status = helper.String("Off")
 	statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
 		if _, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return retryError(err, InternalError)
@@ -262,7 +256,6 @@ func resourceTencentCloudVodSubApplicationDelete(d *schema.ResourceData, meta in
 	// then destroy
 	statusRequest.Status = helper.String("Destroyed")
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
 		if _, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return retryError(err, InternalError)
@@ -66,6 +66,7 @@ func resourceTencentCloudVpcInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -119,6 +120,11 @@ func resourceTencentCloudVpcInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the VPC.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Tags of the VPC, including those inherited from the provider `default_tags`.",
+			},
 
 			// Computed values
 			"is_default": {
@@ -178,7 +184,7 @@ func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interfac
 	}
 	isMulticast = d.Get("is_multicast").(bool)
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 	vpcId, _, err := vpcService.CreateVpc(ctx, name, cidrBlock, isMulticast, dnsServers, tags)
@@ -267,7 +273,7 @@ func resourceTencentCloudVpcInstanceRead(d *schema.ResourceData, meta interface{
 		_ = d.Set("is_default", info.isDefault)
 		_ = d.Set("assistant_cidrs", info.assistantCidrs)
 		_ = d.Set("docker_assistant_cidrs", info.dockerAssistantCidrs)
-		_ = d.Set("tags", tags)
+		setResourceTags(d, meta, tags)
 
 		return nil
 	})
@@ -344,8 +350,8 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
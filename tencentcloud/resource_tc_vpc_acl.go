@@ -52,6 +52,7 @@ func resourceTencentCloudVpcACL() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -83,6 +84,11 @@ func resourceTencentCloudVpcACL() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the vpc acl.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 			//compute
 			"create_time": {
 				Type:        schema.TypeString,
@@ -129,7 +135,7 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if temp := helper.GetTags(d, "tags"); len(temp) > 0 {
+	if temp := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(temp) > 0 {
 		tags = temp
 	}
 
@@ -143,7 +149,7 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*TencentCloudClient).apiV3Conn
 	tagService := TagService{client: client}
 	region := client.Region
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		resourceName := BuildTagResourceName("vpc", "acl", region, aclID)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			return err
@@ -225,7 +231,7 @@ func resourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	_ = d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 
 	return nil
 }
@@ -306,12 +312,12 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: client}
 		region := client.Region
 
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "acl", region, id)
@@ -135,6 +135,7 @@ func resourceTencentCloudVpcFlowLog() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"flow_log_name": {
 				Required:    true,
@@ -210,6 +211,11 @@ func resourceTencentCloudVpcFlowLog() *schema.Resource {
 				Optional:    true,
 				Description: "Tag description list.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the tags of the resource, including those inherited from the provider `default_tags`.",
+			},
 		},
 	}
 }
@@ -295,7 +301,7 @@ func resourceTencentCloudVpcFlowLogCreate(d *schema.ResourceData, meta interface
 
 	resourceTencentCloudSetFlowLogId(d, flowLogId, d.Get("vpc_id").(string))
 
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if tags := mergeDefaultTags(meta, helper.GetTags(d, "tags")); len(tags) > 0 {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client}
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
@@ -393,7 +399,7 @@ func resourceTencentCloudVpcFlowLogRead(d *schema.ResourceData, meta interface{}
 	}
 
 	if len(tags) > 0 {
-		_ = d.Set("tags", tags)
+		setResourceTags(d, meta, tags)
 	}
 
 	return nil
@@ -462,10 +468,10 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client}
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := d.GetChange("tags_all")
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "fl", client.Region, flowLogId)
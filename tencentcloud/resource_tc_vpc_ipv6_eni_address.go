@@ -40,6 +40,14 @@ resource "tencentcloud_vpc_ipv6_eni_address" "ipv6_eni_address" {
   }
 }
 ```
+
+Import
+
+VPC ipv6 eni address can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_vpc_ipv6_eni_address.ipv6_eni_address vpc-xxxxxxxx#eni-xxxxxxxx#2402:4e00::1
+```
 */
 package tencentcloud
 
@@ -62,6 +70,9 @@ func resourceTencentCloudVpcIpv6EniAddress() *schema.Resource {
 		Read:   resourceTencentCloudVpcIpv6EniAddressRead,
 		Update: resourceTencentCloudVpcIpv6EniAddressUpdate,
 		Delete: resourceTencentCloudVpcIpv6EniAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
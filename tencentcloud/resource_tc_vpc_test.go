@@ -10,6 +10,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
 )
 
 func init() {
@@ -62,10 +64,89 @@ func testSweepVpcInstance(region string) error {
 	return nil
 }
 
+func TestUnitVpcServiceDescribeVpcs(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	service := VpcService{client: meta.apiV3Conn}
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+
+	for i := 0; i < 150; i++ {
+		_, _, err := service.CreateVpc(ctx, fmt.Sprintf("vpc-test-%d", i%2), "10.0.0.0/16", false, nil, map[string]string{"test": "unit"})
+		assert.Nil(t, err)
+	}
+
+	// the vpcs are listed in 2 pages of 100
+	infos, err := service.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+	assert.Nil(t, err)
+	assert.Len(t, infos, 150)
+	assert.Equal(t, 2, server.Calls("vpc", "DescribeVpcs"))
+
+	infos, err = service.DescribeVpcs(ctx, "", "vpc-test-1", map[string]string{"test": "unit"}, nil, "", "")
+	assert.Nil(t, err)
+	assert.Len(t, infos, 75)
+
+	// RequestLimitExceeded is retried by the client, and InternalError by the service
+	server.InjectFault("vpc", "DescribeVpcs", fakeapi.Fault{Code: "RequestLimitExceeded", Times: 2})
+	server.InjectFault("vpc", "DescribeVpcs", fakeapi.Fault{Code: InternalError, Times: 1})
+	calls := server.Calls("vpc", "DescribeVpcs")
+	info, has, err := service.DescribeVpc(ctx, infos[0].vpcId, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, has)
+	assert.Equal(t, infos[0].vpcId, info.vpcId)
+	assert.Equal(t, calls+4, server.Calls("vpc", "DescribeVpcs"))
+
+	server.InjectFault("vpc", "DescribeVpcs", fakeapi.Fault{Code: "AuthFailure.UnauthorizedOperation", Times: 1})
+	_, _, err = service.DescribeVpc(ctx, infos[0].vpcId, "", "")
+	assert.NotNil(t, err)
+
+	subnetId, err := service.CreateSubnet(ctx, infos[0].vpcId, "subnet-test", "10.0.1.0/24", "ap-guangzhou-3", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, service.DeleteSubnet(ctx, subnetId))
+	assert.Nil(t, service.DeleteVpc(ctx, infos[0].vpcId))
+
+	_, has, err = service.DescribeVpc(ctx, infos[0].vpcId, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, has)
+}
+
+func TestReplayFakeApiVpc(t *testing.T) {
+	meta := testReplayMeta(t)
+	r := resourceTencentCloudVpcInstance()
+
+	state, err := testResourceApply(r, nil, map[string]interface{}{
+		"name":       defaultInsName,
+		"cidr_block": defaultVpcCidr,
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.NotEmpty(t, state.ID)
+	assert.Equal(t, defaultVpcCidr, state.Attributes["cidr_block"])
+	assert.Equal(t, defaultInsName, state.Attributes["name"])
+	assert.Equal(t, "true", state.Attributes["is_multicast"])
+
+	state, err = testResourceApply(r, state, map[string]interface{}{
+		"name":         defaultInsNameUpdate,
+		"cidr_block":   defaultVpcCidr,
+		"is_multicast": false,
+	}, meta)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, defaultInsNameUpdate, state.Attributes["name"])
+	assert.Equal(t, "false", state.Attributes["is_multicast"])
+
+	assert.Nil(t, testResourceDestroy(r, state, meta))
+	state, err = testResourceRefresh(r, state, meta)
+	assert.Nil(t, err)
+	assert.Nil(t, state)
+}
+
 func TestAccTencentCloudVpcV3Basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckRecorder(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckVpcDestroy,
 		Steps: []resource.TestStep{
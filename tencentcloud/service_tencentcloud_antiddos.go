@@ -11,7 +11,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type AntiddosService struct {
@@ -34,7 +33,6 @@ func (me *AntiddosService) DescribeListBGPIPInstances(ctx context.Context, insta
 	request.Offset = &offsetInt64
 	limitInt64 := uint64(limit)
 	request.Limit = &limitInt64
-	ratelimit.Check(request.GetAction())
 	var response *antiddos.DescribeListBGPIPInstancesResponse
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		response, err = me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
@@ -185,7 +183,6 @@ func (me *AntiddosService) DescribeListBlackWhiteIpList(ctx context.Context, ins
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBlackWhiteIpList(request)
 		if e != nil {
 			err = e
@@ -212,7 +209,6 @@ func (me *AntiddosService) DescribeListPortAclList(ctx context.Context, instance
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListPortAclList(request)
 		if e != nil {
 			err = e
@@ -316,7 +312,6 @@ func (me *AntiddosService) DescribeListDDoSGeoIPBlockConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListDDoSGeoIPBlockConfig(request)
 		if e != nil {
 			err = e
@@ -342,7 +337,6 @@ func (me *AntiddosService) DescribeListDDoSSpeedLimitConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListDDoSSpeedLimitConfig(request)
 		if e != nil {
 			err = e
@@ -891,7 +885,6 @@ func (me *AntiddosService) DescribeCCThresholdList(ctx context.Context, business
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCThresholdList(request)
 		if e != nil {
 			err = e
@@ -946,7 +939,6 @@ func (me *AntiddosService) DescribeCcGeoIPBlockConfigList(ctx context.Context, b
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCcGeoIPBlockConfigList(request)
 		if e != nil {
 			err = e
@@ -1016,7 +1008,6 @@ func (me *AntiddosService) DescribeCcBlackWhiteIpList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCcBlackWhiteIpList(request)
 		if e != nil {
 			err = e
@@ -1094,7 +1085,6 @@ func (me *AntiddosService) DescribeCCPrecisionPlyList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCPrecisionPlyList(request)
 		if e != nil {
 			err = e
@@ -1188,7 +1178,6 @@ func (me *AntiddosService) DescribeCCReqLimitPolicyList(ctx context.Context, bus
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCReqLimitPolicyList(request)
 		if e != nil {
 			err = e
@@ -1255,7 +1244,6 @@ func (me *AntiddosService) DescribeCCLevelPolicy(ctx context.Context, domain, in
 	request.Ip = &ip
 	request.Protocol = &protocol
 
-	ratelimit.Check(request.GetAction())
 	response, e := me.client.UseAntiddosClient().DescribeCCLevelPolicy(request)
 	if e != nil {
 		err = e
@@ -1275,7 +1263,6 @@ func (me *AntiddosService) DescribeListBGPIPInstanceById(ctx context.Context, bu
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
 		if e != nil {
 			err = e
@@ -1302,7 +1289,6 @@ func (me *AntiddosService) DescribeListBGPInstanceById(ctx context.Context, busi
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
 		if e != nil {
 			err = e
@@ -1330,7 +1316,6 @@ func (me *AntiddosService) DescribeCCLevelList(ctx context.Context, business, in
 	request.Business = &business
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCLevelList(request)
 		if e != nil {
 			err = e
@@ -1405,8 +1390,6 @@ func (me *AntiddosService) DescribeAntiddosBoundipById(ctx context.Context, id s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
 	if err != nil {
 		errRet = err
@@ -11,7 +11,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type APIGatewayService struct {
@@ -21,7 +20,6 @@ type APIGatewayService struct {
 func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string) (accessKeyId string, errRet error) {
 	request := apigateway.NewCreateApiKeyRequest()
 	request.SecretName = &secretName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateApiKey(request)
 	if err != nil {
 		errRet = err
@@ -38,7 +36,6 @@ func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string
 func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewEnableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().EnableApiKey(request)
 	if err != nil {
 		errRet = err
@@ -58,7 +55,6 @@ func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId strin
 func (me *APIGatewayService) DisableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDisableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DisableApiKey(request)
 	if err != nil {
 		errRet = err
@@ -114,7 +110,6 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiKeysStatus(request)
 		if err != nil {
 			errRet = err
@@ -137,7 +132,6 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 func (me *APIGatewayService) DeleteApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDeleteApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApiKey(request)
 	if err != nil {
 		errRet = err
@@ -168,8 +162,6 @@ func (me *APIGatewayService) CreateUsagePlan(ctx context.Context, usagePlanName
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseAPIGatewayClient().CreateUsagePlan(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s API[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -189,8 +181,6 @@ func (me *APIGatewayService) DescribeUsagePlan(ctx context.Context, usagePlanId
 	request := apigateway.NewDescribeUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribeUsagePlan(request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.GetCode() == "ResourceNotFound.InvalidUsagePlan" {
@@ -212,8 +202,6 @@ func (me *APIGatewayService) DeleteUsagePlan(ctx context.Context, usagePlanId st
 	request := apigateway.NewDeleteUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DeleteUsagePlan(request)
 
 	if err != nil {
@@ -240,7 +228,6 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request := apigateway.NewModifyUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
 	request.UsagePlanName = &usagePlanName
 	if usagePlanDesc != nil {
 		request.UsagePlanDesc = usagePlanDesc
@@ -248,8 +235,6 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request.MaxRequestNum = &maxRequestNum
 	request.MaxRequestNumPreSec = &maxRequestNumPreSec
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().ModifyUsagePlan(request)
 	if err != nil {
 		errRet = err
@@ -276,7 +261,6 @@ func (me *APIGatewayService) DescribeUsagePlanEnvironments(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanEnvironments(request)
 		if err != nil {
 			errRet = err
@@ -321,7 +305,6 @@ func (me *APIGatewayService) DescribeUsagePlansStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlansStatus(request)
 		if err != nil {
 			errRet = err
@@ -357,7 +340,6 @@ func (me *APIGatewayService) DescribeIPStrategysStatus(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
 	if err != nil {
 		errRet = err
@@ -389,7 +371,6 @@ func (me *APIGatewayService) DescribeIPStrategies(ctx context.Context, serviceId
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
 		if err != nil {
 			errRet = err
@@ -423,7 +404,6 @@ func (me *APIGatewayService) DescribeServiceSubDomains(ctx context.Context, serv
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
 		if err != nil {
 			errRet = err
@@ -450,7 +430,6 @@ func (me *APIGatewayService) BindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
 
 	if err != nil {
@@ -475,7 +454,6 @@ func (me *APIGatewayService) BindSecretIds(ctx context.Context, usagePlanId stri
 		request.AccessKeyIds = append(request.AccessKeyIds, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
 
 	if err != nil {
@@ -510,7 +488,6 @@ func (me *APIGatewayService) UnBindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
 
 	if err != nil {
@@ -562,7 +539,6 @@ func (me *APIGatewayService) CreateService(ctx context.Context,
 	}
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateService(request)
 
 	if err != nil {
@@ -577,7 +553,6 @@ func (me *APIGatewayService) DescribeService(ctx context.Context, serviceId stri
 	request := apigateway.NewDescribeServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeService(request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
@@ -605,7 +580,6 @@ func (me *APIGatewayService) ModifyService(ctx context.Context,
 	request.ServiceDesc = &serviceDesc
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAPIGatewayClient().ModifyService(request)
 	if err != nil {
 		errRet = err
@@ -620,7 +594,6 @@ func (me *APIGatewayService) DeleteService(ctx context.Context,
 	request := apigateway.NewDeleteServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteService(request)
 	if err != nil {
 		errRet = err
@@ -642,7 +615,6 @@ func (me *APIGatewayService) UnReleaseService(ctx context.Context, serviceId, en
 	request.ServiceId = &serviceId
 	request.EnvironmentName = &environment
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnReleaseService(request)
 	if err != nil {
 		errRet = err
@@ -670,7 +642,6 @@ func (me *APIGatewayService) DescribeServiceUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceUsagePlan(request)
 		if err != nil {
 			errRet = err
@@ -702,7 +673,6 @@ func (me *APIGatewayService) DescribeApiUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlan(request)
 		if err != nil {
 			errRet = err
@@ -734,7 +704,6 @@ func (me *APIGatewayService) DescribeApiUsagePlanSecretIds(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanSecretIds(request)
 		if err != nil {
 			errRet = err
@@ -769,8 +738,6 @@ func (me *APIGatewayService) BindEnvironment(ctx context.Context,
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseAPIGatewayClient().BindEnvironment(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s API[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -806,8 +773,6 @@ func (me *APIGatewayService) UnBindSecretIds(ctx context.Context, usagePlanId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
 	if err != nil {
 		errRet = err
@@ -832,8 +797,6 @@ func (me *APIGatewayService) UnBindEnvironment(ctx context.Context,
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, errRet := me.client.UseAPIGatewayClient().UnBindEnvironment(request)
 		if errRet != nil {
 			return retryError(errRet)
@@ -861,8 +824,6 @@ func (me *APIGatewayService) DescribeApi(ctx context.Context,
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribeApi(request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE || sdkError.Code == API_ERR_CODE {
@@ -888,7 +849,6 @@ func (me *APIGatewayService) DeleteApi(ctx context.Context, serviceId,
 	request := apigateway.NewDeleteApiRequest()
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApi(request)
 	if err != nil {
 		errRet = err
@@ -933,7 +893,6 @@ func (me *APIGatewayService) DescribeServicesStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServicesStatus(request)
 		if err != nil {
 			errRet = err
@@ -981,7 +940,6 @@ func (me *APIGatewayService) DescribeApisStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApisStatus(request)
 		if err != nil {
 			errRet = err
@@ -1023,7 +981,6 @@ func (me *APIGatewayService) DescribeServiceEnvironmentStrategyList(ctx context.
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeServiceEnvironmentStrategy(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -1084,7 +1041,6 @@ func (me *APIGatewayService) DescribeApiEnvironmentStrategyList(ctx context.Cont
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeApiEnvironmentStrategy(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -1130,7 +1086,6 @@ func (me *APIGatewayService) ModifyApiEnvironmentStrategy(ctx context.Context,
 	request.ApiIds = append(request.ApiIds, helper.Strings(apiIDs)...)
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifyApiEnvironmentStrategy(request)
 		if err != nil {
 			return retryError(err)
@@ -1168,7 +1123,6 @@ func (me *APIGatewayService) ModifyServiceEnvironmentStrategy(ctx context.Contex
 	request.EnvironmentNames = append(request.EnvironmentNames, helper.Strings(environmentName)...)
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifyServiceEnvironmentStrategy(request)
 		if err != nil {
 			return retryError(err)
@@ -1219,7 +1173,6 @@ func (me *APIGatewayService) BindSubDomainService(ctx context.Context,
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseAPIGatewayClient().BindSubDomain(request)
 		if err != nil {
 			if ee, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -1255,7 +1208,6 @@ func (me *APIGatewayService) DescribeServiceSubDomainsService(ctx context.Contex
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -1291,7 +1243,6 @@ func (me *APIGatewayService) DescribeServiceSubDomainMappings(ctx context.Contex
 	request.SubDomain = &subDomain
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomainMappings(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1342,7 +1293,6 @@ func (me *APIGatewayService) ModifySubDomainService(ctx context.Context,
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifySubDomain(request)
 		if err != nil {
 			return retryError(err)
@@ -1377,7 +1327,6 @@ func (me *APIGatewayService) UnBindSubDomainService(ctx context.Context,
 	request.SubDomain = &subDomain
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().UnBindSubDomain(request)
 		if err != nil {
 			return retryError(err)
@@ -1408,7 +1357,6 @@ func (me *APIGatewayService) CreateIPStrategy(ctx context.Context,
 	request.StrategyType = &strategyType
 	request.StrategyData = &strategyData
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1428,7 +1376,6 @@ func (me *APIGatewayService) DescribeIPStrategyHas(ctx context.Context,
 	request := apigateway.NewDescribeIPStrategysStatusRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.Code == SERVICE_ERR_CODE {
@@ -1470,7 +1417,6 @@ func (me *APIGatewayService) DescribeIPStrategyStatus(ctx context.Context, servi
 		for {
 			request.Limit = &limit
 			request.Offset = &offset
-			ratelimit.Check(request.GetAction())
 			response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
 			if err != nil {
 				errRet = err
@@ -1500,7 +1446,6 @@ func (me *APIGatewayService) UpdateIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 	request.StrategyData = &strategyData
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().ModifyIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1522,7 +1467,6 @@ func (me *APIGatewayService) DeleteIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1548,7 +1492,6 @@ func (me *APIGatewayService) CreateStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.BindApiIds = bindarr
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1571,7 +1514,6 @@ func (me *APIGatewayService) DeleteStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.UnBindApiIds = unBindarr
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnBindIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1616,7 +1558,6 @@ func (me *APIGatewayService) ReleaseService(ctx context.Context,
 	request.ReleaseDesc = &releaseDesc
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ReleaseService(request)
 		if err != nil {
 			return retryError(err)
@@ -1643,7 +1584,6 @@ func (me *APIGatewayService) DescribeServiceEnvironmentReleaseHistory(ctx contex
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceEnvironmentReleaseHistory(request)
 		if err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
@@ -1680,8 +1620,6 @@ func (me *APIGatewayService) DescribeApiGatewayPluginById(ctx context.Context, p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribePlugins(request)
 	if err != nil {
 		errRet = err
@@ -1709,8 +1647,6 @@ func (me *APIGatewayService) DeleteApiGatewayPluginById(ctx context.Context, plu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DeletePlugin(request)
 	if err != nil {
 		errRet = err
@@ -1733,8 +1669,6 @@ func (me *APIGatewayService) DescribeApiGatewayPluginAttachmentById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribePluginApis(request)
 	if err != nil {
 		errRet = err
@@ -1770,8 +1704,6 @@ func (me *APIGatewayService) DeleteApiGatewayPluginAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DetachPlugin(request)
 	if err != nil {
 		errRet = err
@@ -1796,7 +1728,6 @@ func (me *APIGatewayService) DescribeApiDoc(ctx context.Context, apiDocId string
 	}()
 
 	request.ApiDocId = &apiDocId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeAPIDocDetail(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1834,7 +1765,6 @@ func (me *APIGatewayService) DescribeApiDocList(ctx context.Context) (apiDoc []*
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeAPIDocs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1872,7 +1802,6 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIDocById(ctx context.Context, api
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteAPIDoc(request)
 	if err != nil {
 		errRet = err
@@ -1904,7 +1833,6 @@ func (me *APIGatewayService) DescribeApiApp(ctx context.Context, apiAppId string
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1959,7 +1887,6 @@ func (me *APIGatewayService) DescribeApiAppList(ctx context.Context, apiAppId, a
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1994,7 +1921,6 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIAppById(ctx context.Context, api
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApiApp(request)
 	if err != nil {
 		errRet = err
@@ -2032,8 +1958,6 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppAttachmentById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribeApiAppBindApisStatus(request)
 	if err != nil {
 		errRet = err
@@ -2064,8 +1988,6 @@ func (me *APIGatewayService) DeleteAPIGatewayApiAppAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().UnbindApiApp(request)
 	if err != nil {
 		errRet = err
@@ -2096,8 +2018,6 @@ func (me *APIGatewayService) DescribeApigatewayUpstreamById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribeUpstreams(request)
 	if err != nil {
 		errRet = err
@@ -2126,8 +2046,6 @@ func (me *APIGatewayService) DeleteApigatewayUpstreamById(ctx context.Context, u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DeleteUpstream(request)
 	if err != nil {
 		errRet = err
@@ -2163,8 +2081,6 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -2215,8 +2131,6 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 100
@@ -2264,8 +2178,6 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -2318,8 +2230,6 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppServiceByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAPIGatewayClient().DescribeServiceForApiApp(request)
 	if err != nil {
 		errRet = err
@@ -11,7 +11,6 @@ import (
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type AuditService struct {
@@ -32,7 +31,6 @@ func (me *AuditService) DescribeAuditById(ctx context.Context, name string) (aud
 
 	var response *audit.DescribeAuditResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseAuditClient().DescribeAudit(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -63,7 +61,6 @@ func (me *AuditService) DescribeAuditCosRegions(ctx context.Context) (regions []
 	logId := getLogId(ctx)
 	request := audit.NewListCosEnableRegionRequest()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAuditClient().ListCosEnableRegion(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -82,7 +79,6 @@ func (me *AuditService) DescribeAuditCmqRegions(ctx context.Context) (regions []
 	logId := getLogId(ctx)
 	request := audit.NewListCmqEnableRegionRequest()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAuditClient().ListCmqEnableRegion(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -101,7 +97,6 @@ func (me *AuditService) DescribeKeyAlias(ctx context.Context, region string) (ke
 	logId := getLogId(ctx)
 	request := audit.NewListKeyAliasByRegionRequest()
 	request.KmsRegion = &region
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAuditClient().ListKeyAliasByRegion(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -157,7 +152,6 @@ func (me *AuditService) DeleteAuditTrackById(ctx context.Context, trackId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAuditClient().DeleteAuditTrack(request)
 	if err != nil {
 		errRet = err
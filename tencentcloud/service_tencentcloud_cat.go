@@ -7,7 +7,6 @@ import (
 	cat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cat/v20180409"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CatService struct {
@@ -28,7 +27,6 @@ func (me *CatService) DescribeCatTaskSet(ctx context.Context, taskId string) (ta
 	}()
 
 	request.TaskIDs = []*string{helper.String(taskId)}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -37,7 +35,6 @@ func (me *CatService) DescribeCatTaskSet(ctx context.Context, taskId string) (ta
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCatClient().DescribeProbeTasks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -79,7 +76,6 @@ func (me *CatService) DeleteCatTaskSetById(ctx context.Context, taskId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCatClient().DeleteProbeTask(request)
 	if err != nil {
 		errRet = err
@@ -127,7 +123,6 @@ func (me *CatService) DescribeCatNodeByFilter(ctx context.Context, param map[str
 
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCatClient().DescribeProbeNodes(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -222,7 +217,6 @@ func (me *CatService) DescribeCatProbeDataByFilter(ctx context.Context, param ma
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCatClient().DescribeDetailedSingleProbeData(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
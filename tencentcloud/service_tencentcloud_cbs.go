@@ -2,6 +2,7 @@ package tencentcloud
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sync"
 
@@ -9,7 +10,6 @@ import (
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CbsService struct {
@@ -53,7 +53,6 @@ func (me *CbsService) DescribeDiskList(ctx context.Context, diskIds []*string) (
 	request := cbs.NewDescribeDisksRequest()
 	request.DiskIds = diskIds
 	request.Limit = helper.IntUint64(100)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -93,7 +92,6 @@ func (me *CbsService) DescribeDisksByFilter(ctx context.Context, params map[stri
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeDisks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -179,7 +177,6 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 			request.Offset = helper.IntUint64(offset)
 			request.Limit = helper.IntUint64(limit)
 
-			ratelimit.Check(request.GetAction())
 			response, err := me.client.UseCbsClient().DescribeDisks(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -215,7 +212,6 @@ func (me *CbsService) ModifyDiskAttributes(ctx context.Context, diskId, diskName
 	if projectId >= 0 {
 		request.ProjectId = helper.IntUint64(projectId)
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskAttributes(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -238,7 +234,6 @@ func (me *CbsService) DeleteDiskSetByIds(ctx context.Context, diskSetIds string)
 	}
 
 	request.DiskIds = helper.StringsStringsPoint(diskSet)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -254,7 +249,6 @@ func (me *CbsService) DeleteDiskById(ctx context.Context, diskId string) error {
 	logId := getLogId(ctx)
 	request := cbs.NewTerminateDisksRequest()
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -271,7 +265,6 @@ func (me *CbsService) ResizeDisk(ctx context.Context, diskId string, diskSize in
 	request := cbs.NewResizeDiskRequest()
 	request.DiskId = &diskId
 	request.DiskSize = helper.IntUint64(diskSize)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ResizeDisk(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -288,7 +281,6 @@ func (me *CbsService) ModifyThroughputPerformance(ctx context.Context, diskId st
 	request := cbs.NewModifyDiskExtraPerformanceRequest()
 	request.DiskId = &diskId
 	request.ThroughputPerformance = helper.IntUint64(throughputPerformance)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskExtraPerformance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -305,7 +297,6 @@ func (me *CbsService) ApplySnapshot(ctx context.Context, diskId, snapshotId stri
 	request := cbs.NewApplySnapshotRequest()
 	request.DiskId = &diskId
 	request.SnapshotId = &snapshotId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ApplySnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -322,7 +313,6 @@ func (me *CbsService) AttachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewAttachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().AttachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -339,7 +329,6 @@ func (me *CbsService) DetachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewDetachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DetachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -365,7 +354,6 @@ func (me *CbsService) CreateSnapshot(ctx context.Context, diskId, snapshotName s
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().CreateSnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -384,7 +372,6 @@ func (me *CbsService) DescribeSnapshotById(ctx context.Context, snapshotId strin
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -420,7 +407,6 @@ func (me *CbsService) DescribeSnapshotByIds(ctx context.Context, snapshotIdsPara
 		request.Limit = &pageSize
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCbsClient().DescribeSnapshots(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -461,7 +447,6 @@ func (me *CbsService) DescribeSnapshotsByFilter(ctx context.Context, params map[
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -491,7 +476,6 @@ func (me *CbsService) ModifySnapshotName(ctx context.Context, snapshotId, snapsh
 	request := cbs.NewModifySnapshotAttributeRequest()
 	request.SnapshotId = &snapshotId
 	request.SnapshotName = &snapshotName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifySnapshotAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -507,7 +491,6 @@ func (me *CbsService) DeleteSnapshot(ctx context.Context, snapshotId string) err
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -523,7 +506,6 @@ func (me *CbsService) DescribeSnapshotPolicyById(ctx context.Context, policyId s
 	logId := getLogId(contextNil)
 	request := cbs.NewDescribeAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -558,7 +540,6 @@ func (me *CbsService) DescribeSnapshotPolicy(ctx context.Context, policyId, poli
 		}
 		request.Filters = append(request.Filters, &filter)
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -574,7 +555,6 @@ func (me *CbsService) DeleteSnapshotPolicy(ctx context.Context, policyId string)
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -591,7 +571,6 @@ func (me *CbsService) AttachSnapshotPolicy(ctx context.Context, diskId, policyId
 	request := cbs.NewBindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().BindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -605,7 +584,6 @@ func (me *CbsService) DescribeAttachedSnapshotPolicy(ctx context.Context, diskId
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeDiskAssociatedAutoSnapshotPolicyRequest()
 	request.DiskId = &diskId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicy(request)
 	if err != nil {
 		errRet = err
@@ -627,7 +605,6 @@ func (me *CbsService) UnattachSnapshotPolicy(ctx context.Context, diskId, policy
 	request := cbs.NewUnbindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -642,7 +619,6 @@ func (me *CbsService) ModifyDiskChargeType(ctx context.Context, storageId string
 	request := cbs.NewModifyDisksChargeTypeRequest()
 	request.DiskIds = []*string{&storageId}
 	request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period), RenewFlag: &renewFlag}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -658,7 +634,6 @@ func (me *CbsService) ModifyDisksRenewFlag(ctx context.Context, storageId string
 	request.DiskIds = []*string{&storageId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksRenewFlag(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -680,8 +655,6 @@ func (me *CbsService) DescribeCbsDiskBackupById(ctx context.Context, diskBackupI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DescribeDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -709,8 +682,6 @@ func (me *CbsService) DeleteCbsDiskBackupById(ctx context.Context, diskBackupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DeleteDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -733,8 +704,6 @@ func (me *CbsService) ModifyDiskBackupQuota(ctx context.Context, diskId string,
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupQuota = helper.IntUint64(diskBackupQuota)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().ModifyDiskBackupQuota(request)
 	if err != nil {
 		errRet = err
@@ -757,7 +726,6 @@ func (me *CbsService) CreateDiskBackup(ctx context.Context, diskId, diskBackupNa
 	request.DiskBackupName = helper.String(diskBackupName)
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseCbsClient().CreateDiskBackup(request)
 		if e != nil {
 			return retryError(e)
@@ -787,8 +755,6 @@ func (me *CbsService) DescribeCbsSnapshotSharePermissionById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DescribeSnapshotSharePermission(request)
 	if err != nil {
 		errRet = err
@@ -812,7 +778,6 @@ func (me *CbsService) ModifySnapshotsSharePermission(ctx context.Context, snapsh
 	request.SnapshotIds = []*string{&snapshotId}
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ModifySnapshotsSharePermission(request)
@@ -841,7 +806,6 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 	}()
 	request.DiskBackupId = helper.String(diskBackupId)
 	request.DiskId = helper.String(diskId)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ApplyDiskBackup(request)
@@ -858,3 +822,23 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 	}
 	return
 }
+
+func (me *CbsService) InquiryPriceCreateDisks(ctx context.Context, request *cbs.InquiryPriceCreateDisksRequest) (price *cbs.Price, errRet error) {
+	logId := getLogId(ctx)
+	response, err := me.client.UseCbsClient().InquiryPriceCreateDisksWithContext(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.DiskPrice == nil {
+		errRet = fmt.Errorf("api[%s] returns no price", request.GetAction())
+		return
+	}
+	price = response.Response.DiskPrice
+	return
+}
@@ -8,7 +8,6 @@ import (
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 //Ccn basic information
@@ -89,7 +88,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcns(request)
 
 	if err != nil {
@@ -155,7 +153,6 @@ func (me *VpcService) DescribeCcnRegionBandwidthLimits(ctx context.Context, ccnI
 	infos = make([]CcnBandwidthLimit, 0, 100)
 
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnRegionBandwidthLimits(request)
 
 	defer func() {
@@ -204,7 +201,6 @@ func (me *VpcService) CreateCcn(ctx context.Context, name, description,
 	request.QosLevel = &qos
 	request.InstanceChargeType = &chargeType
 	request.BandwidthLimitType = &bandWithLimitType
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateCcn(request)
 
 	defer func() {
@@ -254,7 +250,6 @@ func (me *VpcService) DeleteCcn(ctx context.Context, ccnId string) (errRet error
 	logId := getLogId(ctx)
 	request := vpc.NewDeleteCcnRequest()
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteCcn(request)
 
 	defer func() {
@@ -296,7 +291,6 @@ func (me *VpcService) ModifyCcnAttribute(ctx context.Context, ccnId, name, descr
 	if description != "" {
 		request.CcnDescription = &description
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyCcnAttribute(request)
 
 	defer func() {
@@ -353,7 +347,6 @@ func (me *VpcService) DescribeCcnAttachedInstances(ctx context.Context, ccnId st
 	logId := getLogId(ctx)
 	request := vpc.NewDescribeCcnAttachedInstancesRequest()
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstances(request)
 
 	defer func() {
@@ -414,7 +407,6 @@ func (me *VpcService) DescribeCcnAttachmentsByInstance(ctx context.Context, inst
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-id"), Values: []*string{&instanceId}})
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-region"), Values: []*string{&instanceRegion}})
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstances(request)
 
 	defer func() {
@@ -469,7 +461,6 @@ func (me *VpcService) AttachCcnInstances(ctx context.Context, ccnId, instanceReg
 	}
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().AttachCcnInstances(request)
 
 	defer func() {
@@ -511,7 +502,6 @@ func (me *VpcService) DetachCcnInstances(ctx context.Context, ccnId, instanceReg
 	ccnInstance.InstanceType = &instanceType
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DetachCcnInstances(request)
 
 	defer func() {
@@ -597,7 +587,6 @@ func (me *VpcService) GetCcnRegionBandwidthLimits(ctx context.Context,
 	request.Limit = &limit
 	request.Offset = &offset
 
-	ratelimit.Check(request.GetAction())
 	for {
 		response, err = me.client.UseVpcClient().GetCcnRegionBandwidthLimits(request)
 		if err != nil {
@@ -638,7 +627,6 @@ func (me *VpcService) SetCcnRegionBandwidthLimits(ctx context.Context, ccnId, re
 	}
 
 	request.CcnRegionBandwidthLimits = []*vpc.CcnRegionBandwidthLimit{&ccnRegionBandwidthLimit}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().SetCcnRegionBandwidthLimits(request)
 
 	defer func() {
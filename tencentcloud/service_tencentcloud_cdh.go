@@ -8,7 +8,6 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CdhService struct {
@@ -24,7 +23,6 @@ func (me *CdhService) DescribeCdhInstanceById(ctx context.Context, hostId string
 	}
 	request.Filters = []*cvm.Filter{&filter}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeHosts(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -59,7 +57,6 @@ func (me *CdhService) DescribeCdhInstanceByFilter(ctx context.Context, filters m
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeHosts(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -90,7 +87,6 @@ func (me *CdhService) CreateCdhInstance(ctx context.Context, placement *cvm.Plac
 	request.HostChargeType = helper.String(hostChargeType)
 	request.HostType = helper.String(hostType)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().AllocateHosts(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -115,7 +111,6 @@ func (me *CdhService) ModifyHostName(ctx context.Context, hostId, hostName strin
 	request.HostIds = []*string{helper.String(hostId)}
 	request.HostName = helper.String(hostName)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -135,7 +130,6 @@ func (me *CdhService) ModifyProject(ctx context.Context, hostId string, projectI
 	request.HostIds = []*string{helper.String(hostId)}
 	request.ProjectId = helper.IntUint64(projectId)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -155,7 +149,6 @@ func (me *CdhService) ModifyPrepaidRenewFlag(ctx context.Context, hostId, renewF
 	request.HostIds = []*string{helper.String(hostId)}
 	request.RenewFlag = helper.String(renewFlag)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
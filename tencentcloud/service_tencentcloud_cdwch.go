@@ -11,7 +11,6 @@ import (
 	cdwch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CdwchService struct {
@@ -31,8 +30,6 @@ func (me *CdwchService) DescribeInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstance(request)
 	if err != nil {
 		errRet = err
@@ -57,8 +54,6 @@ func (me *CdwchService) DestroyInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DestroyInstance(request)
 	if err != nil {
 		errRet = err
@@ -84,7 +79,6 @@ func (me *CdwchService) ResizeDisk(ctx context.Context, instanceId string, nodeT
 	request.InstanceId = &instanceId
 	request.Type = &nodeType
 	request.DiskSize = helper.IntInt64(resizeDisk)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ResizeDisk(request)
 	if err != nil {
@@ -113,7 +107,6 @@ func (me *CdwchService) ScaleUpInstance(ctx context.Context, instanceId, nodeTyp
 	request.ScaleUpEnableRolling = helper.Bool(true)
 	request.Type = &nodeType
 	request.SpecName = &specName
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ScaleUpInstance(request)
 	if err != nil {
@@ -146,7 +139,6 @@ func (me *CdwchService) ScaleOutInstance(ctx context.Context, instanceId string,
 	if shardIps != nil {
 		request.ReduceShardInfo = shardIps
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ScaleOutInstance(request)
 	if err != nil {
@@ -172,8 +164,6 @@ func (me *CdwchService) DescribeInstanceClusters(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstanceClusters(request)
 	if err != nil {
 		errRet = err
@@ -198,8 +188,6 @@ func (me *CdwchService) DescribeInstancesNew(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstancesNew(request)
 	if err != nil {
 		errRet = err
@@ -224,8 +212,6 @@ func (me *CdwchService) DescribeBackUpScheduleById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeBackUpSchedule(request)
 	if err != nil {
 		errRet = err
@@ -298,7 +284,6 @@ func (me *CdwchService) CreateBackUpSchedule(ctx context.Context, instanceId str
 	}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseCdwchClient().CreateBackUpSchedule(request)
 		if e != nil {
 			return retryError(e)
@@ -339,8 +324,6 @@ func (me *CdwchService) DescribeClickhouseBackupJobsByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -184,7 +184,7 @@ func (me *CiService) CloseCiOriginalImageProtectionById(ctx context.Context, buc
 		}
 	}()
 
-	_, err := RetryWithContext(ctx, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := RetryWithContext(ctx, me.client.Retryer, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.CloseOriginProtect(ctx)
 	})
 
@@ -206,7 +206,7 @@ func (me *CiService) OpenCiOriginalImageProtectionById(ctx context.Context, buck
 		}
 	}()
 
-	_, err := RetryWithContext(ctx, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := RetryWithContext(ctx, me.client.Retryer, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.OpenOriginProtect(ctx)
 	})
 
@@ -229,7 +229,7 @@ func (me *CiService) GetCiOriginalImageProtectionById(ctx context.Context, bucke
 		}
 	}()
 
-	resRaw, err := RetryWithContext(ctx, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := RetryWithContext(ctx, me.client.Retryer, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UsePicClient(bucket).CI.GetOriginProtect(ctx)
 		return res, err
 	})
@@ -252,7 +252,7 @@ func (me *CiService) CloseCiGuetzliById(ctx context.Context, bucket string) (err
 		}
 	}()
 
-	_, err := RetryWithContext(ctx, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := RetryWithContext(ctx, me.client.Retryer, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.DeleteGuetzli(ctx)
 	})
 
@@ -274,7 +274,7 @@ func (me *CiService) OpenCiGuetzliById(ctx context.Context, bucket string) (errR
 		}
 	}()
 
-	_, err := RetryWithContext(ctx, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := RetryWithContext(ctx, me.client.Retryer, writeRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.PutGuetzli(ctx)
 	})
 
@@ -297,7 +297,7 @@ func (me *CiService) GetCiGuetzliById(ctx context.Context, bucket string) (*cos.
 		}
 	}()
 
-	resRaw, err := RetryWithContext(ctx, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := RetryWithContext(ctx, me.client.Retryer, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UsePicClient(bucket).CI.GetGuetzli(ctx)
 		return res, err
 	})
@@ -8,7 +8,6 @@ import (
 
 	ciam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ciam/v20220331"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CiamService struct {
@@ -34,8 +33,6 @@ func (me *CiamService) DescribeCiamUserGroupById(ctx context.Context, userStoreI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 1
 		limit  int64 = 20
@@ -86,8 +83,6 @@ func (me *CiamService) DeleteCiamUserGroupById(ctx context.Context, userStoreId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCiamClient().DeleteUserGroups(request)
 	if err != nil {
 		errRet = err
@@ -109,8 +104,6 @@ func (me *CiamService) DescribeCiamUserStoreById(ctx context.Context, userPoolId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCiamClient().ListUserStore(request)
 	if err != nil {
 		errRet = err
@@ -143,8 +136,6 @@ func (me *CiamService) DeleteCiamUserStoreById(ctx context.Context, userPoolId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCiamClient().DeleteUserStore(request)
 	if err != nil {
 		errRet = err
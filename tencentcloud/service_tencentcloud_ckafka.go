@@ -12,7 +12,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CkafkaService struct {
@@ -59,7 +58,6 @@ func (me *CkafkaService) CheckCkafkaInstanceReady(ctx context.Context,
 func (me *CkafkaService) ModifyCkafkaInstanceAttributes(ctx context.Context,
 	request *ckafka.ModifyInstanceAttributesRequest) (errRet error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCkafkaClient().ModifyInstanceAttributes(request)
 	if err != nil {
 		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
@@ -274,7 +272,6 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 		var response *ckafka.DescribeUserResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeUser(request)
 			if err != nil {
 				return retryError(err)
@@ -358,7 +355,6 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 		var response *ckafka.DescribeACLResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeACL(request)
 			if err != nil {
 				return retryError(err)
@@ -458,7 +454,6 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 	var response *ckafka.DescribeInstanceAttributesResponse
 	var err error
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeInstanceAttributes(request)
 		if err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -501,7 +496,6 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 	var response *ckafka.DescribeTopicAttributesResponse
 	var err error
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeTopicAttributes(request)
 		if err != nil {
 			return retryError(err)
@@ -548,7 +542,6 @@ func (me *CkafkaService) DescribeCkafkaTopics(ctx context.Context, instanceId st
 		return
 	}
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCkafkaClient().DescribeTopicDetail(request)
 		if err != nil {
 			errRet = err
@@ -576,7 +569,6 @@ func (me *CkafkaService) CreateCkafkaTopic(ctx context.Context, request *ckafka.
 	}()
 	var response *ckafka.CreateTopicResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreateTopic(request)
 		if e != nil {
 			return retryError(e)
@@ -630,7 +622,6 @@ func (me *CkafkaService) DescribeCkafkaTopicAttributes(ctx context.Context, inst
 	request.InstanceId = &instanceId
 	request.TopicName = &topicName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCkafkaClient().DescribeTopicAttributes(request)
 	if err != nil {
 		errRet = err
@@ -658,7 +649,6 @@ func (me *CkafkaService) AddCkafkaTopicIpWhiteList(ctx context.Context, instance
 	request.IpWhiteList = whiteIpList
 	var response *ckafka.CreateTopicIpWhiteListResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreateTopicIpWhiteList(request)
 		if e != nil {
 			return retryError(e)
@@ -689,7 +679,6 @@ func (me *CkafkaService) AddCkafkaTopicPartition(ctx context.Context, instanceId
 	request.PartitionNum = &partitionNum
 	var response *ckafka.CreatePartitionResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreatePartition(request)
 		if e != nil {
 			return retryError(e)
@@ -718,7 +707,6 @@ func (me *CkafkaService) RemoveCkafkaTopicIpWhiteList(ctx context.Context, insta
 	request.TopicName = &topicName
 	request.InstanceId = &instaneId
 	request.IpWhiteList = whiteIpList
-	ratelimit.Check(request.GetAction())
 	var response *ckafka.DeleteTopicIpWhiteListResponse
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		resp, e := me.client.UseCkafkaClient().DeleteTopicIpWhiteList(request)
@@ -747,7 +735,6 @@ func (me *CkafkaService) DescribeCkafkaById(ctx context.Context, instanceId stri
 	}()
 
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 	resp, err := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
 	if err != nil {
 		has = false
@@ -771,7 +758,6 @@ func (me *CkafkaService) ModifyCkafkaTopicAttribute(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, errRet := me.client.UseCkafkaClient().ModifyTopicAttributes(request)
 	if errRet != nil {
 		return errRet
@@ -793,7 +779,6 @@ func (me *CkafkaService) DeleteCkafkaTopic(ctx context.Context, instanceId strin
 	request.InstanceId = &instanceId
 	request.TopicName = &name
 
-	ratelimit.Check(request.GetAction())
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		_, err := me.client.UseCkafkaClient().DeleteTopic(request)
 		if err != nil {
@@ -834,8 +819,6 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicById(ctx context.Context, top
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTopic(request)
 	if err != nil {
 		errRet = err
@@ -863,8 +846,6 @@ func (me *CkafkaService) DeleteCkafkaDatahubTopicById(ctx context.Context, topic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteDatahubTopic(request)
 	if err != nil {
 		errRet = err
@@ -887,8 +868,6 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeConnectResource(request)
 	if err != nil {
 		errRet = err
@@ -916,8 +895,6 @@ func (me *CkafkaService) DeleteCkafkaConnectResourceById(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteConnectResource(request)
 	if err != nil {
 		errRet = err
@@ -975,8 +952,6 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceByFilter(ctx context.Conte
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeConnectResources(request)
 	if err != nil {
 		errRet = err
@@ -1019,7 +994,6 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicByFilter(ctx context.Context,
 	}
 	request.Limit = helper.IntUint64(limit)
 	request.Offset = helper.IntUint64(offset)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTopics(request)
 	if err != nil {
 		errRet = err
@@ -1060,8 +1034,6 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1120,8 +1092,6 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1171,8 +1141,6 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1228,8 +1196,6 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1279,8 +1245,6 @@ func (me *CkafkaService) DescribeCkafkaGroupInfoByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeGroupInfo(request)
 	if err != nil {
 		errRet = err
@@ -1311,8 +1275,6 @@ func (me *CkafkaService) DescribeCkafkaTaskStatusByFilter(ctx context.Context, f
 
 	request.FlowId = helper.IntInt64(flowId)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeTaskStatus(request)
 	if err != nil {
 		errRet = err
@@ -1356,8 +1318,6 @@ func (me *CkafkaService) DescribeCkafkaTopicFlowRankingByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeTopicFlowRanking(request)
 	if err != nil {
 		errRet = err
@@ -1395,8 +1355,6 @@ func (me *CkafkaService) DescribeCkafkaTopicProduceConnectionByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeTopicProduceConnection(request)
 	if err != nil {
 		errRet = err
@@ -1434,8 +1392,6 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1488,8 +1444,6 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  int64  = 20
@@ -1531,8 +1485,6 @@ func (me *CkafkaService) DescribeCkafkaAclRuleById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeAclRule(request)
 	if err != nil {
 		errRet = err
@@ -1561,8 +1513,6 @@ func (me *CkafkaService) DeleteCkafkaAclRuleById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteAclRule(request)
 	if err != nil {
 		errRet = err
@@ -1586,8 +1536,6 @@ func (me *CkafkaService) DescribeCkafkaConsumerGroupById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeConsumerGroup(request)
 	if err != nil {
 		errRet = err
@@ -1616,8 +1564,6 @@ func (me *CkafkaService) DeleteCkafkaConsumerGroupById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteGroup(request)
 	if err != nil {
 		errRet = err
@@ -1640,8 +1586,6 @@ func (me *CkafkaService) DeleteCkafkaDatahubTaskById(ctx context.Context, taskId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteDatahubTask(request)
 	if err != nil {
 		errRet = err
@@ -1664,8 +1608,6 @@ func (me *CkafkaService) DescribeDatahubTask(ctx context.Context, taskId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTask(request)
 	if err != nil {
 		errRet = err
@@ -1712,8 +1654,6 @@ func (me *CkafkaService) DescribeCkafkaCkafkaZoneByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeCkafkaZone(request)
 	if err != nil {
 		errRet = err
@@ -1743,8 +1683,6 @@ func (me *CkafkaService) DescribeCkafkaRouteById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DescribeRoute(request)
 	if err != nil {
 		errRet = err
@@ -1773,8 +1711,6 @@ func (me *CkafkaService) DeleteCkafkaRouteById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCkafkaClient().DeleteRoute(request)
 	if err != nil {
 		errRet = err
@@ -8,7 +8,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ClsService struct {
@@ -36,7 +35,6 @@ func (me *ClsService) DescribeClsLogset(ctx context.Context, logsetId string) (l
 			Values: []*string{&logsetId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -45,7 +43,6 @@ func (me *ClsService) DescribeClsLogset(ctx context.Context, logsetId string) (l
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeLogsets(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -88,7 +85,6 @@ func (me *ClsService) DeleteClsLogsetById(ctx context.Context, logsetId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteLogset(request)
 	if err != nil {
 		errRet = err
@@ -128,7 +124,6 @@ func (me *ClsService) DescribeClsLogsetByFilter(ctx context.Context, filters map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeLogsets(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -180,7 +175,6 @@ func (me *ClsService) DescribeClsTopicByFilter(ctx context.Context, filters map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -222,7 +216,6 @@ func (me *ClsService) DescribeClsTopicById(ctx context.Context, topicId string)
 			Values: []*string{&topicId},
 		},
 	}
-	ratelimit.Check(request.GetAction())
 	var (
 		offset    int64 = 0
 		pageSize  int64 = 100
@@ -232,7 +225,6 @@ func (me *ClsService) DescribeClsTopicById(ctx context.Context, topicId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -273,7 +265,6 @@ func (me *ClsService) DeleteClsTopic(ctx context.Context, id string) (errRet err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteTopic(request)
 	if err != nil {
 		errRet = err
@@ -314,7 +305,6 @@ func (me *ClsService) DescribeClsMachineGroupByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeMachineGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -363,7 +353,6 @@ func (me *ClsService) DescribeClsMachineGroupById(ctx context.Context, id string
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeMachineGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -404,7 +393,6 @@ func (me *ClsService) DeleteClsMachineGroup(ctx context.Context, id string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteMachineGroup(request)
 	if err != nil {
 		errRet = err
@@ -481,7 +469,6 @@ func (me *ClsService) DescribeClsCosShippersByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeShippers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -530,7 +517,6 @@ func (me *ClsService) DescribeClsCosShipperById(ctx context.Context, shipperId s
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClsClient().DescribeShippers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -571,7 +557,6 @@ func (me *ClsService) DeleteClsCosShipper(ctx context.Context, id string) (errRe
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteShipper(request)
 	if err != nil {
 		errRet = err
@@ -601,8 +586,6 @@ func (me *ClsService) DescribeClsConfigById(ctx context.Context, configId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -649,7 +632,6 @@ func (me *ClsService) DeleteClsConfig(ctx context.Context, id string) (errRet er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteConfig(request)
 	if err != nil {
 		errRet = err
@@ -679,8 +661,6 @@ func (me *ClsService) DescribeClsConfigExtraById(ctx context.Context, configExtr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -727,7 +707,6 @@ func (me *ClsService) DeleteClsConfigExtra(ctx context.Context, id string) (errR
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteConfigExtra(request)
 	if err != nil {
 		errRet = err
@@ -753,7 +732,6 @@ func (me *ClsService) DeleteClsIndex(ctx context.Context, id string) (errRet err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().DeleteIndex(request)
 	if err != nil {
 		errRet = err
@@ -781,8 +759,6 @@ func (me *ClsService) DescribeClsAlarmById(ctx context.Context, alarmId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -828,8 +804,6 @@ func (me *ClsService) DeleteClsAlarmById(ctx context.Context, alarmId string) (e
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteAlarm(request)
 	if err != nil {
 		errRet = err
@@ -856,8 +830,6 @@ func (me *ClsService) DescribeClsAlarmNoticeById(ctx context.Context, alarmNotic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -903,8 +875,6 @@ func (me *ClsService) DeleteClsAlarmNoticeById(ctx context.Context, alarmNoticeI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteAlarmNotice(request)
 	if err != nil {
 		errRet = err
@@ -927,8 +897,6 @@ func (me *ClsService) DescribeClsCkafkaConsumerById(ctx context.Context, topicId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeConsumer(request)
 	if err != nil {
 		errRet = err
@@ -952,8 +920,6 @@ func (me *ClsService) DeleteClsCkafkaConsumerById(ctx context.Context, topicId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteConsumer(request)
 	if err != nil {
 		errRet = err
@@ -976,8 +942,6 @@ func (me *ClsService) DescribeClsCosRechargeById(ctx context.Context, topicId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeCosRecharges(request)
 	if err != nil {
 		errRet = err
@@ -1006,8 +970,6 @@ func (me *ClsService) DescribeClsExportById(ctx context.Context, topicId string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeExports(request)
 	if err != nil {
 		errRet = err
@@ -1037,8 +999,6 @@ func (me *ClsService) DeleteClsExportById(ctx context.Context, exportId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteExport(request)
 	if err != nil {
 		errRet = err
@@ -1073,8 +1033,6 @@ func (me *ClsService) DescribeClsShipperTasksByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeShipperTasks(request)
 	if err != nil {
 		errRet = err
@@ -1105,8 +1063,6 @@ func (me *ClsService) DescribeClsMachinesByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeMachines(request)
 	if err != nil {
 		errRet = err
@@ -1136,8 +1092,6 @@ func (me *ClsService) DescribeClsMachineGroupConfigsByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeMachineGroupConfigs(request)
 	if err != nil {
 		errRet = err
@@ -1161,8 +1115,6 @@ func (me *ClsService) DescribeClsDataTransformById(ctx context.Context, taskId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeDataTransformInfo(request)
 	if err != nil {
 		errRet = err
@@ -1190,8 +1142,6 @@ func (me *ClsService) DeleteClsDataTransformById(ctx context.Context, taskId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteDataTransform(request)
 	if err != nil {
 		errRet = err
@@ -1213,8 +1163,6 @@ func (me *ClsService) DescribeClsKafkaRechargeById(ctx context.Context, id strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeKafkaRecharges(request)
 	if err != nil {
 		errRet = err
@@ -1242,8 +1190,6 @@ func (me *ClsService) DeleteClsKafkaRechargeById(ctx context.Context, id string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteKafkaRecharge(request)
 	if err != nil {
 		errRet = err
@@ -1266,8 +1212,6 @@ func (me *ClsService) DescribeClsScheduledSqlById(ctx context.Context, taskId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DescribeScheduledSqlInfo(request)
 	if err != nil {
 		errRet = err
@@ -1295,8 +1239,6 @@ func (me *ClsService) DeleteClsScheduledSqlById(ctx context.Context, taskId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClsClient().DeleteScheduledSql(request)
 	if err != nil {
 		errRet = err
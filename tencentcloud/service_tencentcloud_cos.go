@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CosService struct {
@@ -47,7 +46,6 @@ func (me *CosService) HeadObject(ctx context.Context, bucket, key string) (info
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
-	ratelimit.Check("HeadObject")
 	response, err := me.client.UseCosClient().HeadObject(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -75,7 +73,6 @@ func (me *CosService) DeleteObject(ctx context.Context, bucket, key string) (err
 				logId, "delete object", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("DeleteObject")
 	response, err := me.client.UseCosClient().DeleteObject(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos delete object error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
@@ -102,7 +99,6 @@ func (me *CosService) PutObjectAcl(ctx context.Context, bucket, key, acl string)
 				logId, "put object acl", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutObjectAcl")
 	response, err := me.client.UseCosClient().PutObjectAcl(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put object acl error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
@@ -129,7 +125,6 @@ func (me *CosService) PutBucket(ctx context.Context, bucket, acl string) (errRet
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("CreateBucket")
 	response, err := me.client.UseCosClient().CreateBucket(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put bucket error: %s, bucket: %s", err.Error(), bucket)
@@ -155,7 +150,6 @@ func (me *CosService) TencentCosPutBucket(ctx context.Context, bucket string, op
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.Put(ctx, opt)
 
 	if err != nil {
@@ -183,7 +177,6 @@ func (me *CosService) TencentCosBucketGetLocation(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
 	result, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetLocation(ctx)
 	if err != nil {
 		errRet = fmt.Errorf("cos get location error: %s, bucket: %s", err.Error(), bucket)
@@ -232,7 +225,6 @@ func (me *CosService) TencentCosPutBucketACLBody(
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACLBody")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutACL(ctx, opt)
 
 	if err != nil {
@@ -254,7 +246,6 @@ func (me *CosService) HeadBucket(ctx context.Context, bucket string) (errRet err
 	request := s3.HeadBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("HeadBucket")
 	response, err := me.client.UseCosClient().HeadBucket(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -307,7 +298,6 @@ func (me *CosService) DeleteBucket(ctx context.Context, bucket string, forced bo
 	request := s3.DeleteBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucket")
 	response, err := me.client.UseCosClient().DeleteBucket(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -431,7 +421,6 @@ func (me *CosService) GetBucketCors(ctx context.Context, bucket string) (corsRul
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketCors")
 	response, err := me.client.UseCosClient().GetBucketCors(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -474,7 +463,6 @@ func (me *CosService) GetBucketLifecycle(ctx context.Context, bucket string) (li
 	request := s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketLifecycleConfiguration")
 	response, err := me.client.UseCosClient().GetBucketLifecycleConfiguration(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -578,7 +566,6 @@ func (me *CosService) GetDataSourceBucketLifecycle(ctx context.Context, bucket s
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketLifecycleConfiguration")
 	response, err := me.client.UseCosClient().GetBucketLifecycleConfiguration(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -673,7 +660,6 @@ func (me *CosService) GetBucketWebsite(ctx context.Context, bucket string) (webs
 	request := s3.GetBucketWebsiteInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketWebsite")
 	response, err := me.client.UseCosClient().GetBucketWebsite(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -710,7 +696,6 @@ func (me *CosService) GetBucketEncryption(ctx context.Context, bucket string) (e
 	request := s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketEncryption")
 	response, err := me.client.UseCosClient().GetBucketEncryption(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -738,7 +723,6 @@ func (me *CosService) GetBucketVersioning(ctx context.Context, bucket string) (v
 	request := s3.GetBucketVersioningInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
 	response, err := me.client.UseCosClient().GetBucketVersioning(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -769,7 +753,6 @@ func (me *CosService) GetBucketAccleration(ctx context.Context, bucket string) (
 	request := s3.GetBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketAccelerateConfiguration")
 	response, err := me.client.UseCosClient().GetBucketAccelerateConfiguration(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -800,7 +783,6 @@ func (me *CosService) GetBucketLogStatus(ctx context.Context, bucket string) (lo
 	request := s3.GetBucketLoggingInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
 	response, err := me.client.UseCosClient().GetBucketLogging(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -827,7 +809,6 @@ func (me *CosService) ListBuckets(ctx context.Context) (buckets []*s3.Bucket, er
 	logId := getLogId(ctx)
 
 	request := s3.ListBucketsInput{}
-	ratelimit.Check("ListBuckets")
 	response, err := me.client.UseCosClient().ListBuckets(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -848,7 +829,6 @@ func (me *CosService) ListObjects(ctx context.Context, bucket string) (objects [
 	request := s3.ListObjectsInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("ListObjects")
 	response, err := me.client.UseCosClient().ListObjects(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -869,8 +849,6 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 
 	deleteReq := &s3.DeleteBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("DeleteBucketTagging")
-
 	deleteResp, err := me.client.UseCosClient().DeleteBucketTagging(deleteReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -897,8 +875,6 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 		})
 	}
 
-	ratelimit.Check("PutBucketTagging")
-
 	resp, err := me.client.UseCosClient().PutBucketTagging(putReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -917,8 +893,6 @@ func (me *CosService) GetBucketTags(ctx context.Context, bucket string) (map[str
 
 	req := &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketTagging")
-
 	resp, err := me.client.UseCosClient().GetBucketTagging(req)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "404" {
@@ -949,7 +923,6 @@ func (me *CosService) GetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    &key,
 	}
 
-	ratelimit.Check("GetObjectTagging")
 	resp, err := me.client.UseCosClient().GetObjectTagging(req)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "404" {
@@ -982,8 +955,6 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    aws.String(key),
 	}
 
-	ratelimit.Check("DeleteObjectTagging")
-
 	deleteResp, err := me.client.UseCosClient().DeleteObjectTagging(deleteReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1011,8 +982,6 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		})
 	}
 
-	ratelimit.Check("PutObjectTagging")
-
 	resp, err := me.client.UseCosClient().PutObjectTagging(putReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1039,7 +1008,6 @@ func (me *CosService) PutBucketPolicy(ctx context.Context, bucket, policy string
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutBucketPolicy")
 	response, err := me.client.UseCosClient().PutBucketPolicy(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put bucket policy error: %s, bucket: %s", err.Error(), bucket)
@@ -1056,7 +1024,6 @@ func (me *CosService) DescribePolicyByBucket(ctx context.Context, bucket string)
 	logId := getLogId(ctx)
 	request := s3.GetBucketPolicyInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketPolicy")
 	response, err := me.client.UseCosClient().GetBucketPolicy(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1076,7 +1043,6 @@ func (me *CosService) DeleteBucketPolicy(ctx context.Context, bucket string) (er
 	request := s3.DeleteBucketPolicyInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucketPolicy")
 	response, err := me.client.UseCosClient().DeleteBucketPolicy(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1099,7 +1065,6 @@ func (me *CosService) GetBucketACL(ctx context.Context, bucket string) (result *
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACL")
 	acl, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetACL(ctx)
 
 	if err != nil {
@@ -1158,7 +1123,6 @@ func (me *CosService) GetBucketPullOrigin(ctx context.Context, bucket string) (r
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketPullOrigin")
 	originConfig, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetOrigin(ctx)
 
 	if response.StatusCode == 404 {
@@ -1236,7 +1200,6 @@ func (me *CosService) PutBucketPullOrigin(ctx context.Context, bucket string, ru
 	opt := &cos.BucketPutOriginOptions{
 		Rule: rules,
 	}
-	ratelimit.Check("PutBucketPullOrigin")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutOrigin(ctx, opt)
 	req, _ := json.Marshal(opt)
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1269,7 +1232,6 @@ func (me *CosService) DeleteBucketPullOrigin(ctx context.Context, bucket string)
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketPullOrigin")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteOrigin(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 
@@ -1294,7 +1256,6 @@ func (me *CosService) GetBucketOriginDomain(ctx context.Context, bucket string)
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketOriginDomain")
 	domain, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetDomain(ctx)
 
 	if response != nil && response.StatusCode == 404 {
@@ -1331,7 +1292,6 @@ func (me *CosService) PutBucketOriginDomain(ctx context.Context, bucket string,
 	opt := &cos.BucketPutDomainOptions{
 		Rules: rules,
 	}
-	ratelimit.Check("PutBucketOriginDomain")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutDomain(ctx, opt)
 	req, _ := json.Marshal(opt)
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1364,7 +1324,6 @@ func (me *CosService) DeleteBucketOriginDomain(ctx context.Context, bucket strin
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketOriginDomain")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteDomain(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 
@@ -1388,7 +1347,6 @@ func (me *CosService) GetBucketReplication(ctx context.Context, bucket string) (
 		}
 	}()
 
-	ratelimit.Check("GetBucketReplication")
 	result, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetBucketReplication(ctx)
 
 	if response.StatusCode == 404 {
@@ -1426,7 +1384,6 @@ func (me *CosService) PutBucketReplication(ctx context.Context, bucket string, r
 		}
 	}()
 
-	ratelimit.Check("PutBucketReplication")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutBucketReplication(ctx, option)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1451,7 +1408,6 @@ func (me *CosService) DeleteBucketReplication(ctx context.Context, bucket string
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketReplication")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteBucketReplication(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1530,7 +1486,6 @@ func (me *CosService) DeleteCosBucketDomainCertificate(ctx context.Context, cert
 		}
 	}()
 
-	ratelimit.Check("DeleteDomainCertificate")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteDomainCertificate(ctx, option)
 
 	if err != nil {
@@ -1572,7 +1527,7 @@ func (me *CosService) DescribeCosBucketRefererById(ctx context.Context, bucket s
 		}
 	}()
 
-	resRaw, err := RetryWithContext(ctx, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := RetryWithContext(ctx, me.client.Retryer, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetReferer(ctx)
 		return res, err
 	})
@@ -1596,7 +1551,7 @@ func (me *CosService) DescribeCosBucketVersionById(ctx context.Context, bucket s
 		}
 	}()
 
-	resRaw, err := RetryWithContext(ctx, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := RetryWithContext(ctx, me.client.Retryer, readRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetVersioning(ctx)
 		return res, err
 	})
@@ -1622,7 +1577,6 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("BucketPutIntelligentTiering")
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutIntelligentTiering(ctx, opt)
 	if err != nil {
 		errRet = fmt.Errorf("cos bucket put intelligent tiering error: %s, bucket: %s", err.Error(), bucket)
@@ -1645,7 +1599,6 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 func (me *CosService) BucketGetIntelligentTiering(ctx context.Context, bucket string) (result *cos.BucketGetIntelligentTieringResult, errRet error) {
 	logId := getLogId(ctx)
 
-	ratelimit.Check("BucketGetIntelligentTiering")
 	intelligentTieringResult, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetIntelligentTiering(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 	if response.StatusCode == 404 {
@@ -8,7 +8,6 @@ import (
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CssService struct {
@@ -89,7 +88,6 @@ func (me *CssService) DeleteCssWatermarkById(ctx context.Context, watermarkId *i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCssClient().DeleteLiveWatermark(request)
 	if err != nil {
 		errRet = err
@@ -150,7 +148,6 @@ func (me *CssService) DetachCssWatermarkRuleAttachment(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCssClient().DeleteLiveWatermarkRule(request)
 	if err != nil {
 		errRet = err
@@ -210,7 +207,6 @@ func (me *CssService) DeleteCssPullStreamTaskById(ctx context.Context, taskId, o
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCssClient().DeleteLivePullStreamTask(request)
 	if err != nil {
 		errRet = err
@@ -295,7 +291,6 @@ func (me *CssService) DeleteCssLiveTranscodeTemplateById(ctx context.Context, te
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCssClient().DeleteLiveTranscodeTemplate(request)
 	if err != nil {
 		errRet = err
@@ -361,7 +356,6 @@ func (me *CssService) DeleteCssLiveTranscodeRuleAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCssClient().DeleteLiveTranscodeRule(request)
 	if err != nil {
 		errRet = err
@@ -385,8 +379,6 @@ func (me *CssService) DescribeCssDomainById(ctx context.Context, name string) (d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCssClient().DescribeLiveDomain(request)
 	if err != nil {
 		errRet = err
@@ -419,8 +411,6 @@ func (me *CssService) DeleteCssDomainById(ctx context.Context, name *string, dty
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCssClient().DeleteLiveDomain(request)
 	if err != nil {
 		errRet = err
@@ -466,7 +456,6 @@ func (me *CssService) DescribeCssDomainsByFilter(ctx context.Context, param map[
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var currNumber uint64 = 1
 	var pageSize uint64 = 20
@@ -510,8 +499,6 @@ func (me *CssService) DescribeCssPlayDomainCertAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -562,8 +549,6 @@ func (me *CssService) DeleteCssPlayDomainCertAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCssClient().UnBindLiveDomainCert(request)
 	if err != nil {
 		errRet = err
@@ -586,8 +571,6 @@ func (me *CssService) DescribeCssPlayAuthKeyConfigById(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCssClient().DescribeLivePlayAuthKey(request)
 	if err != nil {
 		errRet = err
@@ -615,8 +598,6 @@ func (me *CssService) DescribeCssPushAuthKeyConfigById(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCssClient().DescribeLivePushAuthKey(request)
 	if err != nil {
 		errRet = err
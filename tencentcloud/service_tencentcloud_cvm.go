@@ -15,7 +15,6 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CvmService struct {
@@ -39,8 +38,7 @@ func (me *CvmService) DescribeInstanceSetByIds(ctx context.Context, instanceSetI
 
 	request.Limit = helper.IntInt64(100)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -62,8 +60,7 @@ func (me *CvmService) DescribeInstanceById(ctx context.Context, instanceId strin
 	request := cvm.NewDescribeInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -102,8 +99,7 @@ func (me *CvmService) DescribeInstanceByFilter(ctx context.Context, instancesId
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeInstances(request)
+		response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -138,7 +134,7 @@ func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, fi
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -180,8 +176,7 @@ func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, fi
 			request.Offset = helper.IntInt64(offset)
 			request.Limit = helper.IntInt64(limit)
 
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseCvmClient().DescribeInstances(request)
+			response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -214,8 +209,7 @@ func (me *CvmService) ModifyInstanceName(ctx context.Context, instanceId, instan
 	request.InstanceIds = []*string{&instanceId}
 	request.InstanceName = &instanceName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -233,8 +227,7 @@ func (me *CvmService) ModifySecurityGroups(ctx context.Context, instanceId strin
 	request.InstanceIds = []*string{&instanceId}
 	request.SecurityGroups = securityGroups
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -252,8 +245,7 @@ func (me *CvmService) ModifyDisableApiTermination(ctx context.Context, instanceI
 	request.InstanceIds = []*string{&instanceId}
 	request.DisableApiTermination = &disableApiTermination
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -271,8 +263,7 @@ func (me *CvmService) ModifyProjectId(ctx context.Context, instanceId string, pr
 	request.InstanceIds = []*string{&instanceId}
 	request.ProjectId = &projectId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesProject(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesProjectWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -291,8 +282,7 @@ func (me *CvmService) ModifyInstanceType(ctx context.Context, instanceId, instan
 	request.InstanceType = &instanceType
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResetInstancesType(request)
+	response, err := me.client.UseCvmClient().ResetInstancesTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -312,8 +302,7 @@ func (me *CvmService) ModifyPassword(ctx context.Context, instanceId, password s
 	forceStop := true
 	request.ForceStop = &forceStop
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResetInstancesPassword(request)
+	response, err := me.client.UseCvmClient().ResetInstancesPasswordWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -334,8 +323,7 @@ func (me *CvmService) ModifyInternetMaxBandwidthOut(ctx context.Context, instanc
 		InternetMaxBandwidthOut: &internetMaxBandWidthOut,
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().ResetInstancesInternetMaxBandwidth(request)
+	_, err := me.client.UseCvmClient().ResetInstancesInternetMaxBandwidthWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -356,8 +344,7 @@ func (me *CvmService) ModifyVpc(ctx context.Context, instanceId, vpcId, subnetId
 		request.VirtualPrivateCloud.PrivateIpAddresses = []*string{&privateIp}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesVpcAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesVpcAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -377,8 +364,7 @@ func (me *CvmService) StopInstance(ctx context.Context, instanceId string, stopp
 		request.StoppedMode = &stoppedMode
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().StopInstances(request)
+	response, err := me.client.UseCvmClient().StopInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -395,8 +381,7 @@ func (me *CvmService) StartInstance(ctx context.Context, instanceId string) erro
 	request := cvm.NewStartInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().StartInstances(request)
+	response, err := me.client.UseCvmClient().StartInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -413,8 +398,7 @@ func (me *CvmService) DeleteInstance(ctx context.Context, instanceId string) err
 	request := cvm.NewTerminateInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().TerminateInstances(request)
+	response, err := me.client.UseCvmClient().TerminateInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -441,8 +425,7 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 		request.InstanceIds = append(request.InstanceIds, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().TerminateInstances(request)
+	response, err := me.client.UseCvmClient().TerminateInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -456,9 +439,8 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 
 func (me *CvmService) ResetInstance(ctx context.Context, request *cvm.ResetInstanceRequest) (errRet error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
 
-	response, err := me.client.UseCvmClient().ResetInstance(request)
+	response, err := me.client.UseCvmClient().ResetInstanceWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -483,8 +465,7 @@ func (me *CvmService) DescribeInstanceTypes(ctx context.Context, zone string) (i
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -514,8 +495,7 @@ func (me *CvmService) DescribeInstanceTypesByFilter(ctx context.Context, filters
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -545,8 +525,7 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
+	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfosWithContext(ctx, request)
 	if err != nil {
 		//deal with not supported error
 		e, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -568,8 +547,7 @@ func (me *CvmService) DescribeKeyPairById(ctx context.Context, keyId string) (ke
 	request := cvm.NewDescribeKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
+	response, err := me.client.UseCvmClient().DescribeKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -613,8 +591,7 @@ func (me *CvmService) DescribeKeyPairByFilter(ctx context.Context, id, name stri
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
+		response, err := me.client.UseCvmClient().DescribeKeyPairsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -642,8 +619,7 @@ func (me *CvmService) ModifyKeyPairName(ctx context.Context, keyId, keyName stri
 	request.KeyId = &keyId
 	request.KeyName = &keyName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyKeyPairAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyKeyPairAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -660,8 +636,7 @@ func (me *CvmService) DeleteKeyPair(ctx context.Context, keyId string) error {
 	request := cvm.NewDeleteKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DeleteKeyPairs(request)
+	response, err := me.client.UseCvmClient().DeleteKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -680,8 +655,7 @@ func (me *CvmService) UnbindKeyPair(ctx context.Context, keyIds []*string, insta
 	request.InstanceIds = instanceIds
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DisassociateInstancesKeyPairs(request)
+	response, err := me.client.UseCvmClient().DisassociateInstancesKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -700,8 +674,7 @@ func (me *CvmService) BindKeyPair(ctx context.Context, keyIds []*string, instanc
 	request.InstanceIds = []*string{&instanceId}
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().AssociateInstancesKeyPairs(request)
+	_, err := me.client.UseCvmClient().AssociateInstancesKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -717,8 +690,7 @@ func (me *CvmService) CreatePlacementGroup(ctx context.Context, placementName, p
 	request.Name = &placementName
 	request.Type = &placementType
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().CreateDisasterRecoverGroup(request)
+	response, err := me.client.UseCvmClient().CreateDisasterRecoverGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -741,8 +713,7 @@ func (me *CvmService) DescribePlacementGroupById(ctx context.Context, placementI
 	request := cvm.NewDescribeDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
+	response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -775,8 +746,7 @@ func (me *CvmService) DescribePlacementGroupByFilter(ctx context.Context, id, na
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
+		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -804,8 +774,7 @@ func (me *CvmService) ModifyPlacementGroup(ctx context.Context, placementId, nam
 	request.DisasterRecoverGroupId = &placementId
 	request.Name = &name
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyDisasterRecoverGroupAttribute(request)
+	response, err := me.client.UseCvmClient().ModifyDisasterRecoverGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -822,8 +791,7 @@ func (me *CvmService) DeletePlacementGroup(ctx context.Context, placementId stri
 	request := cvm.NewDeleteDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DeleteDisasterRecoverGroups(request)
+	response, err := me.client.UseCvmClient().DeleteDisasterRecoverGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -839,8 +807,7 @@ func (me *CvmService) DescribeRegions(ctx context.Context) (zones []*cvm.RegionI
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeRegionsRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeRegions(request)
+	response, err := me.client.UseCvmClient().DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -858,8 +825,7 @@ func (me *CvmService) DescribeZones(ctx context.Context) (zones []*cvm.ZoneInfo,
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeZonesRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeZones(request)
+	response, err := me.client.UseCvmClient().DescribeZonesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -883,8 +849,7 @@ func (me *CvmService) CreateReservedInstance(ctx context.Context, configId strin
 		request.ReservedInstanceName = &reservedInstanceName
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().PurchaseReservedInstancesOffering(request)
+	response, err := me.client.UseCvmClient().PurchaseReservedInstancesOfferingWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -920,8 +885,7 @@ func (me *CvmService) DescribeReservedInstanceByFilter(ctx context.Context, filt
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeReservedInstances(request)
+		response, err := me.client.UseCvmClient().DescribeReservedInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -961,8 +925,7 @@ func (me *CvmService) DescribeReservedInstanceConfigs(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferings(request)
+		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferingsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1028,8 +991,7 @@ func (me *CvmService) ModifyImage(ctx context.Context, instanceId, imageName, im
 	request.ImageDescription = helper.String(imageDesc)
 
 	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseCvmClient().ModifyImageAttribute(request)
+		_, e := me.client.UseCvmClient().ModifyImageAttributeWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.Code == "InvalidImageId.Malformed" || ee.Code == "InvalidImageId.NotFound" ||
@@ -1053,8 +1015,7 @@ func (me *CvmService) DeleteImage(ctx context.Context, imageId string) error {
 	request := cvm.NewDeleteImagesRequest()
 	request.ImageIds = []*string{&imageId}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().DeleteImages(request)
+	_, err := me.client.UseCvmClient().DeleteImagesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1071,8 +1032,7 @@ func (me *CvmService) DescribeImageById(ctx context.Context, keyId string, isDel
 
 	var imgRsp *cvm.DescribeImagesResponse
 	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+		response, err := me.client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1137,8 +1097,7 @@ func (me *CvmService) DescribeImagesByFilter(ctx context.Context, filters map[st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+		response, err := me.client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1167,8 +1126,7 @@ func (me *CvmService) ModifyRenewParam(ctx context.Context, instanceId string, r
 	request.InstanceIds = []*string{&instanceId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesRenewFlag(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesRenewFlagWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1196,8 +1154,7 @@ func (me *CvmService) ModifyInstanceChargeType(ctx context.Context, instanceId s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesChargeType(request)
+	response, err := me.client.UseCvmClient().ModifyInstancesChargeTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1219,8 +1176,7 @@ func (me *CvmService) ResizeInstanceDisks(ctx context.Context, request *cvm.Resi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResizeInstanceDisks(request)
+	response, err := me.client.UseCvmClient().ResizeInstanceDisksWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1245,8 +1201,6 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1255,7 +1209,7 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCvmClient().DescribeHpcClusters(request)
+		response, err := me.client.UseCvmClient().DescribeHpcClustersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1292,9 +1246,7 @@ func (me *CvmService) DeleteCvmHpcClusterById(ctx context.Context, hpcClusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DeleteHpcClusters(request)
+	response, err := me.client.UseCvmClient().DeleteHpcClustersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1316,9 +1268,7 @@ func (me *CvmService) DescribeCvmLaunchTemplateById(ctx context.Context, launchT
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplates(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1345,9 +1295,7 @@ func (me *CvmService) DescribeLaunchTemplateVersionsById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1377,10 +1325,9 @@ func (me *CvmService) DescribeLaunchTemplateVersions(ctx context.Context, launch
 	offect := 0
 	limit := 50
 	for {
-		ratelimit.Check(request.GetAction())
 		request.Offset = helper.IntUint64(offect)
 		request.Limit = helper.IntUint64(limit)
-		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1413,9 +1360,7 @@ func (me *CvmService) DeleteCvmLaunchTemplateById(ctx context.Context, launchTem
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DeleteLaunchTemplate(request)
+	response, err := me.client.UseCvmClient().DeleteLaunchTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1438,9 +1383,7 @@ func (me *CvmService) DescribeCvmLaunchTemplateVersionById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1468,9 +1411,7 @@ func (me *CvmService) DeleteCvmLaunchTemplateVersionById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DeleteLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DeleteLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1486,8 +1427,7 @@ func (me *CvmService) ModifyLaunchTemplateDefaultVersion(ctx context.Context, la
 	request.LaunchTemplateId = helper.String(launchTemplateId)
 	request.DefaultVersion = helper.IntInt64(defaultVersion)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyLaunchTemplateDefaultVersion(request)
+	response, err := me.client.UseCvmClient().ModifyLaunchTemplateDefaultVersionWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue.LaunchTemplateIdVerSetAlready" && strings.Contains(sdkErr.Message, "The specified launch template version had been set to default") {
@@ -1525,8 +1465,6 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1534,7 +1472,7 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCvmClient().DescribeChcHosts(request)
+		response, err := me.client.UseCvmClient().DescribeChcHostsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1573,9 +1511,7 @@ func (me *CvmService) DescribeCvmChcDeniedActionsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeChcDeniedActions(request)
+	response, err := me.client.UseCvmClient().DescribeChcDeniedActionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1602,9 +1538,7 @@ func (me *CvmService) DeleteCvmChcAssistVpcById(ctx context.Context, chcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().RemoveChcAssistVpc(request)
+	response, err := me.client.UseCvmClient().RemoveChcAssistVpcWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1685,9 +1619,7 @@ func (me *CvmService) DescribeCvmImageQuotaByFilter(ctx context.Context, param m
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeImageQuota(request)
+	response, err := me.client.UseCvmClient().DescribeImageQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1721,9 +1653,7 @@ func (me *CvmService) DescribeCvmImageSharePermissionByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseCvmClient().DescribeImageSharePermission(request)
+	response, err := me.client.UseCvmClient().DescribeImageSharePermissionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1750,10 +1680,9 @@ func (me *CvmService) ModifyImageSharePermission(ctx context.Context, imageId, p
 	request.ImageId = helper.String(imageId)
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCvmClient().ModifyImageSharePermission(request)
+		result, e := me.client.UseCvmClient().ModifyImageSharePermissionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1767,3 +1696,23 @@ func (me *CvmService) ModifyImageSharePermission(ctx context.Context, imageId, p
 	}
 	return
 }
+
+func (me *CvmService) InquiryPriceRunInstances(ctx context.Context, request *cvm.InquiryPriceRunInstancesRequest) (price *cvm.Price, errRet error) {
+	logId := getLogId(ctx)
+	response, err := me.client.UseCvmClient().InquiryPriceRunInstancesWithContext(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.Price == nil {
+		errRet = fmt.Errorf("api[%s] returns no price", request.GetAction())
+		return
+	}
+	price = response.Response.Price
+	return
+}
@@ -12,7 +12,6 @@ import (
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CynosdbService struct {
@@ -27,7 +26,6 @@ func (me *CynosdbService) DescribeRedisZoneConfig(ctx context.Context) (instance
 	request.IncludeZoneStocks = helper.Bool(true)
 
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, e := me.client.UseCynosdbClient().DescribeInstanceSpecs(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -62,7 +60,6 @@ func (me *CynosdbService) DescribeClusters(ctx context.Context, filters map[stri
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCynosdbClient().DescribeClusters(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -96,7 +93,6 @@ func (me *CynosdbService) DescribeClusterById(ctx context.Context, clusterId str
 	var notExist bool
 	var clusters []*cynosdb.CynosdbCluster
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		clusters, errRet = me.DescribeClusters(ctx, map[string]string{"ClusterId": clusterId})
 		if errRet != nil {
 			return retryError(errRet)
@@ -130,7 +126,6 @@ func (me *CynosdbService) DescribeClusterById(ctx context.Context, clusterId str
 
 	var response *cynosdb.DescribeClusterDetailResponse
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeClusterDetail(request)
 		if errRet != nil {
 			return retryError(errRet)
@@ -157,7 +152,6 @@ func (me *CynosdbService) UpgradeInstance(ctx context.Context, instanceId string
 	request.UpgradeType = helper.String(CYNOSDB_UPGRADE_IMMEDIATE)
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().UpgradeInstance(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -179,7 +173,6 @@ func (me *CynosdbService) DescribeMaintainPeriod(ctx context.Context, instanceId
 	request.InstanceId = &instanceId
 
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeMaintainPeriod(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -204,7 +197,6 @@ func (me *CynosdbService) ModifyMaintainPeriodConfig(ctx context.Context, instan
 	request.MaintainWeekDays = weekdays
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().ModifyMaintainPeriodConfig(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -226,7 +218,6 @@ func (me *CynosdbService) IsolateCluster(ctx context.Context, clusterId string)
 	request.ClusterId = &clusterId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseCynosdbClient().IsolateCluster(request)
 		if errRet != nil {
 			// isolate after creation immediately will encounter this error
@@ -255,7 +246,6 @@ func (me *CynosdbService) OfflineCluster(ctx context.Context, clusterId string)
 	request.ClusterId = &clusterId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().OfflineCluster(request)
 		if errRet != nil {
 			if ee, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
@@ -294,7 +284,6 @@ func (me *CynosdbService) DescribeInstances(ctx context.Context, filters map[str
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCynosdbClient().DescribeInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -322,7 +311,6 @@ func (me *CynosdbService) DescribeInstanceById(ctx context.Context, instanceId s
 	var notExist bool
 	var instances []*cynosdb.CynosdbInstance
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		instances, errRet = me.DescribeInstances(ctx, map[string]string{"InstanceId": instanceId})
 		if errRet != nil {
 			return retryError(errRet)
@@ -351,7 +339,6 @@ func (me *CynosdbService) DescribeInstanceById(ctx context.Context, instanceId s
 
 	var response *cynosdb.DescribeInstanceDetailResponse
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeInstanceDetail(request)
 		if errRet != nil {
 			return retryError(errRet)
@@ -374,7 +361,6 @@ func (me *CynosdbService) DescribeClusterInstanceGrps(ctx context.Context, clust
 	request.ClusterId = &clusterId
 
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeClusterInstanceGrps(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -395,7 +381,6 @@ func (me *CynosdbService) DescribeInsGrpSecurityGroups(ctx context.Context, inst
 	request.InstanceId = &instanceGrpId
 
 	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeDBSecurityGroups(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -419,7 +404,6 @@ func (me *CynosdbService) ModifyInsGrpSecurityGroups(ctx context.Context, insGrp
 	request.SecurityGroupIds = sg
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().ModifyDBInstanceSecurityGroups(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -443,7 +427,6 @@ func (me *CynosdbService) ModifyClusterParam(ctx context.Context, request *cynos
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCynosdbClient().ModifyClusterParam(request)
 
 	if err != nil {
@@ -467,7 +450,6 @@ func (me *CynosdbService) IsolateInstance(ctx context.Context, clusterId, instan
 	request.InstanceIdList = []*string{helper.String(instanceId)}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().IsolateInstance(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -487,7 +469,6 @@ func (me *CynosdbService) OfflineInstance(ctx context.Context, clusterId, instan
 	request.InstanceIdList = []*string{helper.String(instanceId)}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().OfflineInstance(request)
 		if errRet != nil {
 			if ee, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
@@ -512,7 +493,6 @@ func (me *CynosdbService) DescribeClusterParams(ctx context.Context, clusterId s
 
 	var response *cynosdb.DescribeClusterParamsResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseCynosdbClient().DescribeClusterParams(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -537,7 +517,6 @@ func (me *CynosdbService) ResumeServerless(ctx context.Context, request *cynosdb
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCynosdbClient().ResumeServerless(request)
 
 	if err != nil {
@@ -560,7 +539,6 @@ func (me *CynosdbService) PauseServerless(ctx context.Context, request *cynosdb.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCynosdbClient().PauseServerless(request)
 
 	if err != nil {
@@ -652,8 +630,6 @@ func (me *CynosdbService) DescribeCynosdbAuditLogFileById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeAuditLogFiles(request)
 	if err != nil {
 		errRet = err
@@ -682,8 +658,6 @@ func (me *CynosdbService) DeleteCynosdbAuditLogFileById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DeleteAuditLogFile(request)
 	if err != nil {
 		errRet = err
@@ -707,8 +681,6 @@ func (me *CynosdbService) DeleteCynosdbSecurityGroupById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DisassociateSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -731,8 +703,6 @@ func (me *CynosdbService) DescribeCynosdbSecurityGroups(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -762,8 +732,6 @@ func (me *CynosdbService) DescribeCynosdbBackup(ctx context.Context, clusterId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeBackupList(request)
 	if err != nil {
 		errRet = err
@@ -793,8 +761,6 @@ func (me *CynosdbService) DescribeCynosdbBackupById(ctx context.Context, cluster
 	}
 	request.BackupIds = []*int64{&backupIdInt64}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeBackupList(request)
 	if err != nil {
 		errRet = err
@@ -828,8 +794,6 @@ func (me *CynosdbService) DeleteCynosdbBackupById(ctx context.Context, clusterId
 	}
 	request.BackupIds = []*int64{&backupIdInt64}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DeleteBackup(request)
 	if err != nil {
 		errRet = err
@@ -1047,8 +1011,6 @@ func (me *CynosdbService) DescribeCynosdbAuditLogsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -1101,8 +1063,6 @@ func (me *CynosdbService) DescribeCynosdbBackupDownloadUrlById(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeBackupDownloadUrl(request)
 	if err != nil {
 		errRet = err
@@ -1140,8 +1100,6 @@ func (me *CynosdbService) DescribeCynosdbBinlogDownloadUrlByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeBinlogDownloadUrl(request)
 	if err != nil {
 		errRet = err
@@ -1179,8 +1137,6 @@ func (me *CynosdbService) DescribeCynosdbClusterDetailDatabasesByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -1239,8 +1195,6 @@ func (me *CynosdbService) DescribeCynosdbClusterParamLogsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -1299,8 +1253,6 @@ func (me *CynosdbService) DescribeCynosdbClusterByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().SearchClusterTables(request)
 	if err != nil {
 		errRet = err
@@ -1342,8 +1294,6 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceSlowQueriesByFilter(ctx
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1411,8 +1361,6 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceErrorLogsByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1466,8 +1414,6 @@ func (me *CynosdbService) DescribeCynosdbAccountAllGrantPrivilegesByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -1506,8 +1452,6 @@ func (me *CynosdbService) DescribeCynosdbProjectSecurityGroupsByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1563,8 +1507,6 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageSaleSpecsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1614,8 +1556,6 @@ func (me *CynosdbService) DescribeCynosdbRollbackTimeRangeByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeRange(request)
 	if err != nil {
 		errRet = err
@@ -1657,8 +1597,6 @@ func (me *CynosdbService) DescribeCynosdbRollbackTimeValidityByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeValidity(request)
 	if err != nil {
 		errRet = err
@@ -1712,8 +1650,6 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageListByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1756,8 +1692,6 @@ func (me *CynosdbService) DescribeCynosdbClusterResourcePackagesAttachmentById(c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeClusterDetail(request)
 	if err != nil {
 		errRet = err
@@ -1787,8 +1721,6 @@ func (me *CynosdbService) DeleteCynosdbClusterResourcePackagesAttachmentById(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().UnbindClusterResourcePackages(request)
 	if err != nil {
 		errRet = err
@@ -1812,8 +1744,6 @@ func (me *CynosdbService) DeleteCynosdbProxyById(ctx context.Context, clusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().CloseProxy(request)
 	if err != nil {
 		errRet = err
@@ -1837,8 +1767,6 @@ func (me *CynosdbService) DescribeCynosdbProxyById(ctx context.Context, clusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
 	if err != nil {
 		errRet = err
@@ -1889,8 +1817,6 @@ func (me *CynosdbService) DescribeCynosdbProxyNodeByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1943,8 +1869,6 @@ func (me *CynosdbService) DescribeCynosdbProxyVersionByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeSupportProxyVersion(request)
 	if err != nil {
 		errRet = err
@@ -2026,7 +1950,6 @@ func (me *CynosdbService) DescribeCynosdbZoneByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCynosdbClient().DescribeZones(request)
 	if err != nil {
 		errRet = err
@@ -2057,8 +1980,6 @@ func (me *CynosdbService) DescribeCynosdbAccountById(ctx context.Context, cluste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeAccounts(request)
 	if err != nil {
 		errRet = err
@@ -2092,8 +2013,6 @@ func (me *CynosdbService) DeleteCynosdbAccountById(ctx context.Context, clusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DeleteAccounts(request)
 	if err != nil {
 		errRet = err
@@ -2120,8 +2039,6 @@ func (me *CynosdbService) DescribeCynosdbAccountPrivilegesById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -2149,8 +2066,6 @@ func (me *CynosdbService) DeleteCynosdbAccountPrivilegesById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().RevokeAccountPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -2173,8 +2088,6 @@ func (me *CynosdbService) DescribeCynosdbBinlogSaveDaysById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeBinlogSaveDays(request)
 	if err != nil {
 		errRet = err
@@ -2203,8 +2116,6 @@ func (me *CynosdbService) DescribeCynosdbClusterDatabasesById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeClusterDetailDatabases(request)
 	if err != nil {
 		errRet = err
@@ -2234,8 +2145,6 @@ func (me *CynosdbService) DeleteCynosdbClusterDatabasesById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DeleteClusterDatabase(request)
 	if err != nil {
 		errRet = err
@@ -2258,8 +2167,6 @@ func (me *CynosdbService) DescribeCynosdbClusterPasswordComplexityById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeClusterPasswordComplexity(request)
 	if err != nil {
 		errRet = err
@@ -2287,8 +2194,6 @@ func (me *CynosdbService) DeleteCynosdbClusterPasswordComplexityById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().CloseClusterPasswordComplexity(request)
 	if err != nil {
 		errRet = err
@@ -2311,7 +2216,6 @@ func (me *CynosdbService) DescribeFlow(ctx context.Context, flowId int64) (ok bo
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCynosdbClient().DescribeFlow(request)
 	if err != nil {
 		errRet = err
@@ -2341,8 +2245,6 @@ func (me *CynosdbService) CopyClusterPasswordComplexity(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().CopyClusterPasswordComplexity(request)
 	if err != nil {
 		errRet = err
@@ -2388,8 +2290,6 @@ func (me *CynosdbService) DescribeCynosdbInstanceParamById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -2417,8 +2317,6 @@ func (me *CynosdbService) DeleteCynosdbWanById(ctx context.Context, instanceGrpI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().CloseWan(request)
 	if err != nil {
 		errRet = err
@@ -2442,8 +2340,6 @@ func (me *CynosdbService) DescribeCynosdbParamTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeParamTemplateDetail(request)
 	if err != nil {
 		errRet = err
@@ -2467,8 +2363,6 @@ func (me *CynosdbService) DeleteCynosdbParamTemplateById(ctx context.Context, te
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DeleteParamTemplate(request)
 	if err != nil {
 		errRet = err
@@ -2486,7 +2380,6 @@ func (me *CynosdbService) SetRenewFlag(ctx context.Context, instanceId string, a
 	request.AutoRenewFlag = &autoRenewFlag
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().SetRenewFlag(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -2508,7 +2401,6 @@ func (me *CynosdbService) ModifyClusterName(ctx context.Context, clusterId strin
 	request.ClusterName = &clusterName
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().ModifyClusterName(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -2532,7 +2424,6 @@ func (me *CynosdbService) ModifyClusterStorage(ctx context.Context, clusterId st
 	request.DealMode = helper.IntInt64(0)
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseCynosdbClient().ModifyClusterStorage(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -2557,7 +2448,6 @@ func (me *CynosdbService) SwitchClusterVpc(ctx context.Context, clusterId string
 
 	var flowId int64
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseCynosdbClient().SwitchClusterVpc(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -2606,8 +2496,6 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeResourcePackageDetail(request)
 	if err != nil {
 		errRet = err
@@ -2635,8 +2523,6 @@ func (me *CynosdbService) DeleteCynosdbResourcePackageById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().RefundResourcePackage(request)
 	if err != nil {
 		errRet = err
@@ -2659,8 +2545,6 @@ func (me *CynosdbService) DescribeCynosdbClusterSlaveZoneById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeClusterDetail(request)
 	if err != nil {
 		errRet = err
@@ -2685,8 +2569,6 @@ func (me *CynosdbService) DeleteCynosdbClusterSlaveZoneById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().RemoveClusterSlaveZone(request)
 	if err != nil {
 		errRet = err
@@ -2759,8 +2641,6 @@ func (me *CynosdbService) DescribeCynosdbInstanceSlowQueriesByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2800,8 +2680,6 @@ func (me *CynosdbService) DescribeCynosdbProxyEndPointById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
 	if err != nil {
 		errRet = err
@@ -2836,8 +2714,6 @@ func (me *CynosdbService) DeleteCynosdbProxyEndPointById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().CloseProxy(request)
 	if err != nil {
 		errRet = err
@@ -2863,8 +2739,6 @@ func (me *CynosdbService) DescribeCynosdbUpgradeProxyVersionById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
 	if err != nil {
 		errRet = err
@@ -13,7 +13,6 @@ import (
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DayuService struct {
@@ -41,7 +40,6 @@ func (me *DayuService) DescribeCCSelfdefinePolicies(ctx context.Context, resourc
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribeCCSelfDefinePolicy(request)
 
 		if err != nil {
@@ -122,7 +120,6 @@ func (me *DayuService) CreateCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -169,7 +166,6 @@ func (me *DayuService) ModifyCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.SetId = &policyId
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -202,7 +198,6 @@ func (me *DayuService) DeleteCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.Id = &resourceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -236,7 +231,6 @@ func (me *DayuService) CreateDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -269,7 +263,6 @@ func (me *DayuService) DescribeDdosPolicies(ctx context.Context, resourceType st
 
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -310,7 +303,6 @@ func (me *DayuService) DescribeDdosPolicy(ctx context.Context, resourceType stri
 	}()
 
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -623,7 +615,6 @@ func (me *DayuService) DeleteDdosPolicy(ctx context.Context, resourceType string
 	request.PolicyId = &policyId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -657,7 +648,6 @@ func (me *DayuService) ModifyDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -690,7 +680,6 @@ func (me *DayuService) ModifyDdosPolicyName(ctx context.Context, resourceType st
 	request.PolicyId = &policyId
 	request.Name = helper.String(url.QueryEscape(name))
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicyName(request)
 	if err != nil {
 		errRet = err
@@ -718,7 +707,6 @@ func (me *DayuService) CreateDdosPolicyCase(ctx context.Context, request *dayu.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -747,7 +735,6 @@ func (me *DayuService) DescribeDdosPolicyCase(ctx context.Context, resourceType
 	}()
 	request.SceneId = &sceneId
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribePolicyCase(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -790,7 +777,6 @@ func (me *DayuService) DeleteDdosPolicyCase(ctx context.Context, resourceType st
 	request.SceneId = &sceneId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -825,7 +811,6 @@ func (me *DayuService) ModifyDdosPolicyCase(ctx context.Context, request *dayu.M
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -858,7 +843,6 @@ func (me *DayuService) DescribeDdosPolicyAttachments(ctx context.Context, resour
 		request.Id = &resourceId
 	}
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -907,7 +891,6 @@ func (me *DayuService) BindDdosPolicy(ctx context.Context, resourceId string, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -941,7 +924,6 @@ func (me *DayuService) UnbindDdosPolicy(ctx context.Context, resourceId string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -991,7 +973,6 @@ func (me *DayuService) DescribeL7Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleL7Rules(request)
 
 		if err != nil {
@@ -1073,7 +1054,6 @@ func (me *DayuService) SetL7Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L7HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL7HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1106,7 +1086,6 @@ func (me *DayuService) DescribeL7Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeL7HealthConfig(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1149,7 +1128,6 @@ func (me *DayuService) CreateL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L7RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1194,7 +1172,6 @@ func (me *DayuService) ModifyL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1238,7 +1215,6 @@ func (me *DayuService) DeleteL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1275,7 +1251,6 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 		} else {
 			request.Method = helper.String("close")
 		}
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyCCHostProtection(request)
 		if err != nil {
 			errRet = err
@@ -1309,7 +1284,6 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 			//this default value can be a request value that asks the whole threshold value if needed
 			request.Threshold = helper.IntUint64(DAYU_L7_HTTPS_SWITCH_ON_DEFAULT)
 		}
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyCCThreshold(request)
 		if err != nil {
 			errRet = err
@@ -1377,7 +1351,6 @@ func (me *DayuService) DeleteNewL4Rules(ctx context.Context, business string, id
 		},
 	}
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DeleteNewL4Rules(request)
 
 		if err != nil {
@@ -1438,7 +1411,6 @@ func (me *DayuService) ModifyNewL4Rule(ctx context.Context, business string, id
 	request.Rule = &tmpRule
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyNewL4Rule(request)
 
 		if err != nil {
@@ -1503,7 +1475,6 @@ func (me *DayuService) CreateNewL4Rules(ctx context.Context, business string, id
 	request.Rules = rules
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().CreateNewL4Rules(request)
 
 		if err != nil {
@@ -1542,7 +1513,6 @@ func (me *DayuService) DescribeL4Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleL4Rules(request)
 
 		if err != nil {
@@ -1628,7 +1598,6 @@ func (me *DayuService) SetL4Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L4HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL4HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1661,7 +1630,6 @@ func (me *DayuService) DescribeL4Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeL4HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1697,7 +1665,6 @@ func (me *DayuService) CreateL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L4RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1743,7 +1710,6 @@ func (me *DayuService) ModifyL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1774,7 +1740,6 @@ func (me *DayuService) DeleteL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1807,7 +1772,6 @@ func (me *DayuService) SetSession(ctx context.Context, resourceType string, reso
 	request.RuleId = &ruleId
 	request.KeepEnable = helper.BoolToInt64Pointer(switchFlag)
 	request.KeepTime = helper.IntUint64(sessionTime)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL4KeepTime(request)
 	if err != nil {
 		errRet = err
@@ -1865,7 +1829,6 @@ func (me *DayuService) DescribeL7RulesV2(ctx context.Context, business string, e
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleNewL7Rules(request)
 
 		if err != nil {
@@ -1944,7 +1907,6 @@ func (me *DayuService) CreateL7RuleV2(ctx context.Context, business string, reso
 	request.Business = &business
 	request.Rules = []*dayu.L7RuleEntry{&ruleEntry}
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().CreateNewL7Rules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
@@ -1977,7 +1939,6 @@ func (me *DayuService) ModifyL7RuleV2(ctx context.Context, resourceType string,
 	request.Rule = rule
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().ModifyNewDomainRules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
@@ -2015,7 +1976,6 @@ func (me *DayuService) DeleteL7RulesV2(ctx context.Context, resourceType string,
 		},
 	}
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().DeleteNewL7Rules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
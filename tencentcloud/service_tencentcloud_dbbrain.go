@@ -9,7 +9,6 @@ import (
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DbbrainService struct {
@@ -61,7 +60,6 @@ func (me *DbbrainService) DescribeDbbrainSqlFiltersByFilter(ctx context.Context,
 			request.Statuses = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -69,7 +67,6 @@ func (me *DbbrainService) DescribeDbbrainSqlFiltersByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDbbrainClient().DescribeSqlFilters(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -110,7 +107,6 @@ func (me *DbbrainService) getSessionToken(ctx context.Context, instanceId, user,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().VerifyUserAccount(request)
 	if err != nil {
 		errRet = err
@@ -139,7 +135,6 @@ func (me *DbbrainService) DeleteDbbrainSqlFilterById(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().DeleteSqlFilters(request)
 	if err != nil {
 		errRet = err
@@ -201,7 +196,6 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogExportTasksByFilter(ctx
 			request.AsyncRequestIds = v.([]*uint64)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -209,7 +203,6 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogExportTasksByFilter(ctx
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogExportTasks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -252,7 +245,6 @@ func (me *DbbrainService) DeleteDbbrainSecurityAuditLogExportTaskById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().DeleteSecurityAuditLogExportTasks(request)
 	if err != nil {
 		errRet = err
@@ -292,8 +284,6 @@ func (me *DbbrainService) DescribeDbbrainDiagEventsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -346,8 +336,6 @@ func (me *DbbrainService) DescribeDbbrainDiagEventByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeDBDiagEvent(request)
 	if err != nil {
 		errRet = err
@@ -389,8 +377,6 @@ func (me *DbbrainService) DescribeDbbrainDiagHistoryByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeDBDiagHistory(request)
 	if err != nil {
 		errRet = err
@@ -429,8 +415,6 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogDownloadUrlsByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogDownloadUrls(request)
 	if err != nil {
 		errRet = err
@@ -472,8 +456,6 @@ func (me *DbbrainService) DescribeDbbrainSlowLogTimeSeriesStatsByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeSlowLogTimeSeriesStats(request)
 	if err != nil {
 		errRet = err
@@ -530,8 +512,6 @@ func (me *DbbrainService) DescribeDbbrainSlowLogTopSqlsByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -590,8 +570,6 @@ func (me *DbbrainService) DescribeDbbrainSlowLogUserHostStatsByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeSlowLogUserHostStats(request)
 	if err != nil {
 		errRet = err
@@ -633,8 +611,6 @@ func (me *DbbrainService) DescribeDbbrainSlowLogUserSqlAdviceByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeUserSqlAdvice(request)
 	if err != nil {
 		errRet = err
@@ -662,8 +638,6 @@ func (me *DbbrainService) DescribeDbbrainDbDiagReportTaskById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeDBDiagReportTasks(request)
 	if err != nil {
 		errRet = err
@@ -699,8 +673,6 @@ func (me *DbbrainService) DeleteDbbrainDbDiagReportTaskById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DeleteDBDiagReportTasks(request)
 	if err != nil {
 		errRet = err
@@ -738,8 +710,6 @@ func (me *DbbrainService) DescribeDbbrainTdsqlAuditLogById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeAuditLogFiles(request)
 	if err != nil {
 		errRet = err
@@ -779,8 +749,6 @@ func (me *DbbrainService) DeleteDbbrainTdsqlAuditLogById(ctx context.Context, as
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DeleteAuditLogFile(request)
 	if err != nil {
 		errRet = err
@@ -815,8 +783,6 @@ func (me *DbbrainService) DescribeDbbrainHealthScoresByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeHealthScore(request)
 	if err != nil {
 		errRet = err
@@ -877,8 +843,6 @@ func (me *DbbrainService) DescribeDbbrainSlowLogsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -931,8 +895,6 @@ func (me *DbbrainService) DescribeDbbrainDbSpaceStatusByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeDBSpaceStatus(request)
 	if err != nil {
 		errRet = err
@@ -974,8 +936,6 @@ func (me *DbbrainService) DescribeDbbrainSqlTemplatesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeSqlTemplate(request)
 	if err != nil {
 		errRet = err
@@ -1017,7 +977,6 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceSchemasByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemas(request)
 	if err != nil {
 		errRet = err
@@ -1067,7 +1026,6 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceSchemaTimeSeriesByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemaTimeSeries(request)
 	if err != nil {
 		errRet = err
@@ -1116,7 +1074,6 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceTableTimeSeriesByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTableTimeSeries(request)
 	if err != nil {
 		errRet = err
@@ -1159,8 +1116,6 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceTablesByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTables(request)
 	if err != nil {
 		errRet = err
@@ -1207,8 +1162,6 @@ func (me *DbbrainService) DescribeDbbrainDiagDbInstancesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1282,8 +1235,6 @@ func (me *DbbrainService) DescribeDbbrainMysqlProcessListByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		limit uint64 = 20
 	)
@@ -1327,8 +1278,6 @@ func (me *DbbrainService) DescribeDbbrainNoPrimaryKeyTablesByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1388,8 +1337,6 @@ func (me *DbbrainService) DescribeDbbrainRedisTopBigKeysByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		limit int64 = 20
 	)
@@ -1433,8 +1380,6 @@ func (me *DbbrainService) DescribeDbbrainRedisTopKeyPrefixListByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		limit int64 = 20
 	)
@@ -7,7 +7,6 @@ import (
 
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // info  for one direct connect gateway.
@@ -92,7 +91,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
 
 	if err != nil {
@@ -257,7 +255,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewayCcnRoutes(request)
 
 	if err != nil {
@@ -326,7 +323,6 @@ func (me *VpcService) CreateDirectConnectGateway(ctx context.Context, name, netw
 	request.NetworkType = &networkType
 	request.NetworkInstanceId = &networkInstanceId
 	request.GatewayType = &gatewayType
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateDirectConnectGateway(request)
 
 	defer func() {
@@ -368,7 +364,6 @@ func (me *VpcService) ModifyDirectConnectGatewayAttribute(ctx context.Context, d
 	request := vpc.NewModifyDirectConnectGatewayAttributeRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.DirectConnectGatewayName = &name
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyDirectConnectGatewayAttribute(request)
 
 	defer func() {
@@ -395,7 +390,6 @@ func (me *VpcService) DeleteDirectConnectGateway(ctx context.Context, dcgId stri
 	logId := getLogId(ctx)
 	request := vpc.NewDeleteDirectConnectGatewayRequest()
 	request.DirectConnectGatewayId = &dcgId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteDirectConnectGateway(request)
 
 	defer func() {
@@ -432,7 +426,6 @@ func (me *VpcService) CreateDirectConnectGatewayCcnRoute(ctx context.Context, dc
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
@@ -487,7 +480,6 @@ func (me *VpcService) ReplaceDirectConnectGatewayCcnRoute(ctx context.Context, d
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReplaceDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
@@ -533,7 +525,6 @@ func (me *VpcService) DeleteDirectConnectGatewayCcnRoute(ctx context.Context, dc
 	request := vpc.NewDeleteDirectConnectGatewayCcnRoutesRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.RouteIds = []*string{&routeId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
@@ -567,8 +558,6 @@ func (me *VpcService) DescribeDcGatewayAttachmentById(ctx context.Context, vpcId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
 	if err != nil {
 		errRet = err
@@ -599,8 +588,6 @@ func (me *VpcService) DeleteDcGatewayAttachmentById(ctx context.Context, vpcId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DisassociateDirectConnectGatewayNatGateway(request)
 	if err != nil {
 		errRet = err
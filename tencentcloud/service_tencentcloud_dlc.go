@@ -7,7 +7,6 @@ import (
 	dlc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dlc/v20210125"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DlcService struct {
@@ -26,8 +25,6 @@ func (me *DlcService) DescribeDlcWorkGroupById(ctx context.Context, workGroupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDlcClient().DescribeWorkGroups(request)
 	if err != nil {
 		errRet = err
@@ -55,8 +52,6 @@ func (me *DlcService) DeleteDlcWorkGroupById(ctx context.Context, workGroupId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDlcClient().DeleteWorkGroup(request)
 	if err != nil {
 		errRet = err
@@ -79,8 +74,6 @@ func (me *DlcService) DescribeDlcUserById(ctx context.Context, userId string) (u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDlcClient().DescribeUsers(request)
 	if err != nil {
 		errRet = err
@@ -108,8 +101,6 @@ func (me *DlcService) DeleteDlcUserById(ctx context.Context, userId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDlcClient().DeleteUser(request)
 	if err != nil {
 		errRet = err
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // basic information
@@ -33,7 +32,6 @@ func (me *DnspodService) ModifyDnsPodDomainStatus(ctx context.Context, domain st
 	request.Status = &status
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseDnsPodClient().ModifyDomainStatus(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -59,7 +57,6 @@ func (me *DnspodService) ModifyDnsPodDomainRemark(ctx context.Context, domain st
 	request.Remark = &remark
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseDnsPodClient().ModifyDomainRemark(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -104,7 +101,6 @@ func (me *DnspodService) DeleteDomain(ctx context.Context, domain string) (errRe
 
 	logId := getLogId(ctx)
 	request := dnspod.NewDeleteDomainRequest()
-	ratelimit.Check(request.GetAction())
 	request.Domain = helper.String(domain)
 
 	response, err := me.client.UseDnsPodClient().DeleteDomain(request)
@@ -137,7 +133,6 @@ func (me *DnspodService) DescribeRecordList(ctx context.Context, request *dnspod
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDnsPodClient().DescribeRecordList(request)
 
 	if err != nil {
@@ -6,7 +6,6 @@ import (
 
 	domain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/domain/v20180808"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DomainService struct {
@@ -22,7 +21,6 @@ func (me *DomainService) DescribeDomainNameList(ctx context.Context, request *do
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDomainClient().DescribeDomainNameList(request)
 
 	if err != nil {
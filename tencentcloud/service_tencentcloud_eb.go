@@ -6,7 +6,6 @@ import (
 
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type EbService struct {
@@ -43,8 +42,6 @@ func (me *EbService) DescribeEbSearchByFilter(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -106,8 +103,6 @@ func (me *EbService) DescribeEbSearchLogByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -148,8 +143,6 @@ func (me *EbService) DescribeEbEventBusById(ctx context.Context, eventBusId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().GetEventBus(request)
 	if err != nil {
 		errRet = err
@@ -177,8 +170,6 @@ func (me *EbService) DeleteEbEventBusById(ctx context.Context, eventBusId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().DeleteEventBus(request)
 	if err != nil {
 		errRet = err
@@ -202,8 +193,6 @@ func (me *EbService) DescribeEbEventTargetById(ctx context.Context, eventBusId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().ListTargets(request)
 	if err != nil {
 		errRet = err
@@ -239,8 +228,6 @@ func (me *EbService) DeleteEbEventTargetById(ctx context.Context, eventBusId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().DeleteTarget(request)
 	if err != nil {
 		errRet = err
@@ -264,8 +251,6 @@ func (me *EbService) DescribeEbEventRuleById(ctx context.Context, eventBusId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().GetRule(request)
 	if err != nil {
 		errRet = err
@@ -294,8 +279,6 @@ func (me *EbService) DeleteEbEventRuleById(ctx context.Context, eventBusId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().DeleteRule(request)
 	if err != nil {
 		errRet = err
@@ -320,8 +303,6 @@ func (me *EbService) DescribeEbEventTransformById(ctx context.Context, eventBusI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().GetTransformation(request)
 	if err != nil {
 		errRet = err
@@ -351,8 +332,6 @@ func (me *EbService) DeleteEbEventTransformById(ctx context.Context, eventBusId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().DeleteTransformation(request)
 	if err != nil {
 		errRet = err
@@ -387,8 +366,6 @@ func (me *EbService) DescribeEbBusByFilter(ctx context.Context, param map[string
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -429,8 +406,6 @@ func (me *EbService) DescribeEbEventConnectorById(ctx context.Context, connectio
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().ListConnections(request)
 	if err != nil {
 		errRet = err
@@ -464,8 +439,6 @@ func (me *EbService) DeleteEbEventConnectorById(ctx context.Context, connectionI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseEbClient().DeleteConnection(request)
 	if err != nil {
 		errRet = err
@@ -500,8 +473,6 @@ func (me *EbService) DescribeEbEventRulesByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -9,7 +9,6 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type EksService struct {
@@ -156,7 +155,6 @@ func (me *EksService) DescribeEksCluster(ctx context.Context, id string) (cluste
 
 	request.ClusterIds = []*string{&id}
 
-	ratelimit.Check("DescribeEksCluster")
 	response, err := me.client.UseTkeClient().DescribeEKSClusters(request)
 	if err != nil {
 		errRet = err
@@ -189,7 +187,6 @@ func (me *EksService) DescribeEksContainerInstanceById(ctx context.Context, id s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeEKSContainerInstances(request)
 
 	if err != nil {
@@ -230,7 +227,6 @@ func (me *EksService) DescribeEksContainerInstancesByFilter(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeEKSContainerInstances(request)
 
 	if err != nil {
@@ -262,7 +258,6 @@ func (me *EksService) DescribeEKSClusterCredential(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeEKSClusterCredential(request)
 
 	if err != nil {
@@ -297,8 +292,6 @@ func (me *EksService) CreateEksCluster(ctx context.Context, request *tke.CreateE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().CreateEKSCluster(request)
 
 	if err != nil {
@@ -322,7 +315,6 @@ func (me *EksService) CreateEksContainerInstances(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateEKSContainerInstances(request)
 
 	if err != nil {
@@ -350,7 +342,6 @@ func (me *EksService) UpdateEksContainerInstances(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().UpdateEKSContainerInstance(request)
 
 	if err != nil {
@@ -374,8 +365,6 @@ func (me *EksService) UpdateEksCluster(ctx context.Context, request *tke.UpdateE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().UpdateEKSCluster(request)
 
 	if err != nil {
@@ -399,8 +388,6 @@ func (me *EksService) DeleteEksCluster(ctx context.Context, request *tke.DeleteE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().DeleteEKSCluster(request)
 
 	if err != nil {
@@ -424,7 +411,6 @@ func (me *EksService) DeleteEksContainerInstance(ctx context.Context, request *t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DeleteEKSContainerInstances(request)
 
 	if err != nil {
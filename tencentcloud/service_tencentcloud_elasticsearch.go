@@ -7,7 +7,6 @@ import (
 	es "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ElasticsearchService struct {
@@ -19,7 +18,6 @@ func (me *ElasticsearchService) DescribeInstanceById(ctx context.Context, instan
 	request := es.NewDescribeInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseEsClient().DescribeInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -59,7 +57,6 @@ func (me *ElasticsearchService) DescribeInstancesByFilter(ctx context.Context, i
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseEsClient().DescribeInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -84,7 +81,6 @@ func (me *ElasticsearchService) DeleteInstance(ctx context.Context, instanceId s
 	request := es.NewDeleteInstanceRequest()
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseEsClient().DeleteInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -117,7 +113,6 @@ func (me *ElasticsearchService) UpdateInstance(ctx context.Context, instanceId,
 	if esAcl != nil {
 		request.EsAcl = esAcl
 	}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseEsClient().UpdateInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -133,7 +128,6 @@ func (me *ElasticsearchService) UpdateInstanceVersion(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.EsVersion = &version
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseEsClient().UpgradeInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -149,7 +143,6 @@ func (me *ElasticsearchService) UpdateInstanceLicense(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.LicenseType = &licenseType
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseEsClient().UpgradeLicense(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -12,7 +12,6 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type EMRService struct {
@@ -21,7 +20,6 @@ type EMRService struct {
 
 func (me *EMRService) UpdateInstance(ctx context.Context, request *emr.ScaleOutInstanceRequest) (id string, err error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseEmrClient().ScaleOutInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -38,7 +36,6 @@ func (me *EMRService) DeleteInstance(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = common.StringPtr(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
 	//API: https://cloud.tencent.com/document/api/589/34261
 	_, err := me.client.UseEmrClient().TerminateInstance(request)
 	if err != nil {
@@ -49,7 +46,7 @@ func (me *EMRService) DeleteInstance(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData) (id string, err error) {
+func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData, tags map[string]string) (id string, err error) {
 	logId := getLogId(ctx)
 	request := emr.NewCreateInstanceRequest()
 	if v, ok := d.GetOk("product_id"); ok {
@@ -164,7 +161,7 @@ func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("extend_fs_field"); ok {
 		request.ExtendFsField = common.StringPtr(v.(string))
 	}
-	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+	if len(tags) > 0 {
 		emrTags := make([]*emr.Tag, 0)
 		for k, v := range tags {
 			tagKey := k
@@ -177,7 +174,6 @@ func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData
 		request.Tags = emrTags
 	}
 
-	ratelimit.Check(request.GetAction())
 	//API: https://cloud.tencent.com/document/api/589/34261
 	response, err := me.client.UseEmrClient().CreateInstance(request)
 	if err != nil {
@@ -193,7 +189,6 @@ func (me *EMRService) DescribeInstances(ctx context.Context, filters map[string]
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	if v, ok := filters["instance_ids"]; ok {
 		instances := v.([]interface{})
@@ -232,7 +227,6 @@ func (me *EMRService) DescribeInstancesById(ctx context.Context, instanceId stri
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.ProjectId = helper.IntInt64(-1)
 	request.InstanceIds = make([]*string, 0)
@@ -257,7 +251,6 @@ func (me *EMRService) DescribeClusterNodes(ctx context.Context, instanceId, node
 	logId := getLogId(ctx)
 	request := emr.NewDescribeClusterNodesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.InstanceId = &instanceId
 	request.NodeFlag = &nodeFlag
@@ -335,7 +328,6 @@ func (me *EMRService) ModifyResourcesTags(ctx context.Context, region string, in
 	}
 
 	request := emr.NewModifyResourcesTagsRequest()
-	ratelimit.Check(request.GetAction())
 	request.ModifyType = helper.String("Cluster")
 	request.ModifyResourceTagsInfoList = []*emr.ModifyResourceTags{modifyResourceTags}
 
@@ -14,7 +14,6 @@ import (
 	gaap "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gaap/v20180529"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type gaapRealserverBind struct {
@@ -54,8 +53,6 @@ func (me *GaapService) CreateRealserver(ctx context.Context, address, name strin
 	request.ProjectId = helper.IntUint64(projectId)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().AddRealServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -122,8 +119,6 @@ func (me *GaapService) DescribeRealservers(ctx context.Context, address, name *s
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeRealServers(request)
 			if err != nil {
 				count = 0
@@ -156,8 +151,6 @@ func (me *GaapService) ModifyRealserverName(ctx context.Context, id, name string
 	request.RealServerName = &name
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().ModifyRealServerName(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -178,8 +171,6 @@ func (me *GaapService) DeleteRealserver(ctx context.Context, id string) error {
 	request.RealServerIds = []*string{&id}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().RemoveRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -204,8 +195,6 @@ func (me *GaapService) createCertificate(ctx context.Context, certificateType in
 	request.CertificateKey = key
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().CreateCertificate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -236,8 +225,6 @@ func (me *GaapService) DescribeCertificateById(ctx context.Context, id string) (
 	request.CertificateId = &id
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeCertificateDetail(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -269,8 +256,6 @@ func (me *GaapService) ModifyCertificateName(ctx context.Context, id, name strin
 	request.CertificateAlias = &name
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().ModifyCertificateAttributes(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -292,8 +277,6 @@ func (me *GaapService) DeleteCertificate(ctx context.Context, id string) error {
 	request.CertificateId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().DeleteCertificate(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -336,7 +319,6 @@ func (me *GaapService) CreateProxy(
 	}
 
 	if err := resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
 		createRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.CreateProxy(createRequest)
@@ -363,8 +345,6 @@ func (me *GaapService) CreateProxy(
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -418,7 +398,6 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	enableRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
 		enableRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.OpenProxies(enableRequest)
@@ -450,8 +429,6 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -497,7 +474,6 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	disableRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
 		disableRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.CloseProxies(disableRequest)
@@ -529,8 +505,6 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -615,8 +589,6 @@ func (me *GaapService) DescribeProxies(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeProxies(request)
 			if err != nil {
 				count = 0
@@ -655,7 +627,6 @@ func (me *GaapService) ModifyProxyName(ctx context.Context, id, name string) err
 	request.ProxyName = &name
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		request.ClientToken = helper.String(helper.BuildToken())
 
 		if _, err := me.client.UseGaapClient().ModifyProxiesAttribute(request); err != nil {
@@ -680,7 +651,6 @@ func (me *GaapService) ModifyProxyProjectId(ctx context.Context, id string, proj
 	request.ProjectId = helper.IntInt64(projectId)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		request.ClientToken = helper.String(helper.BuildToken())
 
 		if _, err := me.client.UseGaapClient().ModifyProxiesProject(request); err != nil {
@@ -711,7 +681,6 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 	}
 
 	if err := resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(modifyRequest.GetAction())
 		modifyRequest.ClientToken = helper.String(helper.BuildToken())
 
 		if _, err := client.ModifyProxyConfiguration(modifyRequest); err != nil {
@@ -729,8 +698,6 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -777,7 +744,6 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	deleteRequest.Force = helper.IntInt64(1)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
 		deleteRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.DestroyProxies(deleteRequest)
@@ -809,8 +775,6 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -864,8 +828,6 @@ func (me *GaapService) CreateTCPListener(
 	request.ClientIPMethod = helper.IntInt64(clientIPMethod)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.CreateTCPListeners(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -910,8 +872,6 @@ func (me *GaapService) CreateUDPListener(
 	request.Ports = []*uint64{helper.IntUint64(port)}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.CreateUDPListeners(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -957,8 +917,6 @@ func (me *GaapService) BindLayer4ListenerRealservers(ctx context.Context, id, pr
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.BindListenerRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1007,8 +965,6 @@ func (me *GaapService) DescribeTCPListeners(ctx context.Context, proxyId, listen
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeTCPListeners(request)
 			if err != nil {
 				count = 0
@@ -1068,8 +1024,6 @@ func (me *GaapService) DescribeUDPListeners(ctx context.Context, proxyId, id, na
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeUDPListeners(request)
 			if err != nil {
 				count = 0
@@ -1126,8 +1080,6 @@ func (me *GaapService) ModifyTCPListenerAttribute(
 	request.ConnectTimeout = helper.IntUint64(connectTimeout)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyTCPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1162,8 +1114,6 @@ func (me *GaapService) ModifyUDPListenerAttribute(
 	request.Scheduler = scheduler
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyUDPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1193,8 +1143,6 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		response, err := client.DeleteListeners(deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1233,8 +1181,6 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
-
 			response, err := client.DescribeTCPListeners(describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1265,8 +1211,6 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
-
 			response, err := client.DescribeUDPListeners(describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1304,8 +1248,6 @@ func (me *GaapService) CreateSecurityPolicy(ctx context.Context, proxyId, action
 	request.DefaultAction = &action
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().CreateSecurityPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1337,8 +1279,6 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	enableRequest.ProxyId = &proxyId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
-
 		if _, err := client.OpenSecurityPolicy(enableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, enableRequest.GetAction(), enableRequest.ToJsonString(), err)
@@ -1354,8 +1294,6 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	describeRequest.PolicyId = &policyId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1397,8 +1335,6 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	disableRequest.ProxyId = &proxyId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
-
 		if _, err := client.CloseSecurityPolicy(disableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, disableRequest.GetAction(), disableRequest.ToJsonString(), err)
@@ -1414,8 +1350,6 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	describeRequest.PolicyId = &policyId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1456,8 +1390,6 @@ func (me *GaapService) DescribeSecurityPolicy(ctx context.Context, id string) (p
 	request.PolicyId = &id
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1511,8 +1443,6 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	deleteRequest.PolicyId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteSecurityPolicy(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -1528,8 +1458,6 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	describeRequest.PolicyId = &id
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		_, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1572,8 +1500,6 @@ func (me *GaapService) CreateSecurityRule(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().CreateSecurityRules(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1610,8 +1536,6 @@ func (me *GaapService) DescribeSecurityRule(ctx context.Context, id string) (sec
 	request.SecurityRuleIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeSecurityRules(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1662,8 +1586,6 @@ func (me *GaapService) ModifySecurityRule(ctx context.Context, policyId, ruleId,
 	request.AliasName = &name
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().ModifySecurityRule(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1686,8 +1608,6 @@ func (me *GaapService) DeleteSecurityRule(ctx context.Context, policyId, ruleId
 	request.RuleIdList = []*string{&ruleId}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().DeleteSecurityRules(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1712,8 +1632,6 @@ func (me *GaapService) CreateHTTPListener(ctx context.Context, name, proxyId str
 	request.Port = helper.IntUint64(port)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.CreateHTTPListener(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1761,8 +1679,6 @@ func (me *GaapService) CreateHTTPSListener(
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.CreateHTTPSListener(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1824,8 +1740,6 @@ func (me *GaapService) DescribeHTTPListeners(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeHTTPListeners(request)
 			if err != nil {
 				count = 0
@@ -1889,8 +1803,6 @@ func (me *GaapService) DescribeHTTPSListeners(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeHTTPSListeners(request)
 			if err != nil {
 				count = 0
@@ -1931,8 +1843,6 @@ func (me *GaapService) ModifyHTTPListener(ctx context.Context, id, proxyId, name
 	request.ProxyId = &proxyId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyHTTPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1970,8 +1880,6 @@ func (me *GaapService) ModifyHTTPSListener(
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyHTTPSListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2001,8 +1909,6 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		response, err := client.DeleteListeners(deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -2043,8 +1949,6 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
-
 			response, err := client.DescribeHTTPListeners(describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2077,8 +1981,6 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
-
 			response, err := client.DescribeHTTPSListeners(describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2117,8 +2019,6 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request.ListenerId = &id
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.DescribeTCPListeners(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -2161,8 +2061,6 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request.ListenerId = &id
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.DescribeUDPListeners(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -2214,8 +2112,6 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		request.ListenerId = &id
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.DescribeHTTPListeners(request)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2265,8 +2161,6 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		request.ListenerId = &id
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.DescribeHTTPSListeners(request)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2322,8 +2216,6 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	createRequest.Domain = &domain
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
-
 		if _, err := client.CreateDomain(createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -2339,8 +2231,6 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2394,8 +2284,6 @@ func (me *GaapService) CreateHTTPSDomain(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
-
 		if _, err := client.CreateDomain(createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -2411,8 +2299,6 @@ func (me *GaapService) CreateHTTPSDomain(
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2490,8 +2376,6 @@ func (me *GaapService) SetAdvancedAuth(
 	request.GaapCertificateId = gaapAuthId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().SetAuthentication(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2513,8 +2397,6 @@ func (me *GaapService) DescribeDomain(ctx context.Context, listenerId, domain st
 	request.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeRules(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2566,8 +2448,6 @@ func (me *GaapService) ModifyDomainCertificate(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseGaapClient().ModifyCertificate(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2592,8 +2472,6 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteDomain(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -2609,8 +2487,6 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2682,8 +2558,6 @@ func (me *GaapService) CreateHttpRule(ctx context.Context, httpRule gaapHttpRule
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.CreateRule(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -2730,8 +2604,6 @@ func (me *GaapService) BindHttpRuleRealservers(ctx context.Context, listenerId,
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.BindRuleRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2758,8 +2630,6 @@ func (me *GaapService) DescribeHttpRule(ctx context.Context, id string) (rule *g
 	request.RuleIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeRulesByRuleIds(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2824,8 +2694,6 @@ func (me *GaapService) ModifyHTTPRuleAttribute(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyRuleAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2850,8 +2718,6 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	deleteRequest.Force = helper.IntUint64(1)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteRule(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -2867,8 +2733,6 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2913,8 +2777,6 @@ func waitHttpRuleReady(ctx context.Context, client *gaap.Client, listenerId, rul
 	request.ListenerId = &listenerId
 
 	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.DescribeRules(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2965,8 +2827,6 @@ func (me *GaapService) DescribeDomains(ctx context.Context, listenerId, domain s
 	request.ListenerId = &listenerId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeRules(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3007,8 +2867,6 @@ func (me *GaapService) DescribeSecurityRules(ctx context.Context, policyId strin
 	request.PolicyId = &policyId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3051,8 +2909,6 @@ func (me *GaapService) DescribeCertificates(ctx context.Context, id, name *strin
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseGaapClient().DescribeCertificates(request)
 			if err != nil {
 				count = 0
@@ -3123,8 +2979,6 @@ func (me *GaapService) ModifyHTTPRuleForwardHost(ctx context.Context, listenerId
 	request.ForwardHost = &forwardHost
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyRuleAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
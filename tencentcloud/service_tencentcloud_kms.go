@@ -15,7 +15,6 @@ import (
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type KmsService struct {
@@ -62,7 +61,6 @@ func (me *KmsService) DescribeKeysByFilter(ctx context.Context, param map[string
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseKmsClient().ListKeyDetail(request)
 		if err != nil {
 			errRet = errors.WithStack(err)
@@ -88,7 +86,6 @@ func (me *KmsService) DescribeKeyById(ctx context.Context, keyId string) (key *k
 	logId := getLogId(ctx)
 	request := kms.NewDescribeKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeKey(request)
 	if err != nil {
@@ -113,7 +110,6 @@ func (me *KmsService) CreateKey(ctx context.Context, keyType uint64, alias, desc
 	if keyUsage != "" {
 		request.KeyUsage = helper.String(keyUsage)
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CreateKey(request)
 	if err != nil {
@@ -131,7 +127,6 @@ func (me *KmsService) EnableKeyRotation(ctx context.Context, keyId string) (errR
 	logId := getLogId(ctx)
 	request := kms.NewEnableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().EnableKeyRotation(request)
 	if err != nil {
@@ -148,7 +143,6 @@ func (me *KmsService) DisableKeyRotation(ctx context.Context, keyId string) (err
 	logId := getLogId(ctx)
 	request := kms.NewDisableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DisableKeyRotation(request)
 	if err != nil {
@@ -165,7 +159,6 @@ func (me *KmsService) EnableKey(ctx context.Context, keyId string) (errRet error
 	logId := getLogId(ctx)
 	request := kms.NewEnableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().EnableKey(request)
 	if err != nil {
@@ -182,7 +175,6 @@ func (me *KmsService) DisableKey(ctx context.Context, keyId string) (errRet erro
 	logId := getLogId(ctx)
 	request := kms.NewDisableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DisableKey(request)
 	if err != nil {
@@ -199,7 +191,6 @@ func (me *KmsService) ArchiveKey(ctx context.Context, keyId string) (errRet erro
 	logId := getLogId(ctx)
 	request := kms.NewArchiveKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().ArchiveKey(request)
 	if err != nil {
@@ -216,7 +207,6 @@ func (me *KmsService) CancelKeyArchive(ctx context.Context, keyId string) (errRe
 	logId := getLogId(ctx)
 	request := kms.NewCancelKeyArchiveRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CancelKeyArchive(request)
 	if err != nil {
@@ -232,7 +222,6 @@ func (me *KmsService) CancelKeyDeletion(ctx context.Context, keyId string) (errR
 	logId := getLogId(ctx)
 	request := kms.NewCancelKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CancelKeyDeletion(request)
 	if err != nil {
@@ -250,7 +239,6 @@ func (me *KmsService) UpdateKeyDescription(ctx context.Context, keyId, descripti
 	request := kms.NewUpdateKeyDescriptionRequest()
 	request.KeyId = helper.String(keyId)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().UpdateKeyDescription(request)
 	if err != nil {
@@ -268,7 +256,6 @@ func (me *KmsService) UpdateKeyAlias(ctx context.Context, keyId, alias string) (
 	request := kms.NewUpdateAliasRequest()
 	request.KeyId = helper.String(keyId)
 	request.Alias = helper.String(alias)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().UpdateAlias(request)
 	if err != nil {
@@ -286,7 +273,6 @@ func (me *KmsService) DeleteKey(ctx context.Context, keyId string, pendingDelete
 	request := kms.NewScheduleKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
 	request.PendingWindowInDays = helper.Uint64(pendingDeleteWindowInDays)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().ScheduleKeyDeletion(request)
 	if err != nil {
@@ -326,7 +312,6 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	paramRequest.KeyId = helper.String(keyId)
 	paramRequest.WrappingAlgorithm = helper.String(wrappingAlgorithm)
 	paramRequest.WrappingKeySpec = helper.String(wrappingKeySpec)
-	ratelimit.Check(paramRequest.GetAction())
 
 	paramResponse, err := me.client.UseKmsClient().GetParametersForImport(paramRequest)
 	if err != nil {
@@ -375,7 +360,6 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	importRequest.ValidTo = helper.Uint64(validTo)
 	importRequest.ImportToken = paramResponse.Response.ImportToken
 	importRequest.EncryptedKeyMaterial = helper.String(encryptedKeyMaterial)
-	ratelimit.Check(importRequest.GetAction())
 
 	importResponse, err := me.client.UseKmsClient().ImportKeyMaterial(importRequest)
 	if err != nil {
@@ -392,7 +376,6 @@ func (me *KmsService) DeleteImportKeyMaterial(ctx context.Context, keyId string)
 	logId := getLogId(ctx)
 	request := kms.NewDeleteImportedKeyMaterialRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DeleteImportedKeyMaterial(request)
 	if err != nil {
@@ -423,8 +406,6 @@ func (me *KmsService) DescribeKmsPublicKeyByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseKmsClient().GetPublicKey(request)
 	if err != nil {
 		errRet = err
@@ -467,8 +448,6 @@ func (me *KmsService) DescribeKmsGetParametersForImportByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseKmsClient().GetParametersForImport(request)
 	if err != nil {
 		errRet = err
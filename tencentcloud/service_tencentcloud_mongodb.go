@@ -11,7 +11,6 @@ import (
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MongodbService struct {
@@ -24,7 +23,6 @@ func (me *MongodbService) DescribeInstanceById(ctx context.Context, instanceId s
 	request.InstanceIds = []*string{&instanceId}
 	var response *mongodb.DescribeDBInstancesResponse
 	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().DescribeDBInstances(request)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -64,7 +62,6 @@ func (me *MongodbService) ModifyInstanceName(ctx context.Context, instanceId, in
 	request := mongodb.NewRenameInstanceRequest()
 	request.InstanceId = &instanceId
 	request.NewName = &instanceName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().RenameInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -84,7 +81,6 @@ func (me *MongodbService) ResetInstancePassword(ctx context.Context, instanceId,
 	request.Password = &password
 	var response *mongodb.ResetDBInstancePasswordResponse
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().ResetDBInstancePassword(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -117,7 +113,6 @@ func (me *MongodbService) UpgradeInstance(ctx context.Context, instanceId string
 	var response *mongodb.ModifyDBInstanceSpecResponse
 	tradeError := false
 	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().ModifyDBInstanceSpec(request)
 		if e != nil {
 			// request might be accepted between "InvalidParameterValue.InvalidTradeOperation" and "InvalidParameterValue.StatusAbnormal" error
@@ -151,7 +146,6 @@ func (me *MongodbService) ModifyProjectId(ctx context.Context, instanceId string
 	request := mongodb.NewAssignProjectRequest()
 	request.InstanceIds = []*string{&instanceId}
 	request.ProjectId = helper.IntUint64(projectId)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().AssignProject(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -169,7 +163,6 @@ func (me *MongodbService) DescribeSpecInfo(ctx context.Context, zone string) (in
 	if zone != "" {
 		request.Zone = &zone
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().DescribeSpecInfo(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -189,7 +182,6 @@ func (me *MongodbService) ModifySecurityGroups(ctx context.Context, instanceId s
 	request := mongodb.NewModifyDBInstanceSecurityGroupRequest()
 	request.InstanceId = &instanceId
 	request.SecurityGroupIds = securityGroups
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().ModifyDBInstanceSecurityGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -208,7 +200,6 @@ func (me *MongodbService) ModifyNetworkAddress(ctx context.Context, instanceId s
 	request.NewUniqVpcId = &vpcId
 	request.NewUniqSubnetId = &subnetId
 	request.OldIpExpiredTime = helper.Uint64(0)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().ModifyDBInstanceNetworkAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -244,7 +235,6 @@ func (me *MongodbService) DescribeInstancesByFilter(ctx context.Context, instanc
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseMongodbClient().DescribeDBInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -280,7 +270,6 @@ func (me *MongodbService) IsolateInstance(ctx context.Context, instanceId string
 	}()
 	var response *mongodb.IsolateDBInstanceResponse
 	err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().IsolateDBInstance(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -309,7 +298,6 @@ func (me *MongodbService) TerminateDBInstances(ctx context.Context, instanceId s
 	}()
 	var response *mongodb.TerminateDBInstancesResponse
 	err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().TerminateDBInstances(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -340,7 +328,6 @@ func (me *MongodbService) ModifyAutoRenewFlag(ctx context.Context, instanceId st
 		}
 	}()
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseMongodbClient().RenewDBInstances(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -360,7 +347,6 @@ func (me *MongodbService) DescribeAsyncRequestInfo(ctx context.Context, asyncId
 	request := mongodb.NewDescribeAsyncRequestInfoRequest()
 	request.AsyncRequestId = &asyncId
 	err := resource.Retry(timeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseMongodbClient().DescribeAsyncRequestInfo(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -393,7 +379,6 @@ func (me *MongodbService) OfflineIsolatedDBInstance(ctx context.Context, instanc
 	var response *mongodb.OfflineIsolatedDBInstanceResponse
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseMongodbClient().OfflineIsolatedDBInstance(request)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -412,7 +397,6 @@ func (me *MongodbService) OfflineIsolatedDBInstance(ctx context.Context, instanc
 	checkRequest := mongodb.NewDescribeDBInstancesRequest()
 	checkRequest.InstanceIds = []*string{&instanceId}
 	err = resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(checkRequest.GetAction())
 		result, e := me.client.UseMongodbClient().DescribeDBInstances(checkRequest)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -436,7 +420,6 @@ func (me *MongodbService) DescribeSecurityGroup(ctx context.Context, instanceId
 	logId := getLogId(ctx)
 	request := mongodb.NewDescribeSecurityGroupRequest()
 	request.InstanceId = helper.String(instanceId)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().DescribeSecurityGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -455,7 +438,6 @@ func (me *MongodbService) DescribeDBInstanceNodeProperty(ctx context.Context, in
 	logId := getLogId(ctx)
 	request := mongodb.NewDescribeDBInstanceNodePropertyRequest()
 	request.InstanceId = helper.String(instanceId)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMongodbClient().DescribeDBInstanceNodeProperty(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -482,8 +464,6 @@ func (me *MongodbService) DescribeMongodbInstanceAccountById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMongodbClient().DescribeAccountUsers(request)
 	if err != nil {
 		errRet = err
@@ -518,8 +498,6 @@ func (me *MongodbService) DeleteMongodbInstanceAccountById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMongodbClient().DeleteAccountUser(request)
 	if err != nil {
 		errRet = err
@@ -548,8 +526,6 @@ func (me *MongodbService) DescribeMongodbInstanceBackupDownloadTaskById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMongodbClient().DescribeBackupDownloadTask(request)
 	if err != nil {
 		errRet = err
@@ -586,8 +562,6 @@ func (me *MongodbService) DescribeMongodbInstanceBackupsByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -634,8 +608,6 @@ func (me *MongodbService) DescribeMongodbInstanceConnectionsByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -703,8 +675,6 @@ func (me *MongodbService) DescribeMongodbInstanceCurrentOpByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -751,8 +721,6 @@ func (me *MongodbService) DescribeMongodbInstanceParams(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMongodbClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -795,8 +763,6 @@ func (me *MongodbService) DescribeMongodbInstanceSlowLogByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
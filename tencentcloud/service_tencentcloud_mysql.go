@@ -12,7 +12,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MysqlService struct {
@@ -68,9 +67,7 @@ needMoreItems:
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeBackups(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -106,8 +103,7 @@ func (me *MysqlService) CreateBackup(ctx context.Context, mysqlId string) (backu
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CreateBackup(request)
+	response, err := me.client.UseMysqlClient().CreateBackupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -127,8 +123,7 @@ func (me *MysqlService) DescribeDBZoneConfig(ctx context.Context) (sellConfigure
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeCdbZoneConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeCdbZoneConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -149,8 +144,7 @@ func (me *MysqlService) DescribeBackupConfigByMysqlId(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeBackupConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -176,8 +170,7 @@ func (me *MysqlService) ModifyBackupConfigByMysqlId(ctx context.Context, mysqlId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyBackupConfig(request)
+	response, err := me.client.UseMysqlClient().ModifyBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -199,8 +192,7 @@ func (me *MysqlService) DescribeDefaultParameters(ctx context.Context, engineVer
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDefaultParams(request)
+	response, err := me.client.UseMysqlClient().DescribeDefaultParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -227,8 +219,7 @@ func (me *MysqlService) DescribeInstanceParameters(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
+	response, err := me.client.UseMysqlClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -258,8 +249,7 @@ func (me *MysqlService) ModifyInstanceParam(ctx context.Context, instanceId stri
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyInstanceParam(request)
+	response, err := me.client.UseMysqlClient().ModifyInstanceParamWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -323,8 +313,7 @@ func (me *MysqlService) CreateAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CreateAccounts(request)
+	response, err := me.client.UseMysqlClient().CreateAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -353,8 +342,7 @@ func (me *MysqlService) ModifyAccountPassword(ctx context.Context, mysqlId strin
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountPassword(request)
+	response, err := me.client.UseMysqlClient().ModifyAccountPasswordWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -382,8 +370,7 @@ func (me *MysqlService) ModifyAccountMaxUserConnections(ctx context.Context, mys
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountMaxUserConnections(request)
+	response, err := me.client.UseMysqlClient().ModifyAccountMaxUserConnectionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -412,8 +399,7 @@ func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().UpgradeDBInstanceEngineVersion(request)
+	response, err := me.client.UseMysqlClient().UpgradeDBInstanceEngineVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -439,8 +425,7 @@ func (me *MysqlService) ModifyAccountHost(ctx context.Context, mysqlId, accountN
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountHost(request)
+	response, err := me.client.UseMysqlClient().ModifyAccountHostWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -469,8 +454,7 @@ func (me *MysqlService) ModifyAccountDescription(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountDescription(request)
+	response, err := me.client.UseMysqlClient().ModifyAccountDescriptionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -498,8 +482,7 @@ func (me *MysqlService) DeleteAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DeleteAccounts(request)
+	response, err := me.client.UseMysqlClient().DeleteAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -535,8 +518,7 @@ func (me *MysqlService) DescribeAccounts(ctx context.Context, mysqlId string) (a
 	}()
 
 needMoreItems:
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAccounts(request)
+	response, err := me.client.UseMysqlClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -570,8 +552,7 @@ func (me *MysqlService) _innerDescribeAsyncRequestInfo(ctx context.Context, asyn
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAsyncRequestInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeAsyncRequestInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -584,27 +565,39 @@ func (me *MysqlService) _innerDescribeAsyncRequestInfo(ctx context.Context, asyn
 	return
 }
 
-func (me *MysqlService) DescribeAsyncRequestInfo(ctx context.Context, asyncRequestId string) (status, message string, errRet error) {
-
-	// Post https://cdb.tencentcloudapi.com/:  always get "Gateway Time-out"
-	status, message, errRet = me._innerDescribeAsyncRequestInfo(ctx, asyncRequestId)
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			status, message, errRet = me._innerDescribeAsyncRequestInfo(ctx, asyncRequestId)
+// mysqlRetryGatewayError calls f again after each of the delays while it fails without an API error, e.g.
+// the "Gateway Time-out" of the endpoint, and stops retrying once ctx is done.
+func mysqlRetryGatewayError(ctx context.Context, delays []time.Duration, f func() error) error {
+	err := f()
+	for _, delay := range delays {
+		if err == nil {
+			return nil
 		}
-	}
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			time.Sleep(2 * time.Second)
-			status, message, errRet = me._innerDescribeAsyncRequestInfo(ctx, asyncRequestId)
+		if _, ok := err.(*errors.TencentCloudSDKError); ok {
+			return err
 		}
-	}
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			time.Sleep(5 * time.Second)
-			status, message, errRet = me._innerDescribeAsyncRequestInfo(ctx, asyncRequestId)
+		if ctx.Err() != nil {
+			return err
+		}
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay):
+			}
 		}
+		err = f()
 	}
+	return err
+}
+
+func (me *MysqlService) DescribeAsyncRequestInfo(ctx context.Context, asyncRequestId string) (status, message string, errRet error) {
+
+	// Post https://cdb.tencentcloudapi.com/:  always get "Gateway Time-out"
+	errRet = mysqlRetryGatewayError(ctx, []time.Duration{0, 2 * time.Second, 5 * time.Second}, func() (err error) {
+		status, message, err = me._innerDescribeAsyncRequestInfo(ctx, asyncRequestId)
+		return
+	})
 	return
 }
 
@@ -638,8 +631,7 @@ func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountPrivileges(request)
+	response, err := me.client.UseMysqlClient().ModifyAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -670,8 +662,7 @@ func (me *MysqlService) DescribeAccountPrivileges(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAccountPrivileges(request)
+	response, err := me.client.UseMysqlClient().DescribeAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -718,26 +709,10 @@ func (me *MysqlService) DescribeAccountPrivileges(ctx context.Context, mysqlId s
 func (me *MysqlService) DescribeDBInstanceById(ctx context.Context, mysqlId string) (mysqlInfo *cdb.InstanceInfo, errRet error) {
 
 	// Post https://cdb.tencentcloudapi.com/:  always get "Gateway Time-out"
-	mysqlInfo, errRet = me._innerDescribeDBInstanceById(ctx, mysqlId)
-
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			mysqlInfo, errRet = me._innerDescribeDBInstanceById(ctx, mysqlId)
-		}
-	}
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			time.Sleep(3 * time.Second)
-			mysqlInfo, errRet = me._innerDescribeDBInstanceById(ctx, mysqlId)
-
-		}
-	}
-	if errRet != nil {
-		if _, ok := errRet.(*errors.TencentCloudSDKError); !ok {
-			time.Sleep(5 * time.Second)
-			mysqlInfo, errRet = me._innerDescribeDBInstanceById(ctx, mysqlId)
-		}
-	}
+	errRet = mysqlRetryGatewayError(ctx, []time.Duration{0, 3 * time.Second, 5 * time.Second}, func() (err error) {
+		mysqlInfo, err = me._innerDescribeDBInstanceById(ctx, mysqlId)
+		return
+	})
 	return
 }
 
@@ -757,8 +732,7 @@ func (me *MysqlService) DescribeIsolatedDBInstanceById(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -791,8 +765,7 @@ func (me *MysqlService) _innerDescribeDBInstanceById(ctx context.Context, mysqlI
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -826,8 +799,7 @@ func (me *MysqlService) DescribeRunningDBInstanceById(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -859,8 +831,7 @@ func (me *MysqlService) CheckDBGTIDOpen(ctx context.Context, mysqlId string) (op
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceGTID(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceGTIDWithContext(ctx, request)
 	if err != nil {
 		sdkErr, ok := err.(*errors.TencentCloudSDKError)
 		if ok && sdkErr.Code == "CdbError" {
@@ -885,8 +856,7 @@ func (me *MysqlService) DescribeDBSecurityGroups(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -932,8 +902,7 @@ func (me *MysqlService) ModifyInstanceTag(ctx context.Context, mysqlId string, d
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyInstanceTag(request)
+	response, err := me.client.UseMysqlClient().ModifyInstanceTagWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -968,8 +937,7 @@ again:
 	} else {
 		offset = offset + limit
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeTagsOfInstanceIds(request)
+	response, err := me.client.UseMysqlClient().DescribeTagsOfInstanceIdsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1005,8 +973,7 @@ func (me *MysqlService) DescribeDBInstanceConfig(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceConfigWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1059,8 +1026,7 @@ func (me *MysqlService) InitDBInstances(ctx context.Context, mysqlId, password,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().InitDBInstances(request)
+	response, err := me.client.UseMysqlClient().InitDBInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1087,8 +1053,7 @@ func (me *MysqlService) OpenWanService(ctx context.Context, mysqlId string) (asy
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().OpenWanService(request)
+	response, err := me.client.UseMysqlClient().OpenWanServiceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1109,8 +1074,7 @@ func (me *MysqlService) CloseWanService(ctx context.Context, mysqlId string) (as
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CloseWanService(request)
+	response, err := me.client.UseMysqlClient().CloseWanServiceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1131,8 +1095,7 @@ func (me *MysqlService) OpenDBInstanceGTID(ctx context.Context, mysqlId string)
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().OpenDBInstanceGTID(request)
+	response, err := me.client.UseMysqlClient().OpenDBInstanceGTIDWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1156,8 +1119,7 @@ func (me *MysqlService) ModifyDBInstanceName(ctx context.Context, mysqlId,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceName(request)
+	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceNameWithContext(ctx, request)
 
 	if errRet != nil {
 		return
@@ -1186,8 +1148,7 @@ func (me *MysqlService) ModifyDBInstanceVipVport(ctx context.Context, mysqlId, v
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceVipVport(request)
+	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceVipVportWithContext(ctx, request)
 
 	if errRet != nil {
 		return
@@ -1226,8 +1187,7 @@ func (me *MysqlService) UpgradeDBInstance(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().UpgradeDBInstance(request)
+	response, err := me.client.UseMysqlClient().UpgradeDBInstanceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1251,8 +1211,7 @@ func (me *MysqlService) ModifyDBInstanceProject(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyDBInstanceProject(request)
+	response, err := me.client.UseMysqlClient().ModifyDBInstanceProjectWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1282,8 +1241,7 @@ func (me *MysqlService) ModifyDBInstanceSecurityGroups(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyDBInstanceSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1308,8 +1266,7 @@ func (me *MysqlService) DisassociateSecurityGroup(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DisassociateSecurityGroupsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1334,8 +1291,7 @@ func (me *MysqlService) ModifyAutoRenewFlag(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAutoRenewFlag(request)
+	response, err := me.client.UseMysqlClient().ModifyAutoRenewFlagWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1358,8 +1314,7 @@ func (me *MysqlService) IsolateDBInstance(ctx context.Context, mysqlId string) (
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().IsolateDBInstance(request)
+	response, err := me.client.UseMysqlClient().IsolateDBInstanceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1384,8 +1339,7 @@ func (me *MysqlService) OfflineIsolatedInstances(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	_, errRet = me.client.UseMysqlClient().OfflineIsolatedInstances(request)
+	_, errRet = me.client.UseMysqlClient().OfflineIsolatedInstancesWithContext(ctx, request)
 
 	return
 }
@@ -1402,9 +1356,7 @@ func (me *MysqlService) DescribeMysqlTimeWindowById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeTimeWindow(request)
+	response, err := me.client.UseMysqlClient().DescribeTimeWindowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1427,9 +1379,7 @@ func (me *MysqlService) DeleteMysqlTimeWindowById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DeleteTimeWindow(request)
+	response, err := me.client.UseMysqlClient().DeleteTimeWindowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1451,9 +1401,7 @@ func (me *MysqlService) DescribeMysqlParamTemplateById(ctx context.Context, temp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeParamTemplateInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeParamTemplateInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1476,9 +1424,7 @@ func (me *MysqlService) DescribeMysqlParamTemplateInfoById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeParamTemplates(request)
+	response, err := me.client.UseMysqlClient().DescribeParamTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1505,9 +1451,7 @@ func (me *MysqlService) DeleteMysqlParamTemplateById(ctx context.Context, templa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DeleteParamTemplate(request)
+	response, err := me.client.UseMysqlClient().DeleteParamTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1529,8 +1473,6 @@ func (me *MysqlService) DescribeMysqlDeployGroupById(ctx context.Context, deploy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1539,7 +1481,7 @@ func (me *MysqlService) DescribeMysqlDeployGroupById(ctx context.Context, deploy
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDeployGroupList(request)
+		response, err := me.client.UseMysqlClient().DescribeDeployGroupListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1576,9 +1518,7 @@ func (me *MysqlService) DeleteMysqlDeployGroupById(ctx context.Context, deployGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DeleteDeployGroups(request)
+	response, err := me.client.UseMysqlClient().DeleteDeployGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1600,9 +1540,7 @@ func (me *MysqlService) DescribeMysqlSecurityGroupsAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1635,9 +1573,7 @@ func (me *MysqlService) DeleteMysqlSecurityGroupsAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1659,9 +1595,7 @@ func (me *MysqlService) DescribeMysqlLocalBinlogConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeLocalBinlogConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeLocalBinlogConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1685,9 +1619,7 @@ func (me *MysqlService) DescribeMysqlAuditLogFileById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeAuditLogFiles(request)
+	response, err := me.client.UseMysqlClient().DescribeAuditLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1715,9 +1647,7 @@ func (me *MysqlService) DeleteMysqlAuditLogFileById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DeleteAuditLogFile(request)
+	response, err := me.client.UseMysqlClient().DeleteAuditLogFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1759,9 +1689,7 @@ func (me *MysqlService) DescribeMysqlBackupOverviewByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeBackupOverview(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1799,8 +1727,6 @@ func (me *MysqlService) DescribeMysqlBackupSummariesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1808,7 +1734,7 @@ func (me *MysqlService) DescribeMysqlBackupSummariesByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeBackupSummaries(request)
+		response, err := me.client.UseMysqlClient().DescribeBackupSummariesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1847,8 +1773,6 @@ func (me *MysqlService) DescribeMysqlBinLogByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1856,7 +1780,7 @@ func (me *MysqlService) DescribeMysqlBinLogByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeBinlogs(request)
+		response, err := me.client.UseMysqlClient().DescribeBinlogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1895,8 +1819,7 @@ func (me *MysqlService) DescribeMysqlBinlogBackupOverviewByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeBinlogBackupOverview(request)
+	response, err := me.client.UseMysqlClient().DescribeBinlogBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1929,8 +1852,6 @@ func (me *MysqlService) DescribeMysqlCloneListByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1938,7 +1859,7 @@ func (me *MysqlService) DescribeMysqlCloneListByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeCloneList(request)
+		response, err := me.client.UseMysqlClient().DescribeCloneListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1977,8 +1898,7 @@ func (me *MysqlService) DescribeMysqlDataBackupOverviewByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDataBackupOverview(request)
+	response, err := me.client.UseMysqlClient().DescribeDataBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2012,8 +1932,7 @@ func (me *MysqlService) DescribeMysqlDbFeaturesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBFeatures(request)
+	response, err := me.client.UseMysqlClient().DescribeDBFeaturesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2053,8 +1972,6 @@ func (me *MysqlService) DescribeMysqlInstTablesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2063,7 +1980,7 @@ func (me *MysqlService) DescribeMysqlInstTablesByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeTables(request)
+		response, err := me.client.UseMysqlClient().DescribeTablesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2099,8 +2016,7 @@ func (me *MysqlService) DescribeMysqlInstanceCharsetByFilter(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceCharset(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceCharsetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2130,8 +2046,7 @@ func (me *MysqlService) DescribeMysqlInstanceInfoById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2165,8 +2080,6 @@ func (me *MysqlService) DescribeMysqlInstanceParamRecordByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset      int64 = 0
 		limit       int64 = 20
@@ -2175,7 +2088,7 @@ func (me *MysqlService) DescribeMysqlInstanceParamRecordByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeInstanceParamRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeInstanceParamRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2216,8 +2129,7 @@ func (me *MysqlService) DescribeMysqlInstanceRebootTimeByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceRebootTime(request)
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceRebootTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2247,8 +2159,7 @@ func (me *MysqlService) DescribeMysqlProxyCustomById(ctx context.Context, instan
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeProxyCustomConf(request)
+	response, err := me.client.UseMysqlClient().DescribeProxyCustomConfWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2288,8 +2199,7 @@ func (me *MysqlService) DescribeMysqlRollbackRangeTimeByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeRollbackRangeTime(request)
+	response, err := me.client.UseMysqlClient().DescribeRollbackRangeTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2323,8 +2233,6 @@ func (me *MysqlService) DescribeMysqlSlowLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2332,7 +2240,7 @@ func (me *MysqlService) DescribeMysqlSlowLogByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeSlowLogs(request)
+		response, err := me.client.UseMysqlClient().DescribeSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2395,8 +2303,6 @@ func (me *MysqlService) DescribeMysqlSlowLogDataByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2404,7 +2310,7 @@ func (me *MysqlService) DescribeMysqlSlowLogDataByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeSlowLogData(request)
+		response, err := me.client.UseMysqlClient().DescribeSlowLogDataWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2439,8 +2345,7 @@ func (me *MysqlService) DescribeMysqlSupportedPrivilegesById(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeSupportedPrivileges(request)
+	response, err := me.client.UseMysqlClient().DescribeSupportedPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2470,8 +2375,6 @@ func (me *MysqlService) DescribeMysqlSwitchRecordById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 200
@@ -2479,7 +2382,7 @@ func (me *MysqlService) DescribeMysqlSwitchRecordById(ctx context.Context, insta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDBSwitchRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeDBSwitchRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2518,8 +2421,6 @@ func (me *MysqlService) DescribeMysqlUploadedFilesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2527,7 +2428,7 @@ func (me *MysqlService) DescribeMysqlUploadedFilesByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeUploadedFiles(request)
+		response, err := me.client.UseMysqlClient().DescribeUploadedFilesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2593,8 +2494,6 @@ func (me *MysqlService) DescribeMysqlUserTaskByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2602,7 +2501,7 @@ func (me *MysqlService) DescribeMysqlUserTaskByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeTasks(request)
+		response, err := me.client.UseMysqlClient().DescribeTasksWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2634,9 +2533,7 @@ func (me *MysqlService) DescribeMysqlBackupDownloadRestrictionById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeBackupDownloadRestriction(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupDownloadRestrictionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2659,9 +2556,7 @@ func (me *MysqlService) DescribeMysqlBackupEncryptionStatusById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeBackupEncryptionStatus(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupEncryptionStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2684,8 +2579,6 @@ func (me *MysqlService) DescribeMysqlDbImportJobById(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2693,7 +2586,7 @@ func (me *MysqlService) DescribeMysqlDbImportJobById(ctx context.Context, instan
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDBImportRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeDBImportRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2732,9 +2625,7 @@ func (me *MysqlService) DeleteMysqlDbImportJobById(ctx context.Context, asyncReq
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().StopDBImportJob(request)
+	response, err := me.client.UseMysqlClient().StopDBImportJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2756,9 +2647,7 @@ func (me *MysqlService) DeleteMysqlIsolateInstanceById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().ReleaseIsolatedDBInstances(request)
+	response, err := me.client.UseMysqlClient().ReleaseIsolatedDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2780,9 +2669,7 @@ func (me *MysqlService) DescribeMysqlPasswordComplexityById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
+	response, err := me.client.UseMysqlClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2812,9 +2699,7 @@ func (me *MysqlService) DescribeMysqlProxyById(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeCdbProxyInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeCdbProxyInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2846,9 +2731,7 @@ func (me *MysqlService) ModifyCdbProxyAddressVipAndVPort(ctx context.Context, pr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressVipAndVPort(request)
+	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressVipAndVPortWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2872,9 +2755,7 @@ func (me *MysqlService) ModifyCdbProxyAddressDesc(ctx context.Context, proxyGrou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressDesc(request)
+	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressDescWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2900,9 +2781,7 @@ func (me *MysqlService) UpgradeCDBProxyVersion(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().UpgradeCDBProxyVersion(request)
+	response, err := me.client.UseMysqlClient().UpgradeCDBProxyVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2924,9 +2803,7 @@ func (me *MysqlService) DeleteMysqlProxyById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().CloseCDBProxy(request)
+	response, err := me.client.UseMysqlClient().CloseCDBProxyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2948,9 +2825,7 @@ func (me *MysqlService) DescribeMysqlRemoteBackupConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeRemoteBackupConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeRemoteBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2974,9 +2849,7 @@ func (me *MysqlService) DescribeMysqlRollbackById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeRollbackTaskDetail(request)
+	response, err := me.client.UseMysqlClient().DescribeRollbackTaskDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3002,9 +2875,7 @@ func (me *MysqlService) DeleteMysqlRollbackById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().StopRollback(request)
+	response, err := me.client.UseMysqlClient().StopRollbackWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3027,9 +2898,7 @@ func (me *MysqlService) DescribeMysqlRoGroupById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseMysqlClient().DescribeRoGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeRoGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3080,8 +2949,6 @@ func (me *MysqlService) DescribeMysqlErrorLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3089,7 +2956,7 @@ func (me *MysqlService) DescribeMysqlErrorLogByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeErrorLogData(request)
+		response, err := me.client.UseMysqlClient().DescribeErrorLogDataWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3128,8 +2995,7 @@ func (me *MysqlService) DescribeMysqlProjectSecurityGroupByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeProjectSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3164,8 +3030,7 @@ func (me *MysqlService) DescribeMysqlRoMinScaleByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeRoMinScale(request)
+	response, err := me.client.UseMysqlClient().DescribeRoMinScaleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3201,8 +3066,6 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset   int64 = 0
 		limit    int64 = 20
@@ -3212,7 +3075,7 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDatabases(request)
+		response, err := me.client.UseMysqlClient().DescribeDatabasesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3238,3 +3101,23 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 
 	return
 }
+
+func (me *MysqlService) DescribeDBPrice(ctx context.Context, request *cdb.DescribeDBPriceRequest) (price *cdb.DescribeDBPriceResponseParams, errRet error) {
+	logId := getLogId(ctx)
+	response, err := me.client.UseMysqlClient().DescribeDBPriceWithContext(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.Price == nil {
+		errRet = fmt.Errorf("api[%s] returns no price", request.GetAction())
+		return
+	}
+	price = response.Response
+	return
+}
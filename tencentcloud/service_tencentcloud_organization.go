@@ -8,7 +8,6 @@ import (
 
 	organization "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/organization/v20210331"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type OrganizationService struct {
@@ -35,7 +34,6 @@ func (me *OrganizationService) DescribeOrganizationOrgNode(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseOrganizationClient().DescribeOrganizationNodes(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -83,7 +81,6 @@ func (me *OrganizationService) DeleteOrganizationOrgNodeById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseOrganizationClient().DeleteOrganizationNodes(request)
 	if err != nil {
 		errRet = err
@@ -108,8 +105,6 @@ func (me *OrganizationService) DescribeOrganizationOrgMember(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var offset uint64 = 0
 	var pageSize uint64 = 50
 	instances := make([]*organization.OrgMember, 0)
@@ -117,7 +112,6 @@ func (me *OrganizationService) DescribeOrganizationOrgMember(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseOrganizationClient().DescribeOrganizationMembers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -166,7 +160,6 @@ func (me *OrganizationService) DeleteOrganizationOrgMemberById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseOrganizationClient().DeleteOrganizationMembers(request)
 	if err != nil {
 		errRet = err
@@ -227,7 +220,6 @@ func (me *OrganizationService) DeleteOrganizationPolicySubAccountAttachmentById(
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseOrganizationClient().CancelOrganizationMemberAuthAccount(request)
 	if err != nil {
 		errRet = err
@@ -13,7 +13,6 @@ import (
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type PostgresqlService struct {
@@ -87,7 +86,6 @@ func (me *PostgresqlService) CreatePostgresqlInstance(
 		request.VoucherIds = voucherIds
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().CreateInstances(request)
 	if err != nil {
 		errRet = err
@@ -132,8 +130,6 @@ func (me *PostgresqlService) DescribeOrders(ctx context.Context, dealIds []*stri
 
 	request.DealNames = dealIds
 
-	ratelimit.Check(request.GetAction())
-
 	var response *postgresql.DescribeOrdersResponse
 	err := resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
 		res, err := me.client.UsePostgresqlClient().DescribeOrders(request)
@@ -175,7 +171,6 @@ func (me *PostgresqlService) InitPostgresqlInstance(ctx context.Context, instanc
 	request.AdminPassword = &password
 	request.DBInstanceIdSet = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().InitDBInstances(request)
 	if err != nil {
 		return err
@@ -197,7 +192,6 @@ func (me *PostgresqlService) DescribeSpecinfos(ctx context.Context, zone string)
 	}()
 	request.Zone = &zone
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeProductConfig(request)
 	if err != nil {
 		errRet = err
@@ -221,7 +215,6 @@ func (me *PostgresqlService) ModifyBackupPlan(ctx context.Context, request *post
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().ModifyBackupPlan(request)
 
 	if err != nil {
@@ -244,7 +237,6 @@ func (me *PostgresqlService) DescribeBackupPlans(ctx context.Context, request *p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeBackupPlans(request)
 
 	if err != nil {
@@ -274,7 +266,6 @@ func (me *PostgresqlService) DescribeDBXlogs(ctx context.Context, request *postg
 	offset := 0
 	request.Limit = helper.IntInt64(100)
 	request.Offset = helper.IntInt64(offset)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UsePostgresqlClient().DescribeDBXlogs(request)
 
@@ -304,7 +295,6 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 	if openInternet {
 		request := postgresql.NewOpenDBExtranetAccessRequest()
 		request.DBInstanceId = &instanceId
-		ratelimit.Check(request.GetAction())
 
 		var response *postgresql.OpenDBExtranetAccessResponse
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
@@ -366,7 +356,6 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 	} else {
 		request := postgresql.NewCloseDBExtranetAccessRequest()
 		request.DBInstanceId = &instanceId
-		ratelimit.Check(request.GetAction())
 
 		var response *postgresql.CloseDBExtranetAccessResponse
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
@@ -432,7 +421,6 @@ func (me *PostgresqlService) DescribePostgresqlInstanceById(ctx context.Context,
 	}()
 	request.DBInstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
 	if err != nil {
 		errRet = err
@@ -465,7 +453,6 @@ func (me *PostgresqlService) DescribePostgresqlInstances(ctx context.Context, fi
 		request.Offset = &offset
 		request.Limit = &limit
 		request.Filters = filter
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UsePostgresqlClient().DescribeDBInstances(request)
 		if err != nil {
 			errRet = err
@@ -493,7 +480,6 @@ func (me *PostgresqlService) ModifyPostgresqlInstanceName(ctx context.Context, i
 	request.DBInstanceId = &instanceId
 	request.InstanceName = &name
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceName(request)
 	return err
 }
@@ -510,7 +496,6 @@ func (me *PostgresqlService) UpgradePostgresqlInstance(ctx context.Context, inst
 	request.Storage = helper.IntUint64(storage)
 	request.Memory = helper.IntUint64(memory)
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceSpec(request)
 	return err
 }
@@ -526,7 +511,6 @@ func (me *PostgresqlService) ModifyPostgresqlInstanceProjectId(ctx context.Conte
 	request.DBInstanceIdSet = []*string{&instanceId}
 	request.ProjectId = helper.String(strconv.Itoa(projectId))
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().ModifyDBInstancesProject(request)
 	return err
 }
@@ -542,7 +526,6 @@ func (me *PostgresqlService) SetPostgresqlInstanceAutoRenewFlag(ctx context.Cont
 	request.DBInstanceIdSet = []*string{&instanceId}
 	request.AutoRenewFlag = helper.IntInt64(autoRenewFlag)
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().SetAutoRenewFlag(request)
 	return err
 }
@@ -552,7 +535,6 @@ func (me *PostgresqlService) IsolatePostgresqlInstance(ctx context.Context, inst
 
 	request := postgresql.NewIsolateDBInstancesRequest()
 	request.DBInstanceIdSet = []*string{&instanceId}
-	ratelimit.Check(request.GetAction())
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail,reason[%s]", logId, request.GetAction(), errRet.Error())
@@ -580,7 +562,6 @@ func (me *PostgresqlService) DeletePostgresqlInstance(ctx context.Context, insta
 	}()
 	request.DBInstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().DestroyDBInstance(request)
 	return err
 }
@@ -597,7 +578,6 @@ func (me *PostgresqlService) SetPostgresqlInstanceRootPassword(ctx context.Conte
 	request.UserName = helper.String("root")
 	request.Password = &password
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().ResetAccountPassword(request)
 	return err
 }
@@ -739,7 +719,6 @@ func (me *PostgresqlService) ModifyDBInstanceDeployment(ctx context.Context, req
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().ModifyDBInstanceDeployment(request)
 
 	if err != nil {
@@ -762,7 +741,6 @@ func (me *PostgresqlService) DescribeDBInstanceAttribute(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
 
 	if err != nil {
@@ -787,7 +765,6 @@ func (me *PostgresqlService) DescribeDBEncryptionKeys(ctx context.Context, reque
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeEncryptionKeys(request)
 	if err != nil {
 		errRet = err
@@ -820,7 +797,6 @@ func (me *PostgresqlService) DescribePostgresqlReadonlyGroups(ctx context.Contex
 		request.PageNumber = &offset
 		request.PageSize = &limit
 		request.Filters = filter
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
 		if err != nil {
 			errRet = err
@@ -859,7 +835,6 @@ func (me *PostgresqlService) DescribePostgresqlReadOnlyGroupById(ctx context.Con
 	for {
 		request.PageNumber = &offset
 		request.PageSize = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
 		if err != nil {
 			errRet = err
@@ -886,7 +861,6 @@ func (me *PostgresqlService) DeletePostgresqlReadOnlyGroupById(ctx context.Conte
 	}()
 	request.ReadOnlyGroupId = &groupId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroup(request)
 	return err
 }
@@ -915,8 +889,6 @@ func (me *PostgresqlService) DescribePostgresqlParameterTemplatesByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -957,8 +929,6 @@ func (me *PostgresqlService) DescribePostgresqlParameterTemplateById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeParameterTemplateAttributes(request)
 	if err != nil {
 		errRet = err
@@ -982,8 +952,6 @@ func (me *PostgresqlService) DeletePostgresqlParameterTemplateById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DeleteParameterTemplate(request)
 	if err != nil {
 		errRet = err
@@ -1032,7 +1000,6 @@ func (me *PostgresqlService) DescribePostgresqlReadonlyGroupsByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
 	if err != nil {
 		errRet = err
@@ -1060,8 +1027,6 @@ func (me *PostgresqlService) DescribePostgresqlBackupPlanConfigById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeBackupPlans(request)
 	if err != nil {
 		errRet = err
@@ -1088,8 +1053,6 @@ func (me *PostgresqlService) DescribePostgresqlBackupDownloadRestrictionConfigBy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadRestriction(request)
 	if err != nil {
 		errRet = err
@@ -1119,8 +1082,6 @@ func (me *PostgresqlService) DescribePostgresqlSecurityGroupConfigById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1186,8 +1147,6 @@ func (me *PostgresqlService) DescribePostgresqlDBInstanceNetInfosById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
 	if err != nil {
 		errRet = err
@@ -1218,8 +1177,6 @@ func (me *PostgresqlService) DeletePostgresqlDBInstanceNetworkAccessById(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DeleteDBInstanceNetworkAccess(request)
 	if err != nil {
 		errRet = err
@@ -1316,8 +1273,6 @@ func (me *PostgresqlService) DeletePostgresqlReadonlyGroupNetworkAccessById(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroupNetworkAccess(request)
 	if err != nil {
 		errRet = err
@@ -1425,7 +1380,6 @@ func (me *PostgresqlService) DescribePostgresqlBackupDownloadUrlsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadURL(request)
 	if err != nil {
 		errRet = err
@@ -1471,8 +1425,6 @@ func (me *PostgresqlService) DescribePostgresqlBaseBackupsByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1531,8 +1483,6 @@ func (me *PostgresqlService) DescribePostgresqlLogBackupsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1585,8 +1535,6 @@ func (me *PostgresqlService) DescribePostgresqlDbInstanceClassesByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeClasses(request)
 	if err != nil {
 		errRet = err
@@ -1623,8 +1571,6 @@ func (me *PostgresqlService) DescribePostgresqlDefaultParametersByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeDefaultParameters(request)
 	if err != nil {
 		errRet = err
@@ -1658,8 +1604,6 @@ func (me *PostgresqlService) DescribePostgresqlRecoveryTimeByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeAvailableRecoveryTime(request)
 	if err != nil {
 		errRet = err
@@ -1687,8 +1631,6 @@ func (me *PostgresqlService) DescribePostgresqlRegionsByFilter(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeRegions(request)
 	if err != nil {
 		errRet = err
@@ -1716,8 +1658,6 @@ func (me *PostgresqlService) DescribePostgresqlDbInstanceVersionsByFilter(ctx co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeDBVersions(request)
 	if err != nil {
 		errRet = err
@@ -1745,8 +1685,6 @@ func (me *PostgresqlService) DescribePostgresqlZonesByFilter(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UsePostgresqlClient().DescribeZones(request)
 	if err != nil {
 		errRet = err
@@ -1802,8 +1740,6 @@ func (me *PostgresqlService) DeletePostgresqlBaseBackupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	errRet = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
 		response, e := me.client.UsePostgresqlClient().DeleteBaseBackup(request)
 		if e != nil {
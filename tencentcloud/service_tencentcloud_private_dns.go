@@ -8,7 +8,6 @@ import (
 
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // basic information
@@ -48,12 +47,10 @@ getMoreData:
 	}
 	var response *privatedns.DescribePrivateZoneRecordListResponse
 
-	ratelimit.Check(request.GetAction())
 	request.Limit = &limit
 	request.Offset = &offset
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UsePrivateDnsClient().DescribePrivateZoneRecordList(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -13,7 +13,6 @@ import (
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type RedisService struct {
@@ -98,7 +97,6 @@ func (me *RedisService) DescribeRedisZoneConfig(ctx context.Context) (sellConfig
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeProductInfo(request)
 	if err != nil {
 		errRet = err
@@ -150,7 +148,6 @@ needMoreItems:
 	if projectId >= 0 {
 		request.ProjectIds = []*int64{&projectId}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeInstances(request)
 	if err != nil {
 		errRet = err
@@ -338,7 +335,6 @@ func (me *RedisService) CreateInstances(ctx context.Context,
 	if paramsTemplateId != "" {
 		request.TemplateId = &paramsTemplateId
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().CreateInstances(request)
 	if err != nil {
 		errRet = err
@@ -369,7 +365,6 @@ func (me *RedisService) CheckRedisOnlineOk(ctx context.Context, redisId string,
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
 	var response *redis.DescribeInstancesResponse
 	err := resource.Retry(retryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseRedisClient().DescribeInstances(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s CheckRedisOnlineOk fail, reason:%s\n", logId, e.Error())
@@ -418,7 +413,6 @@ func (me *RedisService) CheckRedisUpdateOk(ctx context.Context, redisId string)
 	}()
 	request.InstanceId = &redisId
 	errRet = resource.Retry(readRetryTimeout*20, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseRedisClient().DescribeInstances(request)
 		if err != nil {
 			return retryError(err)
@@ -459,7 +453,6 @@ func (me *RedisService) CheckRedisDestroyOk(ctx context.Context, redisId string)
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
 	var response *redis.DescribeInstancesResponse
 	err := resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseRedisClient().DescribeInstances(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s CheckRedisDestroyOk fail, reason:%s\n", logId, e.Error())
@@ -512,7 +505,6 @@ func (me *RedisService) DescribeInstanceDealDetail(ctx context.Context, dealId s
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
 	var response *redis.DescribeInstanceDealDetailResponse
 	err := resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseRedisClient().DescribeInstanceDealDetail(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeInstanceDealDetail fail, reason:%s\n", logId, e.Error())
@@ -567,7 +559,6 @@ func (me *RedisService) ModifyInstanceName(ctx context.Context, redisId string,
 	request.InstanceName = &name
 	request.Operation = &op
 	request.InstanceId = &redisId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ModifyInstance(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -591,7 +582,6 @@ func (me *RedisService) ModifyInstanceProjectId(ctx context.Context, redisId str
 	request.ProjectId = &projectId
 	request.Operation = &op
 	request.InstanceId = &redisId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ModifyInstance(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -611,7 +601,6 @@ func (me *RedisService) DescribeInstanceSecurityGroup(ctx context.Context, redis
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeInstanceSecurityGroup(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -648,7 +637,6 @@ func (me *RedisService) DescribeDBSecurityGroups(ctx context.Context, product st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeDBSecurityGroups(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -681,7 +669,6 @@ func (me *RedisService) ModifyDBInstanceSecurityGroups(ctx context.Context, prod
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ModifyDBInstanceSecurityGroups(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -701,7 +688,6 @@ func (me *RedisService) DestroyPostpaidInstance(ctx context.Context, redisId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DestroyPostpaidInstance(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -728,7 +714,6 @@ func (me *RedisService) DestroyPrepaidInstance(ctx context.Context, redisId stri
 	// For prepaid instance, deal status synchronization will take some time so need to retry.
 	var response *redis.DestroyPrepaidInstanceResponse
 	err := resource.Retry(5*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseRedisClient().DestroyPrepaidInstance(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DestroyPrepaidInstance fail, reason:%s\n", logId, e.Error())
@@ -760,7 +745,6 @@ func (me *RedisService) CleanUpInstance(ctx context.Context, redisId string) (ta
 	// Cleaning up action for prepaid instances needs to retry.
 	var response *redis.CleanUpInstanceResponse
 	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseRedisClient().CleanUpInstance(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s CleanUpInstance fail, reason:%s\n", logId, e.Error())
@@ -801,7 +785,6 @@ func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, new
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().UpgradeInstance(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -827,7 +810,6 @@ func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, ta
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeTaskInfo(request)
 
 	if err != nil {
@@ -858,7 +840,6 @@ func (me *RedisService) ResetPassword(ctx context.Context, redisId string, newPa
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseRedisClient().ResetPassword(request)
 	if err == nil {
@@ -890,7 +871,6 @@ func (me *RedisService) ModifyAutoBackupConfig(ctx context.Context, redisId stri
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ModifyAutoBackupConfig(request)
 	errRet = err
 	if err == nil {
@@ -913,7 +893,6 @@ func (me *RedisService) DescribeAutoBackupConfig(ctx context.Context, redisId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeAutoBackupConfig(request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -945,7 +924,6 @@ func (me *RedisService) DescribeParamTemplates(ctx context.Context, request *red
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeParamTemplates(request)
 
 	if err != nil {
@@ -991,7 +969,6 @@ func (me *RedisService) ApplyParamsTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ApplyParamsTemplate(request)
 
 	if err != nil {
@@ -1019,7 +996,6 @@ func (me *RedisService) DescribeParamTemplateInfo(ctx context.Context, templateI
 
 	request.TemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeParamTemplateInfo(request)
 
 	if err != nil {
@@ -1044,7 +1020,6 @@ func (me *RedisService) CreateParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().CreateParamTemplate(request)
 
 	if err != nil {
@@ -1074,7 +1049,6 @@ func (me *RedisService) ModifyParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().ModifyParamTemplate(request)
 
 	if err != nil {
@@ -1097,7 +1071,6 @@ func (me *RedisService) DeleteParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DeleteParamTemplate(request)
 
 	if err != nil {
@@ -1123,14 +1096,11 @@ func (me *RedisService) DescribeRedisAccountById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var offset int64 = 0
 	var limit int64 = 50
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseRedisClient().DescribeInstanceAccount(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1172,8 +1142,6 @@ func (me *RedisService) DeleteRedisAccountById(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DeleteInstanceAccount(request)
 	if err != nil {
 		errRet = err
@@ -1214,8 +1182,6 @@ func (me *RedisService) DescribeRedisInstanceById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeInstances(request)
 	if err != nil {
 		errRet = err
@@ -1243,8 +1209,6 @@ func (me *RedisService) DescribeRedisParamById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -1298,8 +1262,6 @@ func (me *RedisService) DescribeRedisSslById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeSSLStatus(request)
 	if err != nil {
 		errRet = err
@@ -1323,8 +1285,6 @@ func (me *RedisService) DescribeRedisMaintenanceWindowById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeMaintenanceWindow(request)
 	if err != nil {
 		errRet = err
@@ -1372,8 +1332,6 @@ func (me *RedisService) DescribeRedisBackupDownloadInfoByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeBackupUrl(request)
 	if err != nil {
 		errRet = err
@@ -1416,8 +1374,6 @@ func (me *RedisService) DescribeRedisBackupByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1464,8 +1420,6 @@ func (me *RedisService) DescribeRedisParamRecordsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset    uint64 = 0
 		limit     uint64 = 20
@@ -1520,7 +1474,6 @@ func (me *RedisService) DescribeRedisInstanceShardsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeInstanceShards(request)
 	if err != nil {
 		errRet = err
@@ -1554,7 +1507,6 @@ func (me *RedisService) DescribeRedisInstanceZoneInfoByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeInstanceZoneInfo(request)
 	if err != nil {
 		errRet = err
@@ -1612,8 +1564,6 @@ func (me *RedisService) DescribeRedisInstanceTaskListByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1708,8 +1658,6 @@ func (me *RedisService) DescribeRedisReplicateInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1743,8 +1691,6 @@ func (me *RedisService) DescribeRedisBackupDownloadRestrictionById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeBackupDownloadRestriction(request)
 	if err != nil {
 		errRet = err
@@ -1774,7 +1720,6 @@ func (me *RedisService) DescribeRedisInstanceNodeInfoByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRedisClient().DescribeInstanceNodeInfo(request)
 	if err != nil {
 		errRet = err
@@ -1798,8 +1743,6 @@ func (me *RedisService) DescribeBandwidthRangeById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeBandwidthRange(request)
 	if err != nil {
 		errRet = err
@@ -1825,8 +1768,6 @@ func (me *RedisService) DeleteRedisReplicateAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().RemoveReplicationInstance(request)
 	if err != nil {
 		errRet = err
@@ -1876,8 +1817,6 @@ func (me *RedisService) DescribeRedisSecurityGroupAttachmentById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1913,8 +1852,6 @@ func (me *RedisService) DeleteRedisSecurityGroupAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseRedisClient().DisassociateSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1924,3 +1861,23 @@ func (me *RedisService) DeleteRedisSecurityGroupAttachmentById(ctx context.Conte
 
 	return
 }
+
+func (me *RedisService) InquiryPriceCreateInstance(ctx context.Context, request *redis.InquiryPriceCreateInstanceRequest) (price float64, errRet error) {
+	logId := getLogId(ctx)
+	response, err := me.client.UseRedisClient().InquiryPriceCreateInstanceWithContext(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.Price == nil {
+		errRet = fmt.Errorf("api[%s] returns no price", request.GetAction())
+		return
+	}
+	price = *response.Response.Price
+	return
+}
@@ -8,7 +8,6 @@ import (
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type RumService struct {
@@ -66,7 +65,6 @@ func (me *RumService) DeleteRumTawInstanceById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRumClient().DeleteInstance(request)
 	if err != nil {
 		errRet = err
@@ -98,7 +96,6 @@ func (me *RumService) DescribeRumProject(ctx context.Context, id string) (projec
 			Values: []*string{&id},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -107,7 +104,6 @@ func (me *RumService) DescribeRumProject(ctx context.Context, id string) (projec
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseRumClient().DescribeProjects(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -158,7 +154,6 @@ func (me *RumService) DeleteRumProjectById(ctx context.Context, id string) (errR
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRumClient().DeleteProject(request)
 	if err != nil {
 		errRet = err
@@ -222,7 +217,6 @@ func (me *RumService) DeleteRumWhitelistById(ctx context.Context, instanceID, id
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRumClient().DeleteWhitelist(request)
 	if err != nil {
 		errRet = err
@@ -276,7 +270,6 @@ func (me *RumService) DeleteRumOfflineLogConfigAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRumClient().DeleteOfflineLogConfig(request)
 	if err != nil {
 		errRet = err
@@ -306,7 +299,6 @@ func (me *RumService) DescribeRumOfflineLogConfigByFilter(ctx context.Context, p
 			request.ProjectKey = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseRumClient().DescribeOfflineLogConfigs(request)
 	if err != nil {
@@ -349,7 +341,6 @@ func (me *RumService) DescribeRumProjectByFilter(ctx context.Context, param map[
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -357,7 +348,6 @@ func (me *RumService) DescribeRumProjectByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseRumClient().DescribeProjects(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -398,7 +388,6 @@ func (me *RumService) DescribeRumWhitelistByFilter(ctx context.Context, param ma
 			request.InstanceID = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseRumClient().DescribeWhitelists(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -452,7 +441,6 @@ func (me *RumService) DescribeRumTawInstanceByFilter(ctx context.Context, param
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -460,7 +448,6 @@ func (me *RumService) DescribeRumTawInstanceByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseRumClient().DescribeTawInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -11,7 +11,6 @@ import (
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type scfFunctionInfo struct {
@@ -113,8 +112,6 @@ func (me *ScfService) CreateFunction(ctx context.Context, info scfFunctionInfo)
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.CreateFunction(request); err != nil {
 			e, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if ok && strings.Contains(e.Code, "ResourceInUse") {
@@ -138,8 +135,6 @@ func (me *ScfService) DescribeFunction(ctx context.Context, name, namespace stri
 	request.Namespace = &namespace
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.GetFunction(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -185,8 +180,6 @@ func (me *ScfService) DescribeFunctions(ctx context.Context, name, namespace, de
 	// at least run loop once
 	for count == SCF_FUNCTION_DESCRIBE_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.ListFunctions(request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
@@ -222,8 +215,6 @@ func (me *ScfService) ModifyFunctionCode(ctx context.Context, info scfFunctionIn
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.UpdateFunctionCode(request); err != nil {
 			return retryError(errors.WithStack(err), InternalError)
 		}
@@ -290,8 +281,6 @@ func (me *ScfService) ModifyFunctionConfig(ctx context.Context, info scfFunction
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.UpdateFunctionConfiguration(request); err != nil {
 			return retryError(errors.WithStack(err), InternalError)
 		}
@@ -311,8 +300,6 @@ func (me *ScfService) DeleteFunction(ctx context.Context, name, namespace string
 	deleteRequest.Namespace = &namespace
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteFunction(deleteRequest); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				for _, code := range SCF_FUNCTIONS_NOT_FOUND_SET {
@@ -334,8 +321,6 @@ func (me *ScfService) DeleteFunction(ctx context.Context, name, namespace string
 	descRequest.Namespace = &namespace
 
 	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(descRequest.GetAction())
-
 		if _, err := client.GetFunction(descRequest); err == nil {
 			return resource.RetryableError(errors.New("function still exists"))
 		} else {
@@ -360,8 +345,6 @@ func (me *ScfService) CreateNamespace(ctx context.Context, namespace, desc strin
 	request.Description = &desc
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.CreateNamespace(request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
@@ -384,8 +367,6 @@ func (me *ScfService) DescribeNamespace(ctx context.Context, namespace string) (
 	// at least run loop once
 	for count == SCF_NAMESPACE_DESCRIBE_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.ListNamespaces(request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
@@ -428,8 +409,6 @@ func (me *ScfService) DescribeNamespaces(ctx context.Context) (nss []*scf.Namesp
 	// at least run loop once
 	for count == SCF_NAMESPACE_DESCRIBE_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := client.ListNamespaces(request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
@@ -457,8 +436,6 @@ func (me *ScfService) ModifyNamespace(ctx context.Context, namespace, desc strin
 	request.Description = &desc
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.UpdateNamespace(request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
@@ -473,8 +450,6 @@ func (me *ScfService) DeleteNamespace(ctx context.Context, namespace string) err
 	request.Namespace = &namespace
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.DeleteNamespace(request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
@@ -496,8 +471,6 @@ func (me *ScfService) CreateTriggers(ctx context.Context, functionName, namespac
 		request.Enable = helper.String("OPEN")
 
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			if _, err := client.CreateTrigger(request); err != nil {
 				return retryError(errors.WithStack(err))
 			}
@@ -522,8 +495,6 @@ func (me *ScfService) DeleteTriggers(ctx context.Context, functionName, namespac
 		request.TriggerDesc = &trigger.triggerDesc
 
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			if _, err := client.DeleteTrigger(request); err != nil {
 				return retryError(errors.WithStack(err))
 			}
@@ -559,8 +530,6 @@ func (me *ScfService) DescribeLogs(
 	request.EndTime = endTime
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.GetFunctionLogs(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -588,8 +557,6 @@ func waitScfFunctionReady(ctx context.Context, name, namespace string, client *s
 	request.Namespace = &namespace
 
 	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.GetFunction(request)
 		if err != nil {
 			return retryError(errors.WithStack(err), InternalError)
@@ -622,8 +589,6 @@ func (me *ScfService) DescribeScfFunctionAliasById(ctx context.Context, namespac
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetAlias(request)
 	if err != nil {
 		errRet = err
@@ -649,8 +614,6 @@ func (me *ScfService) DeleteScfFunctionAliasById(ctx context.Context, namespace
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().DeleteAlias(request)
 	if err != nil {
 		errRet = err
@@ -675,8 +638,6 @@ func (me *ScfService) DescribeScfFunctionVersionById(ctx context.Context, functi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetFunction(request)
 	if err != nil {
 		errRet = err
@@ -702,8 +663,6 @@ func (me *ScfService) DeleteScfFunctionVersionById(ctx context.Context, function
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().DeleteFunction(request)
 	if err != nil {
 		errRet = err
@@ -727,8 +686,6 @@ func (me *ScfService) DescribeScfFunctionEventInvokeConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetFunctionEventInvokeConfig(request)
 	if err != nil {
 		errRet = err
@@ -753,8 +710,6 @@ func (me *ScfService) DescribeScfReservedConcurrencyConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetReservedConcurrencyConfig(request)
 	if err != nil {
 		errRet = err
@@ -779,8 +734,6 @@ func (me *ScfService) DeleteScfReservedConcurrencyConfigById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().DeleteReservedConcurrencyConfig(request)
 	if err != nil {
 		errRet = err
@@ -805,8 +758,6 @@ func (me *ScfService) DescribeScfProvisionedConcurrencyConfigById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetProvisionedConcurrencyConfig(request)
 	if err != nil {
 		errRet = err
@@ -839,8 +790,6 @@ func (me *ScfService) DeleteScfProvisionedConcurrencyConfigById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().DeleteProvisionedConcurrencyConfig(request)
 	if err != nil {
 		errRet = err
@@ -863,8 +812,6 @@ func (me *ScfService) DescribeScfAccountInfo(ctx context.Context) (accountInfo *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetAccount(request)
 	if err != nil {
 		errRet = err
@@ -916,8 +863,6 @@ func (me *ScfService) DescribeScfAsyncEventManagementByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -976,8 +921,6 @@ func (me *ScfService) DescribeScfTriggersByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1024,8 +967,6 @@ func (me *ScfService) DescribeScfAsyncEventStatus(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetAsyncEventStatus(request)
 	if err != nil {
 		errRet = err
@@ -1062,8 +1003,6 @@ func (me *ScfService) DescribeScfFunctionAddress(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetFunctionAddress(request)
 	if err != nil {
 		errRet = err
@@ -1106,8 +1045,6 @@ func (me *ScfService) DescribeScfRequestStatusByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().GetRequestStatus(request)
 	if err != nil {
 		errRet = err
@@ -1144,8 +1081,6 @@ func (me *ScfService) DescribeScfFunctionAliasesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1195,8 +1130,6 @@ func (me *ScfService) DescribeScfLayerVersions(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseScfClient().ListLayerVersions(request)
 	if err != nil {
 		errRet = err
@@ -1230,8 +1163,6 @@ func (me *ScfService) DescribeScfLayersByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1287,8 +1218,6 @@ func (me *ScfService) DescribeScfFunctionVersionsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1337,8 +1266,6 @@ func (me *ScfService) DescribeScfTriggerConfigById(ctx context.Context, function
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
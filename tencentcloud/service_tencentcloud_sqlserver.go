@@ -13,7 +13,6 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SqlserverService struct {
@@ -33,7 +32,6 @@ func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*
 
 	var response *sqlserver.DescribeZonesResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DescribeZones(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeZones fail, reason:%s\n", logId, e.Error())
@@ -66,7 +64,6 @@ func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone stri
 
 	var response *sqlserver.DescribeProductConfigResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DescribeProductConfig(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeProductConfig fail, reason:%s\n", logId, e.Error())
@@ -93,7 +90,6 @@ func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -130,7 +126,6 @@ func (me *SqlserverService) ModifySqlserverInstanceName(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceName(request)
 	return err
 }
@@ -146,7 +141,6 @@ func (me *SqlserverService) ModifySqlserverInstanceProjectId(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceProject(request)
 	return err
 }
@@ -170,7 +164,6 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
 	if err != nil {
 		return err
@@ -210,7 +203,6 @@ func (me *SqlserverService) RemoveSecurityGroup(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DisassociateSecurityGroups(request)
 
 	return err
@@ -228,7 +220,6 @@ func (me *SqlserverService) AddSecurityGroup(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().AssociateSecurityGroups(request)
 	time.Sleep(10 * time.Second)
 	return err
@@ -256,7 +247,6 @@ func (me *SqlserverService) ModifySqlserverInstanceMaintenanceSpan(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyMaintenanceSpan(request)
 
 	return err
@@ -272,7 +262,6 @@ func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
 	return err
 }
@@ -287,7 +276,6 @@ func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	return err
 }
@@ -327,7 +315,6 @@ func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, inst
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 		if err != nil {
 			errRet = err
@@ -373,7 +360,6 @@ func (me *SqlserverService) DescribeMaintenanceSpan(ctx context.Context, instanc
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
 	if err != nil {
 		errRet = err
@@ -402,7 +388,6 @@ func (me *SqlserverService) DescribeInstanceSecurityGroups(ctx context.Context,
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -441,7 +426,6 @@ func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instan
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
 		if err != nil {
 			errRet = err
@@ -468,7 +452,6 @@ func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, insta
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupList(request)
 	if err != nil {
 		errRet = err
@@ -492,7 +475,6 @@ func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateReadOnlyDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -529,7 +511,6 @@ func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx co
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupByReadOnlyInstance(request)
 	if err != nil {
 		errRet = err
@@ -560,7 +541,6 @@ func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instance
 	}
 	request.Accounts = []*sqlserver.AccountCreateInfo{&account}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateAccount(request)
 	if err != nil {
 		errRet = err
@@ -595,7 +575,6 @@ func (me *SqlserverService) DescribeSqlserverAccounts(ctx context.Context, insta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeAccounts(request)
 		if err != nil {
 			ee, ok := err.(*SDKErrors.TencentCloudSDKError)
@@ -650,7 +629,6 @@ func (me *SqlserverService) ModifySqlserverAccountRemark(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyAccountRemark(request)
 	return err
 }
@@ -666,7 +644,6 @@ func (me *SqlserverService) ResetSqlserverAccountPassword(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ResetAccountPassword(request)
 	if err != nil {
 		errRet = err
@@ -703,7 +680,6 @@ func (me *SqlserverService) DeleteSqlserverAccount(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DeleteAccount(request)
 	if err != nil {
 		ee, ok := err.(*SDKErrors.TencentCloudSDKError)
@@ -766,7 +742,6 @@ func (me *SqlserverService) ModifyAccountDBAttachment(ctx context.Context, insta
 		return fmt.Errorf("SQL Server DB %s , instance ID %s is not exist", dbName, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().ModifyAccountPrivilege(request)
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
@@ -864,7 +839,6 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string,
 		retryTimeout = readRetryTimeout * 20
 	}
 	outErr := resource.Retry(retryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeOrders(request)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -917,7 +891,6 @@ func (me *SqlserverService) WaitForTaskFinish(ctx context.Context, flowId int64)
 
 	errRet = resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
 		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
-		ratelimit.Check(request.GetAction())
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
 		}
@@ -956,7 +929,6 @@ func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID st
 
 	var response *sqlserver.CreateDBResponse
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().CreateDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -995,7 +967,6 @@ func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceI
 		request.Limit = &limit
 		var response *sqlserver.DescribeDBsResponse
 		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			result, e := me.client.UseSqlserverClient().DescribeDBs(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -1068,7 +1039,6 @@ func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanc
 	}()
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseSqlserverClient().ModifyDBRemark(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -1096,7 +1066,6 @@ func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId st
 
 	var response *sqlserver.DeleteDBResponse
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DeleteDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -1133,7 +1102,6 @@ func (me *SqlserverService) CreateSqlserverPublishSubscribe(ctx context.Context,
 
 	var response *sqlserver.CreatePublishSubscribeResponse
 	errRet = resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseSqlserverClient().CreatePublishSubscribe(request)
 		if errRet != nil {
 			if ee, ok := errRet.(*SDKErrors.TencentCloudSDKError); ok {
@@ -1230,11 +1198,9 @@ func (me *SqlserverService) DescribeSqlserverPublishSubscribes(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		var response *sqlserver.DescribePublishSubscribeResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseSqlserverClient().DescribePublishSubscribe(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s DescribePublishSubscribe fail, reason:%s", logId, err.Error())
@@ -1270,7 +1236,6 @@ func (me *SqlserverService) ModifyPublishSubscribeName(ctx context.Context, id u
 	request.PublishSubscribeId = &id
 	request.PublishSubscribeName = &name
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseSqlserverClient().ModifyPublishSubscribeName(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -1294,7 +1259,6 @@ func (me *SqlserverService) DeletePublishSubscribe(ctx context.Context, publishS
 	var response *sqlserver.DeletePublishSubscribeResponse
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseSqlserverClient().DeletePublishSubscribe(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -1321,7 +1285,6 @@ func (me *SqlserverService) RecycleDBInstance(ctx context.Context, instanceId st
 	}()
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseSqlserverClient().RecycleDBInstance(request)
 		if err != nil {
 			// FIXME: if action offline then kill this function
@@ -1404,7 +1367,6 @@ func (me *SqlserverService) CreateSqlserverBasicInstance(ctx context.Context, pa
 		request.SecurityGroupList = append(request.SecurityGroupList, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateBasicDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -1443,7 +1405,6 @@ func (me *SqlserverService) UpgradeSqlserverBasicInstance(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
 	if err != nil {
 		return err
@@ -1488,7 +1449,6 @@ func (me *SqlserverService) NewModifyDBInstanceRenewFlag(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceRenewFlag(request)
 
 	return err
@@ -1506,8 +1466,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeMigrationDetail(request)
 	if err != nil {
 		errRet = err
@@ -1531,8 +1489,6 @@ func (me *SqlserverService) DeleteSqlserverMigrationById(ctx context.Context, mi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteMigration(request)
 	if err != nil {
 		errRet = err
@@ -1574,7 +1530,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -1582,7 +1537,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeMigrations(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1617,8 +1571,6 @@ func (me *SqlserverService) DescribeSqlserverConfigBackupStrategyById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -1653,7 +1605,6 @@ func (me *SqlserverService) DescribeSqlserverBackupByBackupId(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
 		if err != nil {
 			errRet = err
@@ -1687,7 +1638,6 @@ func (me *SqlserverService) DescribeBackupByFlowId(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupByFlowId(request)
 	if err != nil {
 		errRet = err
@@ -1712,7 +1662,6 @@ func (me *SqlserverService) DescribeSqlserverBackupsById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupFiles(request)
 	if err != nil {
 		errRet = err
@@ -1741,8 +1690,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralBackupsById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().RemoveBackups(request)
 	if err != nil {
 		errRet = err
@@ -1780,8 +1727,6 @@ func (me *SqlserverService) DescribeSqlserverBackupCommand(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBackupCommand(request)
 	if err != nil {
 		errRet = err
@@ -1809,7 +1754,6 @@ func (me *SqlserverService) DescribeCloneStatusByFlowId(ctx context.Context, flo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
 	if err != nil {
 		errRet = err
@@ -1832,8 +1776,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloneById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -1861,7 +1803,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloneDB(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DeleteDB(request)
 	if err != nil {
 		errRet = err
@@ -1885,8 +1826,6 @@ func (me *SqlserverService) DescribeSqlserverFullBackupMigrationById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBackupMigration(request)
 	if err != nil {
 		errRet = err
@@ -1914,8 +1853,6 @@ func (me *SqlserverService) DeleteSqlserverFullBackupMigrationById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteBackupMigration(request)
 	if err != nil {
 		errRet = err
@@ -1940,7 +1877,6 @@ func (me *SqlserverService) DescribeSqlserverIncreBackupMigrationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeIncrementalMigration(request)
 	if err != nil {
 		errRet = err
@@ -1970,8 +1906,6 @@ func (me *SqlserverService) DeleteSqlserverIncreBackupMigrationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteIncrementalMigration(request)
 	if err != nil {
 		errRet = err
@@ -1996,8 +1930,6 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceFileById(ctx co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBusinessIntelligenceFile(request)
 	if err != nil {
 		errRet = err
@@ -2026,8 +1958,6 @@ func (me *SqlserverService) DeleteSqlserverBusinessIntelligenceFileById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteBusinessIntelligenceFile(request)
 	if err != nil {
 		errRet = err
@@ -2050,8 +1980,6 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceInstanceById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2079,8 +2007,6 @@ func (me *SqlserverService) DescribeMaintenanceSpanById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
 	if err != nil {
 		errRet = err
@@ -2108,7 +2034,6 @@ func (me *SqlserverService) TerminateSqlserverInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -2131,7 +2056,6 @@ func (me *SqlserverService) DeleteSqlserverInstanceById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -2157,8 +2081,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCommunicationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstanceInter(request)
 	if err != nil {
 		errRet = err
@@ -2186,8 +2108,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCommunicationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().CloseInterCommunication(request)
 	if err != nil {
 		errRet = err
@@ -2227,7 +2147,6 @@ func (me *SqlserverService) DescribeSqlserverBackupUploadSizeByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupUploadSize(request)
 	if err != nil {
 		errRet = err
@@ -2261,8 +2180,6 @@ func (me *SqlserverService) DescribeSqlserverCrossRegionZoneByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeCrossRegionZone(request)
 	if err != nil {
 		errRet = err
@@ -2298,7 +2215,6 @@ func (me *SqlserverService) DescribeSqlserverDatasourceDBCharsetsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeDBCharsets(request)
 	if err != nil {
 		errRet = err
@@ -2333,8 +2249,6 @@ func (me *SqlserverService) DescribeSqlserverInstanceParamRecordsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -2384,8 +2298,6 @@ func (me *SqlserverService) DescribeSqlserverProjectSecurityGroupsByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeProjectSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -2415,8 +2327,6 @@ func (me *SqlserverService) DescribeSqlserverDatasourceRegionsByFilter(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeRegions(request)
 	if err != nil {
 		errRet = err
@@ -2455,8 +2365,6 @@ func (me *SqlserverService) DescribeSqlserverRollbackTimeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeRollbackTime(request)
 	if err != nil {
 		errRet = err
@@ -2498,8 +2406,6 @@ func (me *SqlserverService) DescribeSqlserverSlowlogsByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		limit  int64  = 100
 		offset uint64 = 0
@@ -2552,8 +2458,6 @@ func (me *SqlserverService) DescribeSqlserverUploadBackupInfoByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeUploadBackupInfo(request)
 	if err != nil {
 		errRet = err
@@ -2595,8 +2499,6 @@ func (me *SqlserverService) DescribeSqlserverUploadIncrementalInfoByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeUploadIncrementalInfo(request)
 	if err != nil {
 		errRet = err
@@ -2626,8 +2528,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCDCById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2656,8 +2556,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2686,8 +2584,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCTById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2716,8 +2612,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseMdfById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2746,8 +2640,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceNetworkById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2776,8 +2668,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceParamById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -2807,8 +2697,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceRoGroupById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupDetails(request)
 	if err != nil {
 		errRet = err
@@ -2836,8 +2724,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceSecurityGroupsById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -2866,8 +2752,6 @@ func (me *SqlserverService) DescribeSqlserverRenewDBInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2895,8 +2779,6 @@ func (me *SqlserverService) DescribeSqlserverRenewPostpaidDBInstanceById(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2924,8 +2806,6 @@ func (me *SqlserverService) DescribeSqlserverRestartDBInstanceById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2953,8 +2833,6 @@ func (me *SqlserverService) DescribeSqlserverRestoreInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -2995,8 +2873,6 @@ func (me *SqlserverService) DescribeSqlserverRollbackInstanceById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -3037,8 +2913,6 @@ func (me *SqlserverService) DescribeSqlserverConfigTerminateDBInstanceById(ctx c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -3067,8 +2941,6 @@ func (me *SqlserverService) DescribeSqlserverDBS(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -3097,8 +2969,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudRoInstanceById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -3127,8 +2997,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloudRoInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -3166,8 +3034,6 @@ func (me *SqlserverService) DescribeSqlserverQueryXeventByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3214,8 +3080,6 @@ func (me *SqlserverService) DescribeSqlserverInsAttributeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
 	if err != nil {
 		errRet = err
@@ -3245,8 +3109,6 @@ func (me *SqlserverService) DescribeSqlserverInstanceTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
 	if err != nil {
 		errRet = err
@@ -3271,8 +3133,6 @@ func (me *SqlserverService) DescribeSqlserverDatabaseTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -11,7 +11,6 @@ import (
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wss/v20180426"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SslService struct {
@@ -31,8 +30,6 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	createRequest.Key = key
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
-
 		response, err := client.UploadCert(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -58,8 +55,6 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	describeRequest.Id = &id
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -127,8 +122,6 @@ func (me *SslService) DescribeCertificates(ctx context.Context, id, name, certTy
 	count := 20
 	for count == 20 {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseSslClient().DescribeCertList(request)
 			if err != nil {
 				count = 0
@@ -162,8 +155,6 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	deleteRequest.Id = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteCert(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -180,8 +171,6 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	describeRequest.Id = &id
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
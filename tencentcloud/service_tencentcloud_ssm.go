@@ -9,7 +9,6 @@ import (
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SsmService struct {
@@ -67,7 +66,6 @@ func (me *SsmService) DescribeSecretsByFilter(ctx context.Context, param map[str
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSsmClient().ListSecrets(request)
 		if err != nil {
 			errRet = err
@@ -93,7 +91,6 @@ func (me *SsmService) DescribeSecretByName(ctx context.Context, secretName strin
 	logId := getLogId(ctx)
 	request := ssm.NewDescribeSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DescribeSecret(request)
 	if err != nil {
@@ -122,7 +119,6 @@ func (me *SsmService) DescribeSecretVersionIdsByName(ctx context.Context, secret
 	logId := getLogId(ctx)
 	request := ssm.NewListSecretVersionIdsRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().ListSecretVersionIds(request)
 	if err != nil {
@@ -144,7 +140,6 @@ func (me *SsmService) DescribeSecretVersion(ctx context.Context, secretName, ver
 	request := ssm.NewGetSecretValueRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().GetSecretValue(request)
 	if err != nil {
@@ -194,7 +189,6 @@ func (me *SsmService) CreateSecret(ctx context.Context, param map[string]interfa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSsmClient().CreateSecret(request)
 	if err != nil {
 		errRet = err
@@ -212,7 +206,6 @@ func (me *SsmService) UpdateSecretDescription(ctx context.Context, secretName, d
 	request := ssm.NewUpdateDescriptionRequest()
 	request.SecretName = helper.String(secretName)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().UpdateDescription(request)
 	if err != nil {
@@ -241,7 +234,6 @@ func (me *SsmService) UpdateSecret(ctx context.Context, param map[string]interfa
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().UpdateSecret(request)
 	if err != nil {
@@ -257,7 +249,6 @@ func (me *SsmService) EnableSecret(ctx context.Context, secretName string) (errR
 	logId := getLogId(ctx)
 	request := ssm.NewEnableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().EnableSecret(request)
 	if err != nil {
@@ -273,7 +264,6 @@ func (me *SsmService) DisableSecret(ctx context.Context, secretName string) (err
 	logId := getLogId(ctx)
 	request := ssm.NewDisableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DisableSecret(request)
 	if err != nil {
@@ -302,7 +292,6 @@ func (me *SsmService) PutSecretValue(ctx context.Context, param map[string]inter
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().PutSecretValue(request)
 	if err != nil {
@@ -322,7 +311,6 @@ func (me *SsmService) DeleteSecretVersion(ctx context.Context, secretName, versi
 	request := ssm.NewDeleteSecretVersionRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DeleteSecretVersion(request)
 	if err != nil {
@@ -339,7 +327,6 @@ func (me *SsmService) DeleteSecret(ctx context.Context, secretName string, recov
 	request := ssm.NewDeleteSecretRequest()
 	request.SecretName = helper.String(secretName)
 	request.RecoveryWindowInDays = helper.Uint64(recoveryWindowInDays)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DeleteSecret(request)
 	if err != nil {
@@ -364,8 +351,6 @@ func (me *SsmService) DescribeSecretById(ctx context.Context, secretName string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -415,8 +400,6 @@ func (me *SsmService) DeleteSsmSshKeyPairSecretById(ctx context.Context, secretN
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSsmClient().DeleteSecret(request)
 	if err != nil {
 		errRet = err
@@ -439,8 +422,6 @@ func (me *SsmService) DeleteSsmProductSecretById(ctx context.Context, secretName
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSsmClient().DeleteSecret(request)
 	if err != nil {
 		errRet = err
@@ -463,8 +444,6 @@ func (me *SsmService) DescribeAsyncRequestInfo(ctx context.Context, flowID int64
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSsmClient().DescribeAsyncRequestInfo(request)
 	if err != nil {
 		errRet = err
@@ -3,13 +3,15 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TagService struct {
@@ -43,9 +45,7 @@ func (me *TagService) ModifyTags(ctx context.Context, resourceName string, repla
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
-		if _, err := me.client.UseTagClient().ModifyResourceTags(request); err != nil {
+		if _, err := me.client.UseTagClient().ModifyResourceTagsWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
 
@@ -68,9 +68,7 @@ func (me *TagService) DescribeResourceTags(ctx context.Context, serviceType, res
 	count := DESCRIBE_TAGS_LIMIT
 	for count == DESCRIBE_TAGS_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
-			response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIds(request)
+			response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIdsWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -120,6 +118,146 @@ func diffTags(oldTags, newTags map[string]interface{}) (replaceTags map[string]s
 	return
 }
 
+// IgnoreTagsConfig is the provider `ignore_tags`, the matched tags are not managed by terraform
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Ignored returns whether the tag key is ignored
+func (me *IgnoreTagsConfig) Ignored(key string) bool {
+	if me == nil {
+		return false
+	}
+	for _, k := range me.Keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range me.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeDefaultTags returns the provider `default_tags` merged with the resource tags, the resource tags win
+// when the keys conflict, and the ignored tags are left out.
+func mergeDefaultTags(meta interface{}, tags map[string]string) map[string]string {
+	var (
+		defaultTags map[string]string
+		ignoreTags  *IgnoreTagsConfig
+	)
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil {
+		defaultTags = client.defaultTags
+		ignoreTags = client.ignoreTags
+	}
+
+	tagsAll := make(map[string]string, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		if !ignoreTags.Ignored(k) {
+			tagsAll[k] = v
+		}
+	}
+	for k, v := range tags {
+		if !ignoreTags.Ignored(k) {
+			tagsAll[k] = v
+		}
+	}
+	return tagsAll
+}
+
+// keepIgnoredTags returns the tags with the ignored ones of current tags, so the APIs replacing the
+// whole tag set don't delete the tags which are managed by others.
+func keepIgnoredTags(meta interface{}, current, tags map[string]string) map[string]string {
+	var ignoreTags *IgnoreTagsConfig
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil {
+		ignoreTags = client.ignoreTags
+	}
+
+	result := make(map[string]string, len(tags))
+	for k, v := range current {
+		if ignoreTags.Ignored(k) {
+			result[k] = v
+		}
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
+// customizeDiffTagsAll plans `tags_all` as the effective tags of the resource, so the change of
+// provider `default_tags` also triggers updating.
+func customizeDiffTagsAll(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return customizeDiffTagsAllOf("tags")(ctx, d, meta)
+}
+
+// customizeDiffTagsAllOf is customizeDiffTagsAll of the resources whose tags are configured by key
+// instead of `tags`.
+func customizeDiffTagsAllOf(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("tags_all")
+		}
+
+		tags := make(map[string]string)
+		for k, v := range d.Get(key).(map[string]interface{}) {
+			tags[k] = v.(string)
+		}
+		tagsAll := mergeDefaultTags(meta, tags)
+
+		oldTagsAll := make(map[string]string)
+		for k, v := range d.Get("tags_all").(map[string]interface{}) {
+			oldTagsAll[k] = v.(string)
+		}
+		if reflect.DeepEqual(oldTagsAll, tagsAll) {
+			return nil
+		}
+
+		return d.SetNew("tags_all", tagsAll)
+	}
+}
+
+// setResourceTags sets the tags read from cloud to `tags_all` and `tags`, the ignored tags are left out,
+// and the tags inherited from provider `default_tags` are only kept in `tags` if they are configured.
+func setResourceTags(d *schema.ResourceData, meta interface{}, tags map[string]string) {
+	setResourceTagsOf(d, meta, "tags", tags)
+}
+
+// setResourceTagsOf is setResourceTags of the resources whose tags are configured by key instead of `tags`.
+func setResourceTagsOf(d *schema.ResourceData, meta interface{}, key string, tags map[string]string) {
+	var (
+		defaultTags map[string]string
+		ignoreTags  *IgnoreTagsConfig
+	)
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil {
+		defaultTags = client.defaultTags
+		ignoreTags = client.ignoreTags
+	}
+
+	configured := helper.GetTags(d, key)
+	tagsAll := make(map[string]string, len(tags))
+	resourceTags := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if ignoreTags.Ignored(k) {
+			continue
+		}
+		tagsAll[k] = v
+
+		if defaultValue, ok := defaultTags[k]; ok && defaultValue == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		resourceTags[k] = v
+	}
+
+	_ = d.Set(key, resourceTags)
+	_ = d.Set("tags_all", tagsAll)
+}
+
 func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64) (project *tag.Project, disable *uint64, errRet error) {
 	logId := getLogId(ctx)
 
@@ -131,8 +269,6 @@ func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	// query enable project
 	request.AllList = helper.Uint64(0)
 
@@ -223,8 +359,6 @@ func (me *TagService) DisableProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().UpdateProject(request)
 	if err != nil {
 		errRet = err
@@ -253,8 +387,6 @@ func (me *TagService) DescribeProjects(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -295,8 +427,6 @@ func (me *TagService) DescribeTagResourceById(ctx context.Context, tagKey string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().GetTags(request)
 	if err != nil {
 		errRet = err
@@ -328,8 +458,6 @@ func (me *TagService) DeleteTagResourceById(ctx context.Context, tagKey string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().DeleteTag(request)
 	if err != nil {
 		errRet = err
@@ -351,8 +479,6 @@ func (me *TagService) DescribeTagTagAttachmentById(ctx context.Context, tagKey s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().GetResources(request)
 	if err != nil {
 		errRet = err
@@ -393,8 +519,6 @@ func (me *TagService) DeleteTagTagAttachmentById(ctx context.Context, tagKey str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().DeleteResourceTag(request)
 	if err != nil {
 		errRet = err
@@ -404,3 +528,102 @@ func (me *TagService) DeleteTagTagAttachmentById(ctx context.Context, tagKey str
 
 	return
 }
+
+func (me *TagService) DescribeTagResourcesByFilter(ctx context.Context, param map[string]interface{}) (resources []*tag.ResourceTagMapping, errRet error) {
+	var (
+		logId   = getLogId(ctx)
+		request = tag.NewGetResourcesRequest()
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for k, v := range param {
+		if k == "ResourceList" {
+			request.ResourceList = v.([]*string)
+		}
+		if k == "TagFilters" {
+			request.TagFilters = v.([]*tag.TagFilter)
+		}
+		if k == "MaxResults" {
+			request.MaxResults = v.(*uint64)
+		}
+	}
+
+	for {
+		response, err := me.client.UseTagClient().GetResources(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || response.Response == nil {
+			break
+		}
+		resources = append(resources, response.Response.ResourceTagMappingList...)
+		if len(response.Response.ResourceTagMappingList) < 1 || helper.PString(response.Response.PaginationToken) == "" {
+			break
+		}
+		request.PaginationToken = response.Response.PaginationToken
+	}
+
+	return
+}
+
+func (me *TagService) DescribeResourcesByTagsByFilter(ctx context.Context, param map[string]interface{}) (resources []*tag.ResourceTag, errRet error) {
+	var (
+		logId   = getLogId(ctx)
+		request = tag.NewDescribeResourcesByTagsRequest()
+	)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	for k, v := range param {
+		if k == "TagFilters" {
+			request.TagFilters = v.([]*tag.TagFilter)
+		}
+		if k == "ServiceType" {
+			request.ServiceType = v.(*string)
+		}
+		if k == "ResourcePrefix" {
+			request.ResourcePrefix = v.(*string)
+		}
+		if k == "ResourceRegion" {
+			request.ResourceRegion = v.(*string)
+		}
+		if k == "Limit" {
+			request.Limit = v.(*uint64)
+		}
+	}
+
+	var offset uint64
+	for {
+		request.Offset = &offset
+		response, err := me.client.UseTagClient().DescribeResourcesByTags(request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if response == nil || response.Response == nil || len(response.Response.Rows) < 1 {
+			break
+		}
+		resources = append(resources, response.Response.Rows...)
+		offset += uint64(len(response.Response.Rows))
+		if request.Limit != nil && uint64(len(response.Response.Rows)) < *request.Limit ||
+			offset >= helper.PUint64(response.Response.TotalCount) {
+			break
+		}
+	}
+
+	return
+}
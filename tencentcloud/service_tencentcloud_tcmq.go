@@ -7,7 +7,6 @@ import (
 	tcmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // basic information
@@ -28,8 +27,6 @@ func (me *TcmqService) DescribeTcmqTopicById(ctx context.Context, topicName stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqTopicDetail(request)
 	if err != nil {
 		errRet = err
@@ -57,8 +54,6 @@ func (me *TcmqService) DeleteTcmqTopicById(ctx context.Context, topicName string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteCmqTopic(request)
 	if err != nil {
 		errRet = err
@@ -81,8 +76,6 @@ func (me *TcmqService) DescribeTcmqQueueById(ctx context.Context, queueName stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqQueueDetail(request)
 	if err != nil {
 		errRet = err
@@ -142,8 +135,6 @@ func (me *TcmqService) DescribeTcmqQueueByFilter(ctx context.Context, paramMap m
 		request.Filters = filters
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqQueues(request)
 	if err != nil {
 		errRet = err
@@ -167,8 +158,6 @@ func (me *TcmqService) DeleteTcmqQueueById(ctx context.Context, queueName string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteCmqQueue(request)
 	if err != nil {
 		errRet = err
@@ -227,8 +216,6 @@ func (me *TcmqService) DescribeTcmqTopicByFilter(ctx context.Context, paramMap m
 		request.Filters = filters
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqTopics(request)
 	if err != nil {
 		errRet = err
@@ -253,8 +240,6 @@ func (me *TcmqService) DescribeTcmqSubscribeById(ctx context.Context, topicName
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetail(request)
 	if err != nil {
 		errRet = err
@@ -294,8 +279,6 @@ func (me *TcmqService) DescribeTcmqSubscribeByFilter(ctx context.Context, paramM
 		request.SubscriptionName = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetail(request)
 	if err != nil {
 		errRet = err
@@ -320,8 +303,6 @@ func (me *TcmqService) DeleteTcmqSubscribeById(ctx context.Context, topicName st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteCmqSubscribe(request)
 	if err != nil {
 		errRet = err
@@ -8,7 +8,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // basic information
@@ -35,7 +34,6 @@ func (me *TdmqService) DescribeTdmqInstanceById(ctx context.Context,
 	var response *tdmq.DescribeClustersResponse
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().DescribeClusters(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -70,7 +68,6 @@ func (me *TdmqService) ModifyTdmqInstanceAttribute(ctx context.Context, clusterI
 	request.Remark = &remark
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().ModifyCluster(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -123,7 +120,6 @@ func (me *TdmqService) CreateTdmqNamespace(ctx context.Context, environName stri
 
 	var response *tdmq.CreateEnvironmentResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().CreateEnvironment(request)
 		if err != nil {
 			return retryError(err)
@@ -155,7 +151,6 @@ func (me *TdmqService) DescribeTdmqNamespaceById(ctx context.Context,
 	var response *tdmq.DescribeEnvironmentsResponse
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().DescribeEnvironments(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -192,7 +187,6 @@ func (me *TdmqService) ModifyTdmqNamespaceAttribute(ctx context.Context, environ
 	request.RetentionPolicy = retentionPolicy
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().ModifyEnvironmentAttributes(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -217,7 +211,6 @@ func (me *TdmqService) DeleteTdmqNamespace(ctx context.Context, environId string
 	request.EnvironmentIds = []*string{&environId}
 	request.ClusterId = &clusterId
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().DeleteEnvironments(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -255,7 +248,6 @@ func (me *TdmqService) CreateTdmqTopic(ctx context.Context, environId string, to
 	}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().CreateTopic(request)
 		if err != nil {
 			return retryError(err)
@@ -286,7 +278,6 @@ func (me *TdmqService) DescribeTdmqTopicById(ctx context.Context,
 	var response *tdmq.DescribeTopicsResponse
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().DescribeTopics(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -323,7 +314,6 @@ func (me *TdmqService) ModifyTdmqTopicAttribute(ctx context.Context, environId s
 	request.ClusterId = &clusterId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().ModifyTopic(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -354,7 +344,6 @@ func (me *TdmqService) DeleteTdmqTopic(ctx context.Context, environId string, to
 	request.ClusterId = &clusterId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().DeleteTopics(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -385,7 +374,6 @@ func (me *TdmqService) CreateTdmqRole(ctx context.Context, roleName string, clus
 
 	var response *tdmq.CreateRoleResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().CreateRole(request)
 		if err != nil {
 			return retryError(err)
@@ -417,7 +405,6 @@ func (me *TdmqService) DescribeTdmqRoleById(ctx context.Context,
 	var response *tdmq.DescribeRolesResponse
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().DescribeRoles(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -452,7 +439,6 @@ func (me *TdmqService) ModifyTdmqRoleAttribute(ctx context.Context, roleName str
 	request.Remark = &remark
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().ModifyRole(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -479,7 +465,6 @@ func (me *TdmqService) DeleteTdmqRole(ctx context.Context, roleName string, clus
 	request.ClusterId = &cluserId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().DeleteRoles(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -510,7 +495,6 @@ func (me *TdmqService) CreateTdmqNamespaceRoleAttachment(ctx context.Context, en
 	request.ClusterId = &clusterId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().CreateEnvironmentRole(request)
 		if err != nil {
 			return retryError(err)
@@ -541,7 +525,6 @@ func (me *TdmqService) DescribeTdmqNamespaceRoleAttachment(ctx context.Context,
 	var response *tdmq.DescribeEnvironmentRolesResponse
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseTdmqClient().DescribeEnvironmentRoles(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -577,7 +560,6 @@ func (me *TdmqService) ModifyTdmqNamespaceRoleAttachment(ctx context.Context,
 	request.Permissions = permissions
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().ModifyEnvironmentRole(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -606,7 +588,6 @@ func (me *TdmqService) DeleteTdmqNamespaceRoleAttachment(ctx context.Context, en
 	request.ClusterId = &cluserId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseTdmqClient().DeleteEnvironmentRoles(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -11,7 +11,6 @@ import (
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TdmqRocketmqService struct {
@@ -60,7 +59,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqClusterById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQCluster(request)
 	if err != nil {
 		errRet = err
@@ -92,7 +90,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespace(ctx context.Context
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -129,7 +126,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqNamespaceById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQNamespace(request)
 	if err != nil {
 		errRet = err
@@ -162,7 +158,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 			Values: []*string{&roleName},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -171,7 +166,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -216,7 +210,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqRoleById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRoles(request)
 	if err != nil {
 		errRet = err
@@ -251,7 +244,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopic(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -291,7 +283,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqTopicById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQTopic(request)
 	if err != nil {
 		errRet = err
@@ -326,7 +317,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroup(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -376,7 +366,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqGroupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQGroup(request)
 	if err != nil {
 		errRet = err
@@ -410,7 +399,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqEnvironmentRole(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeEnvironmentRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -449,7 +437,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqEnvironmentRoleById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteEnvironmentRoles(request)
 	if err != nil {
 		errRet = err
@@ -488,15 +475,12 @@ func (me *TdmqRocketmqService) DescribeRocketmqClusterByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var offset uint64 = 0
 	var pageSize uint64 = 20
 
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQClusters(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -542,7 +526,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -550,7 +533,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -609,7 +591,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -617,7 +598,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -656,7 +636,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	if v, ok := param["role_name"]; ok {
 		request.RoleName = helper.String(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -664,7 +643,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -722,7 +700,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -730,7 +707,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -775,8 +751,6 @@ func (me *TdmqService) DescribeTdmqEnvironmentAttributesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeEnvironmentAttributes(request)
 	if err != nil {
 		errRet = err
@@ -817,8 +791,6 @@ func (me *TdmqService) DescribeTdmqPublisherSummaryByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribePublisherSummary(request)
 	if err != nil {
 		errRet = err
@@ -865,8 +837,6 @@ func (me *TdmqService) DescribeTdmqPublishersByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -911,8 +881,6 @@ func (me *TdmqService) DescribeTdmqSubscriptionAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeSubscriptions(request)
 	if err != nil {
 		errRet = err
@@ -951,8 +919,6 @@ func (me *TdmqService) GetTdmqTopicsAttachmentById(ctx context.Context, environm
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeTopics(request)
 	if err != nil {
 		errRet = err
@@ -994,8 +960,6 @@ func (me *TdmqService) DeleteTdmqTopicsAttachmentById(ctx context.Context, envir
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteTopics(request)
 	if err != nil {
 		errRet = err
@@ -1027,8 +991,6 @@ func (me *TdmqService) DeleteTdmqSubscriptionAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteSubscriptions(request)
 	if err != nil {
 		errRet = err
@@ -1061,8 +1023,6 @@ func (me *TdmqService) DescribeTdmqDeadLetterSourceQueueByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1123,8 +1083,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqNodeListByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1174,8 +1132,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1224,8 +1180,6 @@ func (me *TdmqService) DescribeTdmqVipInstanceByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -1260,8 +1214,6 @@ func (me *TdmqService) DescribeTdmqProInstanceDetailByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribePulsarProInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -1297,8 +1249,6 @@ func (me *TdmqService) DescribeTdmqProInstancesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1361,8 +1311,6 @@ func (me *TdmqService) DescribeTdmqMessageByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQMsg(request)
 	if err != nil {
 		errRet = err
@@ -1398,8 +1346,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostListByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1445,8 +1391,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqUserById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQUser(request)
 	if err != nil {
 		errRet = err
@@ -1475,8 +1419,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqUserById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQUser(request)
 	if err != nil {
 		errRet = err
@@ -1503,8 +1445,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHost(request)
 	if err != nil {
 		errRet = err
@@ -1534,8 +1474,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqVirtualHostById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQVirtualHost(request)
 	if err != nil {
 		errRet = err
@@ -1559,8 +1497,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstance(request)
 	if err != nil {
 		errRet = err
@@ -1587,8 +1523,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqVipInstanceById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQVipInstance(request)
 	if err != nil {
 		errRet = err
@@ -1611,8 +1545,6 @@ func (me *TdmqService) DescribeTdmqRocketmqVipInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -1644,8 +1576,6 @@ func (me *TdmqService) DescribeTdmqRocketmqVipInstancesByFilter(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstances(request)
 	if err != nil {
 		errRet = err
@@ -1673,8 +1603,6 @@ func (me *TdmqService) DeleteTdmqRocketmqVipInstanceById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRocketMQVipInstance(request)
 	if err != nil {
 		errRet = err
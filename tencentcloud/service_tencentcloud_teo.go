@@ -9,7 +9,6 @@ import (
 
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TeoService struct {
@@ -36,7 +35,6 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 			Values: []*string{&zoneId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -45,7 +43,6 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeZones(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -87,7 +84,6 @@ func (me *TeoService) DeleteTeoZoneById(ctx context.Context, zoneId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteZone(request)
 	if err != nil {
 		errRet = err
@@ -135,7 +131,6 @@ func (me *TeoService) DescribeTeoOriginGroup(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeOriginGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -178,7 +173,6 @@ func (me *TeoService) DeleteTeoOriginGroupById(ctx context.Context, zoneId, orig
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteOriginGroup(request)
 	if err != nil {
 		errRet = err
@@ -212,7 +206,6 @@ func (me *TeoService) DescribeTeoRuleEngine(ctx context.Context, zoneId, ruleId
 			Values: []*string{&ruleId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -251,7 +244,6 @@ func (me *TeoService) DescribeTeoRuleEngines(ctx context.Context, zoneId string)
 	}()
 
 	request.ZoneId = &zoneId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -285,7 +277,6 @@ func (me *TeoService) DeleteTeoRuleEngineById(ctx context.Context, zoneId, ruleI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteRules(request)
 	if err != nil {
 		errRet = err
@@ -325,7 +316,6 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 			Values: []*string{&proxyId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -334,7 +324,6 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -378,7 +367,6 @@ func (me *TeoService) DeleteTeoApplicationProxyById(ctx context.Context, zoneId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteApplicationProxy(request)
 	if err != nil {
 		errRet = err
@@ -419,7 +407,6 @@ func (me *TeoService) DescribeTeoApplicationProxyRule(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -460,7 +447,6 @@ func (me *TeoService) DeleteTeoApplicationProxyRuleById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteApplicationProxyRule(request)
 	if err != nil {
 		errRet = err
@@ -529,7 +515,6 @@ func (me *TeoService) DescribeTeoDefaultCertificate(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeDefaultCertificates(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -635,7 +620,6 @@ func (me *TeoService) DescribeTeoRuleEngineSettingsByFilter(ctx context.Context)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRulesSetting(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -17,7 +17,6 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ClusterBasicSetting struct {
@@ -140,8 +139,7 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterInstances(request)
+	response, err := me.client.UseTkeClient().DescribeClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -218,7 +216,7 @@ func (me *TkeService) DescribeClusters(ctx context.Context, id string, name stri
 		request.Filters = []*tke.Filter{filter}
 	}
 
-	response, err := me.client.UseTkeClient().DescribeClusters(request)
+	response, err := me.client.UseTkeClient().DescribeClustersWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -288,8 +286,7 @@ func (me *TkeService) DescribeCluster(ctx context.Context, id string) (
 
 	request.ClusterIds = []*string{&id}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusters(request)
+	response, err := me.client.UseTkeClient().DescribeClustersWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -342,8 +339,7 @@ func (me *TkeService) DescribeClusterCommonNames(ctx context.Context, request *t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterCommonNames(request)
+	response, err := me.client.UseTkeClient().DescribeClusterCommonNamesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -371,8 +367,7 @@ func (me *TkeService) DescribeClusterLevelAttribute(ctx context.Context, id stri
 	if id != "" {
 		request.ClusterID = &id
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterLevelAttribute(request)
+	response, err := me.client.UseTkeClient().DescribeClusterLevelAttributeWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -404,8 +399,7 @@ func (me *TkeService) DescribeClusterConfig(ctx context.Context, id string, isPu
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterKubeconfig(request)
+	response, err := me.client.UseTkeClient().DescribeClusterKubeconfigWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -437,8 +431,7 @@ func (me *TkeService) GetUpgradeInstanceResult(ctx context.Context, id string) (
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgress(request)
+	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgressWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -629,8 +622,7 @@ func (me *TkeService) CreateCluster(ctx context.Context,
 		request.ExistedInstancesForNode = existedInstance
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateCluster(request)
+	response, err := me.client.UseTkeClient().CreateClusterWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -658,8 +650,7 @@ func (me *TkeService) CreateClusterInstances(ctx context.Context,
 
 	request.InstanceAdvancedSettings = &iAdvanced
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterInstances(request)
+	response, err := me.client.UseTkeClient().CreateClusterInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -681,7 +672,7 @@ func (me *TkeService) CreateClusterInstances(ctx context.Context,
 }
 
 func (me *TkeService) CheckOneOfClusterNodeReady(ctx context.Context, clusterId string, mustHaveWorkers bool) error {
-	return resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
+	return resource.RetryContext(ctx, readRetryTimeout*5, func() *resource.RetryError {
 		_, workers, err := me.DescribeClusterInstances(ctx, clusterId)
 		if err != nil {
 			return retryError(err)
@@ -738,8 +729,7 @@ func (me *TkeService) DeleteClusterInstances(ctx context.Context, id string, ins
 	}
 
 	request.InstanceDeleteMode = helper.String("terminate")
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterInstances(request)
+	_, err := me.client.UseTkeClient().DeleteClusterInstancesWithContext(ctx, request)
 	return err
 }
 
@@ -757,8 +747,7 @@ func (me *TkeService) DeleteCluster(ctx context.Context, id string) (errRet erro
 	request.ClusterId = &id
 	request.InstanceDeleteMode = helper.String("terminate")
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteCluster(request)
+	_, err := me.client.UseTkeClient().DeleteClusterWithContext(ctx, request)
 
 	return err
 }
@@ -776,7 +765,7 @@ func (me *TkeService) DescribeClusterSecurity(ctx context.Context, id string) (r
 	}()
 	request.ClusterId = &id
 
-	return me.client.UseTkeClient().DescribeClusterSecurity(request)
+	return me.client.UseTkeClient().DescribeClusterSecurityWithContext(ctx, request)
 }
 
 func (me *TkeService) CreateClusterAsGroup(ctx context.Context, id, groupPara, configPara string, labels []*tke.Label, iAdvanced InstanceAdvancedSettings) (asGroupId string, errRet error) {
@@ -790,8 +779,7 @@ func (me *TkeService) DescribeClusterAsGroupsByGroupId(ctx context.Context, id s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&groupId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterAsGroups(request)
+	response, err := me.client.UseTkeClient().DescribeClusterAsGroupsWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), err.Error())
@@ -818,8 +806,7 @@ func (me *TkeService) DeleteClusterAsGroups(ctx context.Context, id, asGroupId s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&asGroupId}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterAsGroups(request)
+	_, err := me.client.UseTkeClient().DeleteClusterAsGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -858,8 +845,7 @@ func (me *TkeService) CreateClusterEndpoint(ctx context.Context, id string, subn
 		request.ExtensiveParameters = helper.String(extensiveParameters)
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().CreateClusterEndpoint(request)
+	_, err := me.client.UseTkeClient().CreateClusterEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -878,9 +864,7 @@ func (me *TkeService) DescribeClusterEndpointStatus(ctx context.Context, id stri
 	request.ClusterId = &id
 	request.IsExtranet = &isExtranet
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseTkeClient().DescribeClusterEndpointStatus(request)
+	response, err := me.client.UseTkeClient().DescribeClusterEndpointStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -905,9 +889,7 @@ func (me *TkeService) DeleteClusterEndpoint(ctx context.Context, id string, isIn
 	request.ClusterId = &id
 	request.IsExtranet = &isInternet
 
-	ratelimit.Check(request.GetAction())
-
-	_, err := me.client.UseTkeClient().DeleteClusterEndpoint(request)
+	_, err := me.client.UseTkeClient().DeleteClusterEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -926,9 +908,7 @@ func (me *TkeService) ModifyClusterEndpointSP(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityPolicies = helper.Strings(securityPolicies)
 
-	ratelimit.Check(request.GetAction())
-
-	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
+	_, err := me.client.UseTkeClient().ModifyClusterEndpointSPWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -947,9 +927,7 @@ func (me *TkeService) ModifyClusterEndpointSG(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityGroup = &securityGroup
 
-	ratelimit.Check(request.GetAction())
-
-	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
+	_, err := me.client.UseTkeClient().ModifyClusterEndpointSPWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -978,9 +956,7 @@ func (me *TkeService) ModifyClusterAttribute(ctx context.Context, id string, pro
 		IsAutoUpgrade: &autoUpgradeClusterLevel,
 	}
 
-	ratelimit.Check(request.GetAction())
-
-	_, err := me.client.UseTkeClient().ModifyClusterAttribute(request)
+	_, err := me.client.UseTkeClient().ModifyClusterAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1037,9 +1013,7 @@ func (me *TkeService) ModifyClusterVersion(ctx context.Context, id string, clust
 	request.ClusterId = &id
 	request.DstVersion = &clusterVersion
 
-	ratelimit.Check(request.GetAction())
-
-	_, err := me.client.UseTkeClient().UpdateClusterVersion(request)
+	_, err := me.client.UseTkeClient().UpdateClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1068,9 +1042,7 @@ func (me *TkeService) DescribeKubernetesAvailableClusterVersionsByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
+	response, err := me.client.UseTkeClient().DescribeAvailableClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1092,9 +1064,8 @@ func (me *TkeService) CheckClusterVersion(ctx context.Context, id string, cluste
 		}
 	}()
 	request.ClusterId = &id
-	ratelimit.Check(request.GetAction())
 
-	resp, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
+	resp, err := me.client.UseTkeClient().DescribeAvailableClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1124,9 +1095,8 @@ func (me *TkeService) CheckInstancesUpgradeAble(ctx context.Context, id string,
 	}()
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
-	ratelimit.Check(request.GetAction())
 
-	resp, err := me.client.UseTkeClient().CheckInstancesUpgradeAble(request)
+	resp, err := me.client.UseTkeClient().CheckInstancesUpgradeAbleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1155,9 +1125,8 @@ func (me *TkeService) UpgradeClusterInstances(ctx context.Context, id string, up
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
 	request.InstanceIds = helper.Strings(instanceIds)
-	ratelimit.Check(request.GetAction())
 
-	_, err := me.client.UseTkeClient().UpgradeClusterInstances(request)
+	_, err := me.client.UseTkeClient().UpgradeClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1175,8 +1144,7 @@ func (me *TkeService) DescribeImages(ctx context.Context) (imageIds []string, er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeImages(request)
+	response, err := me.client.UseTkeClient().DescribeImagesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1228,8 +1196,7 @@ func (me *TkeService) ModifyClusterAsGroupAttribute(ctx context.Context, id, asG
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterAsGroupAttribute(request)
+	_, err := me.client.UseTkeClient().ModifyClusterAsGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1263,8 +1230,7 @@ func (me *TkeService) CreateClusterNodePool(ctx context.Context, clusterId, name
 		request.Taints = taints
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterNodePool(request)
+	response, err := me.client.UseTkeClient().CreateClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1315,8 +1281,7 @@ func (me *TkeService) ModifyClusterNodePool(ctx context.Context, clusterId, node
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterNodePool(request)
+	_, err := me.client.UseTkeClient().ModifyClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1337,8 +1302,7 @@ func (me *TkeService) ModifyClusterNodePoolDesiredCapacity(ctx context.Context,
 	request.NodePoolId = &nodePoolId
 	request.DesiredCapacity = &desiredCapacity
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyNodePoolDesiredCapacityAboutAsg(request)
+	_, err := me.client.UseTkeClient().ModifyNodePoolDesiredCapacityAboutAsgWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1359,8 +1323,7 @@ func (me *TkeService) ModifyClusterNodePoolInstanceTypes(ctx context.Context, cl
 	request.NodePoolId = &nodePoolId
 	request.InstanceTypes = instanceTypes
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyNodePoolInstanceTypes(request)
+	_, err := me.client.UseTkeClient().ModifyNodePoolInstanceTypesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1382,8 +1345,7 @@ func (me *TkeService) DeleteClusterNodePool(ctx context.Context, id, nodePoolId
 	request.NodePoolIds = []*string{&nodePoolId}
 	request.KeepInstance = &deleteKeepInstance
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterNodePool(request)
+	_, err := me.client.UseTkeClient().DeleteClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1420,8 +1382,7 @@ func (me *TkeService) DescribeNodePool(ctx context.Context, clusterId string, no
 	request.ClusterId = helper.String(clusterId)
 	request.NodePoolId = helper.String(nodePoolId)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterNodePoolDetail(request)
+	response, err := me.client.UseTkeClient().DescribeClusterNodePoolDetailWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1447,8 +1408,7 @@ func (me *TkeService) ModifyClusterNodePoolGlobalConfig(ctx context.Context, req
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterAsGroupOptionAttribute(request)
+	_, err := me.client.UseTkeClient().ModifyClusterAsGroupOptionAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1483,8 +1443,7 @@ func (me *TkeService) DescribeClusterNodePoolGlobalConfig(ctx context.Context, c
 
 	request.ClusterId = helper.String(clusterId)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterAsGroupOption(request)
+	response, err := me.client.UseTkeClient().DescribeClusterAsGroupOptionWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1501,7 +1460,7 @@ func (me *TkeService) DescribeClusterNodePoolGlobalConfig(ctx context.Context, c
 }
 
 func (me *TkeService) WaitForAuthenticationOptionsUpdateSuccess(ctx context.Context, id string) (info *tke.ServiceAccountAuthenticationOptions, errRet error) {
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		options, state, _, err := me.DescribeClusterAuthenticationOptions(ctx, id)
 		info = options
 
@@ -1539,8 +1498,7 @@ func (me *TkeService) DescribeClusterAuthenticationOptions(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	res, err := me.client.UseTkeClient().DescribeClusterAuthenticationOptions(request)
+	res, err := me.client.UseTkeClient().DescribeClusterAuthenticationOptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1562,8 +1520,7 @@ func (me *TkeService) ModifyClusterAuthenticationOptions(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().ModifyClusterAuthenticationOptions(request)
+	response, err := me.client.UseTkeClient().ModifyClusterAuthenticationOptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1584,8 +1541,7 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewEnableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
-		response, err := me.client.UseTkeClient().EnableClusterDeletionProtection(request)
+		response, err := me.client.UseTkeClient().EnableClusterDeletionProtectionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1597,8 +1553,7 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewDisableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
-		response, err := me.client.UseTkeClient().DisableClusterDeletionProtection(request)
+		response, err := me.client.UseTkeClient().DisableClusterDeletionProtectionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1630,8 +1585,7 @@ func (me *TkeService) AcquireClusterAdminRole(ctx context.Context, clusterId str
 
 	request.ClusterId = &clusterId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().AcquireClusterAdminRole(request)
+	response, err := me.client.UseTkeClient().AcquireClusterAdminRoleWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1706,8 +1660,7 @@ func (me *TkeService) InstallLogAgent(ctx context.Context, request *tke.InstallL
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().InstallLogAgent(request)
+	response, err := me.client.UseTkeClient().InstallLogAgentWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1729,8 +1682,7 @@ func (me *TkeService) UninstallLogAgent(ctx context.Context, request *tke.Uninst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().UninstallLogAgent(request)
+	response, err := me.client.UseTkeClient().UninstallLogAgentWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1752,8 +1704,7 @@ func (me *TkeService) EnableEventPersistence(ctx context.Context, request *tke.E
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().EnableEventPersistence(request)
+	response, err := me.client.UseTkeClient().EnableEventPersistenceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1775,8 +1726,7 @@ func (me *TkeService) DisableEventPersistence(ctx context.Context, request *tke.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DisableEventPersistence(request)
+	response, err := me.client.UseTkeClient().DisableEventPersistenceWithContext(ctx, request)
 
 	if err != nil {
 		code := err.(*sdkErrors.TencentCloudSDKError).Code
@@ -1802,8 +1752,7 @@ func (me *TkeService) EnableClusterAudit(ctx context.Context, request *tke.Enabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().EnableClusterAudit(request)
+	response, err := me.client.UseTkeClient().EnableClusterAuditWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1825,8 +1774,7 @@ func (me *TkeService) DisableClusterAudit(ctx context.Context, request *tke.Disa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DisableClusterAudit(request)
+	response, err := me.client.UseTkeClient().DisableClusterAuditWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1852,8 +1800,7 @@ func (me *TkeService) DescribeServerlessNodePoolByClusterIdAndNodePoolId(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodePools(request)
+	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodePoolsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1884,8 +1831,7 @@ func (me *TkeService) CreateClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterVirtualNodePool(request)
+	response, err := me.client.UseTkeClient().CreateClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1912,8 +1858,7 @@ func (me *TkeService) DeleteClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DeleteClusterVirtualNodePool(request)
+	response, err := me.client.UseTkeClient().DeleteClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1936,8 +1881,7 @@ func (me *TkeService) ModifyClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().ModifyClusterVirtualNodePool(request)
+	response, err := me.client.UseTkeClient().ModifyClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1963,8 +1907,7 @@ func (me *TkeService) DescribeClusterVirtualNode(ctx context.Context, clusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterVirtualNode(request)
+	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodeWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2028,7 +1971,7 @@ func ModifySecurityServiceOfCvmInNodePool(ctx context.Context, d *schema.Resourc
 				for _, ins := range instanceSet {
 					requestDeleteMachine := cwp.NewDeleteMachineRequest()
 					requestDeleteMachine.Uuid = ins.Uuid
-					if _, err := client.UseCwpClient().DeleteMachine(requestDeleteMachine); err != nil {
+					if _, err := client.UseCwpClient().DeleteMachineWithContext(ctx, requestDeleteMachine); err != nil {
 						log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 							logId, requestDeleteMachine.GetAction(), requestDeleteMachine.ToJsonString(), err.Error())
 						return err
@@ -2051,7 +1994,7 @@ func ModifySecurityServiceOfCvmInNodePool(ctx context.Context, d *schema.Resourc
 				requestInvokeCommand.CommandId = helper.String(InstallSecurityAgentCommandId)
 				requestInvokeCommand.Parameters = helper.String("{}")
 				requestInvokeCommand.Timeout = helper.Uint64(60)
-				_, err := client.UseTatClient().InvokeCommand(requestInvokeCommand)
+				_, err := client.UseTatClient().InvokeCommandWithContext(ctx, requestInvokeCommand)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 						logId, requestInvokeCommand.GetAction(), requestInvokeCommand.ToJsonString(), err.Error())
@@ -2075,7 +2018,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 	}
 	// open access
 	if enable {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeSvc.CreateClusterEndpoint(ctx, id, subnetId, sg, isInternet, domain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2085,7 +2028,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2104,7 +2047,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 			return err
 		}
 	} else { // close access
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeSvc.DeleteClusterEndpoint(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2114,7 +2057,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2146,8 +2089,7 @@ func (me *TkeService) createBackupStorageLocation(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateBackupStorageLocation(request)
+	response, err := me.client.UseTkeClient().CreateBackupStorageLocationWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2173,8 +2115,7 @@ func (me *TkeService) describeBackupStorageLocations(ctx context.Context, names
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeBackupStorageLocations(request)
+	response, err := me.client.UseTkeClient().DescribeBackupStorageLocationsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2203,8 +2144,7 @@ func (me *TkeService) deleteBackupStorageLocation(ctx context.Context, name stri
 	}()
 	request.Name = common.StringPtr(name)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteBackupStorageLocation(request)
+	_, err := me.client.UseTkeClient().DeleteBackupStorageLocationWithContext(ctx, request)
 	return err
 }
 
@@ -2220,9 +2160,7 @@ func (me *TkeService) DescribeTkeEncryptionProtectionById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseTkeClient().DescribeEncryptionStatus(request)
+	response, err := me.client.UseTkeClient().DescribeEncryptionStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2245,9 +2183,7 @@ func (me *TkeService) DeleteTkeEncryptionProtectionById(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseTkeClient().DisableEncryptionProtection(request)
+	response, err := me.client.UseTkeClient().DisableEncryptionProtectionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2274,7 +2210,7 @@ func (me *TkeService) TkeEncryptionProtectionStateRefreshFunc(clusterId string,
 			}
 		}()
 
-		object, err := me.client.UseTkeClient().DescribeEncryptionStatus(request)
+		object, err := me.client.UseTkeClient().DescribeEncryptionStatusWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
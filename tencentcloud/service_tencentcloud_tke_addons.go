@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type AddonSpecChart struct {
@@ -62,7 +61,6 @@ func (me *TkeService) GetTkeAppChartList(ctx context.Context, request *tke.GetTk
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().GetTkeAppChartList(request)
 
 	if err != nil {
@@ -126,7 +124,6 @@ func (me *TkeService) ProcessExtensionAddons(ctx context.Context, request *tke.F
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err = me.client.UseTkeClient().ForwardApplicationRequestV3(request)
 
 	if err != nil {
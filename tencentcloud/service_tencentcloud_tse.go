@@ -11,7 +11,6 @@ import (
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TseService struct {
@@ -34,8 +33,6 @@ func (me *TseService) DescribeTseInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeSREInstances(request)
 	if err != nil {
 		errRet = err
@@ -120,8 +117,6 @@ func (me *TseService) DeleteTseInstanceById(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteEngine(request)
 	if err != nil {
 		errRet = err
@@ -162,7 +157,6 @@ func (me *TseService) DescribeTseAccessAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTseClient().DescribeSREInstanceAccessAddress(request)
 	if err != nil {
 		errRet = err
@@ -196,8 +190,6 @@ func (me *TseService) DescribeTseNacosReplicasByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -244,8 +236,6 @@ func (me *TseService) DescribeTseZookeeperReplicasByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -292,8 +282,6 @@ func (me *TseService) DescribeTseZookeeperServerInterfacesByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -336,8 +324,6 @@ func (me *TseService) DescribeTseNacosServerInterfacesByFilter(ctx context.Conte
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -387,8 +373,6 @@ func (me *TseService) DescribeTseGatewayNodesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -438,8 +422,6 @@ func (me *TseService) DescribeTseGatewayCanaryRulesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -503,8 +485,6 @@ func (me *TseService) DescribeTseGatewayRoutesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -562,8 +542,6 @@ func (me *TseService) DescribeTseGatewayServicesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -613,8 +591,6 @@ func (me *TseService) DescribeTseCngwServiceById(ctx context.Context, gatewayId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeOneCloudNativeAPIGatewayService(request)
 	if err != nil {
 		errRet = err
@@ -643,8 +619,6 @@ func (me *TseService) DeleteTseCngwServiceById(ctx context.Context, gatewayId, n
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayService(request)
 	if err != nil {
 		errRet = err
@@ -668,8 +642,6 @@ func (me *TseService) DescribeTseCngwServiceRateLimitById(ctx context.Context, g
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServiceRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -698,8 +670,6 @@ func (me *TseService) DeleteTseCngwServiceRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayServiceRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -723,8 +693,6 @@ func (me *TseService) DescribeTseCngwRouteById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutes(request)
 	if err != nil {
 		errRet = err
@@ -758,8 +726,6 @@ func (me *TseService) DeleteTseCngwRouteById(ctx context.Context, gatewayId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRoute(request)
 	if err != nil {
 		errRet = err
@@ -783,8 +749,6 @@ func (me *TseService) DescribeTseCngwRouteRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRouteRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -813,8 +777,6 @@ func (me *TseService) DeleteTseCngwRouteRateLimitById(ctx context.Context, gatew
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRouteRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -843,8 +805,6 @@ func (me *TseService) DescribeTseCngwCanaryRuleById(ctx context.Context, gateway
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRules(request)
 	if err != nil {
 		errRet = err
@@ -885,8 +845,6 @@ func (me *TseService) DeleteTseCngwCanaryRuleById(ctx context.Context, gatewayId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCanaryRule(request)
 	if err != nil {
 		errRet = err
@@ -909,8 +867,6 @@ func (me *TseService) DescribeTseCngwGatewayById(ctx context.Context, gatewayId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGateway(request)
 	if err != nil {
 		errRet = err
@@ -938,8 +894,6 @@ func (me *TseService) DeleteTseCngwGatewayById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGateway(request)
 	if err != nil {
 		errRet = err
@@ -1032,8 +986,6 @@ func (me *TseService) DescribeTseCngwGroupById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeNativeGatewayServerGroups(request)
 	if err != nil {
 		errRet = err
@@ -1062,8 +1014,6 @@ func (me *TseService) DeleteTseCngwGroupById(ctx context.Context, gatewayId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteNativeGatewayServerGroup(request)
 	if err != nil {
 		errRet = err
@@ -1092,8 +1042,6 @@ func (me *TseService) DescribeTseGatewaysByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1151,8 +1099,6 @@ func (me *TseService) DescribeTseGroupsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
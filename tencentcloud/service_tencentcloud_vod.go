@@ -10,7 +10,6 @@ import (
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type VodService struct {
@@ -43,7 +42,6 @@ func (me *VodService) DescribeAdaptiveDynamicStreamingTemplatesByFilter(ctx cont
 		var response *vod.DescribeAdaptiveDynamicStreamingTemplatesResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeAdaptiveDynamicStreamingTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -100,7 +98,6 @@ func (me *VodService) DeleteAdaptiveDynamicStreamingTemplate(ctx context.Context
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteAdaptiveDynamicStreamingTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -140,7 +137,6 @@ func (me *VodService) DescribeProcedureTemplatesByFilter(ctx context.Context, fi
 		var response *vod.DescribeProcedureTemplatesResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeProcedureTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -196,7 +192,6 @@ func (me *VodService) DeleteProcedureTemplate(ctx context.Context, templateId st
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteProcedureTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -237,7 +232,6 @@ func (me *VodService) DescribeSnapshotByTimeOffsetTemplatesByFilter(ctx context.
 		var response *vod.DescribeSnapshotByTimeOffsetTemplatesResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeSnapshotByTimeOffsetTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -294,7 +288,6 @@ func (me *VodService) DeleteSnapshotByTimeOffsetTemplate(ctx context.Context, te
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteSnapshotByTimeOffsetTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -335,7 +328,6 @@ func (me *VodService) DescribeImageSpriteTemplatesByFilter(ctx context.Context,
 		var response *vod.DescribeImageSpriteTemplatesResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeImageSpriteTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -392,7 +384,6 @@ func (me *VodService) DeleteImageSpriteTemplate(ctx context.Context, templateId
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteImageSpriteTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -432,7 +423,6 @@ func (me *VodService) DescribeSuperPlayerConfigsByFilter(ctx context.Context, fi
 		var response *vod.DescribeSuperPlayerConfigsResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeSuperPlayerConfigs(request)
 			if err != nil {
 				return retryError(err)
@@ -487,7 +477,6 @@ func (me *VodService) DeleteSuperPlayerConfig(ctx context.Context, configId stri
 	}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteSuperPlayerConfig(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -20,7 +20,6 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 var eipUnattachLocker = &sync.Mutex{}
@@ -240,7 +239,6 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 
 	var response *vpc.CreateVpcResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateVpc(request)
 		if err != nil {
 			return retryError(err)
@@ -339,8 +337,7 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeVpcsResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().DescribeVpcs(request)
+		result, err := me.client.UseVpcClient().DescribeVpcsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -496,7 +493,6 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeSubnetsResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().DescribeSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -569,7 +565,6 @@ func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string
 	request.EnableMulticast = &enableMulticast
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifyVpcAttribute(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -600,7 +595,6 @@ func (me *VpcService) DeleteVpc(ctx context.Context, vpcId string) (errRet error
 	request.VpcId = &vpcId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteVpc(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -645,7 +639,6 @@ func (me *VpcService) CreateSubnet(ctx context.Context, vpcId, name, cidr, zone
 
 	var response *vpc.CreateSubnetResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateSubnet(request)
 		if err != nil {
 			return retryError(err)
@@ -678,7 +671,6 @@ func (me *VpcService) ModifySubnetAttribute(ctx context.Context, subnetId, name
 	request.SubnetName = &name
 	request.EnableBroadcast = &enableMulticast
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySubnetAttribute(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -703,7 +695,6 @@ func (me *VpcService) DeleteSubnet(ctx context.Context, subnetId string) (errRet
 	}()
 	request.SubnetId = &subnetId
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteSubnet(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -728,7 +719,6 @@ func (me *VpcService) ReplaceRouteTableAssociation(ctx context.Context, subnetId
 	}()
 	request.SubnetId = &subnetId
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReplaceRouteTableAssociation(request)
 
 	errRet = err
@@ -827,7 +817,6 @@ getMoreData:
 
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
@@ -910,7 +899,6 @@ func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string,
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRouteTable(request)
 	errRet = err
 	if err == nil {
@@ -938,7 +926,6 @@ func (me *VpcService) DeleteRouteTable(ctx context.Context, routeTableId string)
 		return
 	}
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRouteTable(request)
 	errRet = err
 	if err == nil {
@@ -966,7 +953,6 @@ func (me *VpcService) ModifyRouteTableAttribute(ctx context.Context, routeTableI
 	}
 	request.RouteTableId = &routeTableId
 	request.RouteTableName = &name
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyRouteTableAttribute(request)
 	errRet = err
 	if err == nil {
@@ -1042,7 +1028,6 @@ func (me *VpcService) DeleteRoutes(ctx context.Context, routeTableId string, ent
 	var route vpc.Route
 	route.RouteId = &entryId
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRoutes(request)
 	errRet = err
 	if err == nil {
@@ -1076,7 +1061,6 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 	route.GatewayId = &nextHub
 	route.Enabled = &enabled
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRoutes(request)
 	errRet = err
 	if err == nil {
@@ -1143,7 +1127,6 @@ func (me *VpcService) EnableRoutes(ctx context.Context, request *vpc.EnableRoute
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().EnableRoutes(request)
 
 	if err != nil {
@@ -1165,7 +1148,6 @@ func (me *VpcService) DisableRoutes(ctx context.Context, request *vpc.DisableRou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisableRoutes(request)
 
 	if err != nil {
@@ -1201,8 +1183,6 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().CreateSecurityGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1233,8 +1213,6 @@ func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg
 	request.SecurityGroupIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1273,7 +1251,6 @@ func (me *VpcService) ModifySecurityGroup(ctx context.Context, id string, newNam
 	request.GroupDescription = newDesc
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySecurityGroupAttribute(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1297,8 +1274,6 @@ func (me *VpcService) DeleteSecurityGroup(ctx context.Context, id string) error
 	request.SecurityGroupId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroup(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1319,7 +1294,6 @@ func (me *VpcService) DescribeSecurityGroupsAssociate(ctx context.Context, ids [
 
 	request := vpc.NewDescribeSecurityGroupAssociationStatisticsRequest()
 	request.SecurityGroupIds = common.StringPtrs(ids)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupAssociationStatistics(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1374,7 +1348,6 @@ func (me *VpcService) CreateSecurityGroupPolicy(ctx context.Context, info securi
 	case "egress":
 		createRequest.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(createRequest.GetAction())
 	if _, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(createRequest); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -1411,7 +1384,6 @@ func (me *VpcService) CreateSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1437,7 +1409,6 @@ func (me *VpcService) DescribeSecurityGroupPolicy(ctx context.Context, ruleId st
 
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &info.SgId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1496,7 +1467,6 @@ func (me *VpcService) DescribeSecurityGroupPolicies(ctx context.Context, sgId st
 	}()
 	request.SecurityGroupId = &sgId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1574,7 +1544,6 @@ func (me *VpcService) DeleteSecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1593,7 +1562,6 @@ func (me *VpcService) DeleteSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1623,7 +1591,6 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndex(ctx context.Context
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1655,7 +1622,6 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndexList(ctx context.Con
 		request.SecurityGroupPolicySet.Egress = tmpList
 	}
 
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1694,7 +1660,6 @@ func (me *VpcService) ModifySecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().ReplaceSecurityGroupPolicy(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1713,7 +1678,6 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1766,8 +1730,6 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 		request.Offset = helper.String(strconv.Itoa(offset))
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 			if err != nil {
 				count = 0
@@ -1809,8 +1771,6 @@ func (me *VpcService) modifyLiteRulesInSecurityGroup(ctx context.Context, sgId s
 	request.SecurityGroupPolicySet.Ingress = getSecurityGroupPolicies(ingress)
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1835,8 +1795,6 @@ func (me *VpcService) DeleteLiteRules(ctx context.Context, sgId string, rules []
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1867,8 +1825,6 @@ func (me *VpcService) DescribeSecurityGroupPolices(ctx context.Context, sgId str
 	request.SecurityGroupId = &sgId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1979,8 +1935,6 @@ func (me *VpcService) DetachAllLiteRulesFromSecurityGroup(ctx context.Context, s
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2271,7 +2225,6 @@ func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *v
 	request := vpc.NewDescribeAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2304,7 +2257,6 @@ func (me *VpcService) DescribeEipByFilter(ctx context.Context, filters map[strin
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2325,7 +2277,6 @@ func (me *VpcService) ModifyEipName(ctx context.Context, eipId, eipName string)
 	request.AddressId = &eipId
 	request.AddressName = &eipName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2344,7 +2295,6 @@ func (me *VpcService) ModifyEipBandwidthOut(ctx context.Context, eipId string, b
 	request.AddressIds = []*string{&eipId}
 	request.InternetMaxBandwidthOut = helper.IntInt64(bandwidthOut)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressesBandwidth(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2371,7 +2321,6 @@ func (me *VpcService) ModifyEipInternetChargeType(ctx context.Context, eipId str
 		request.AddressChargePrepaid = &addressChargePrepaid
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressInternetChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2392,7 +2341,6 @@ func (me *VpcService) RenewAddress(ctx context.Context, eipId string, period int
 	addressChargePrepaid.AutoRenewFlag = helper.IntInt64(renewFlag)
 	addressChargePrepaid.Period = helper.IntInt64(period)
 	request.AddressChargePrepaid = &addressChargePrepaid
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RenewAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2410,7 +2358,6 @@ func (me *VpcService) DeleteEip(ctx context.Context, eipId string) error {
 	request := vpc.NewReleaseAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReleaseAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2429,7 +2376,6 @@ func (me *VpcService) AttachEip(ctx context.Context, eipId, instanceId string) e
 	request.AddressId = &eipId
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().AssociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2453,7 +2399,6 @@ func (me *VpcService) DescribeNatGatewayById(ctx context.Context, natGateWayId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 
 	if err != nil {
@@ -2492,7 +2437,6 @@ func (me *VpcService) DescribeNatGatewayByFilter(ctx context.Context, filters ma
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2526,7 +2470,6 @@ func (me *VpcService) DeleteNatGateway(ctx context.Context, natGatewayId string)
 	request.NatGatewayId = &natGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -2578,7 +2521,6 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 
 	request.PublicIpAddresses = candidates
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateNatGatewayAddress(request)
 
 	if err != nil {
@@ -2634,7 +2576,6 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 
 	request := vpc.NewDisassociateAddressRequest()
 	request.AddressId = &eipId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2652,7 +2593,6 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 	taskRequest := vpc.NewDescribeTaskResultRequest()
 	taskRequest.TaskId = &taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(taskRequest.GetAction())
 		taskResponse, err := me.client.UseVpcClient().DescribeTaskResult(taskRequest)
 		if err != nil {
 			return retryError(err)
@@ -2717,8 +2657,6 @@ func (me *VpcService) CreateEni(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
-
 		response, err := client.CreateNetworkInterface(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -2884,8 +2822,6 @@ func (me *VpcService) describeEnis(
 	count := ENI_DESCRIBE_LIMIT
 	for count == ENI_DESCRIBE_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseVpcClient().DescribeNetworkInterfaces(request)
 			if err != nil {
 				count = 0
@@ -2932,8 +2868,6 @@ func (me *VpcService) ModifyEniAttribute(ctx context.Context, id string, name, d
 	request.SecurityGroupIds = common.StringPtrs(sgs)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyNetworkInterfaceAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2968,8 +2902,6 @@ func (me *VpcService) UnAssignIpv4FromEni(ctx context.Context, id string, ipv4s
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.UnassignPrivateIpAddresses(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3018,8 +2950,6 @@ func (me *VpcService) AssignIpv4ToEni(ctx context.Context, id string, ipv4s []Vp
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.AssignPrivateIpAddresses(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3093,8 +3023,6 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	deleteRequest.NetworkInterfaceId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteNetworkInterface(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -3111,8 +3039,6 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	describeRequest.NetworkInterfaceIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3158,8 +3084,6 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	attachRequest.InstanceId = &cvmId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(attachRequest.GetAction())
-
 		if _, err := client.AttachNetworkInterface(attachRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, attachRequest.GetAction(), attachRequest.ToJsonString(), err)
@@ -3176,8 +3100,6 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	describeRequest.NetworkInterfaceIds = []*string{&eniId}
 
 	if err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3253,8 +3175,6 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 	request.InstanceId = &cvmId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.DetachNetworkInterface(request); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				switch sdkError.Code {
@@ -3300,8 +3220,6 @@ func (me *VpcService) ModifyEniPrimaryIpv4Desc(ctx context.Context, id, ip strin
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyPrivateIpAddressesAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3350,7 +3268,6 @@ func (me *VpcService) DescribeHaVipByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeHaVips(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -3390,8 +3307,6 @@ func (me *VpcService) DescribeHaVipEipById(ctx context.Context, haVipEipAttachme
 	eip = ""
 	haVip = ""
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if result, err := client.DescribeHaVips(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3433,7 +3348,6 @@ func (me *VpcService) DeleteHaVip(ctx context.Context, haVipId string) (errRet e
 	request.HaVipId = &haVipId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteHaVip(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -3460,8 +3374,6 @@ func waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3568,8 +3480,6 @@ func waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
@@ -3680,7 +3590,6 @@ func (me *VpcService) CreateVpcNetworkAcl(ctx context.Context, vpcID string, nam
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseVpcClient().CreateNetworkAcl(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3755,7 +3664,6 @@ func (me *VpcService) ModifyNetWorkAclRules(ctx context.Context, aclID string, i
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclEntries(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3815,7 +3723,6 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 			}
 
 			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check(request.GetAction())
 				_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(requestSubnet)
 				if err != nil {
 					if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3839,7 +3746,6 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 	// delete acl
 	request.NetworkAclId = &aclID
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DeleteNetworkAcl(request)
 
 		if err != nil {
@@ -3878,7 +3784,6 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 	request.NetworkAclName = name
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclAttribute(request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -3921,7 +3826,6 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 	request.SubnetIds = subIds
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().AssociateNetworkAclSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3965,7 +3869,6 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 	request.Limit = &pageSize
 	for {
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVpcClient().DescribeNetworkAcls(request)
 			if err != nil {
 				if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4049,7 +3952,6 @@ func (me *VpcService) DeleteAclAttachment(ctx context.Context, attachmentAcl str
 	request.SubnetIds = helper.Strings(items[1:])
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -4123,7 +4025,6 @@ func (me *VpcService) DescribeVpnGwByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4157,7 +4058,6 @@ func (me *VpcService) DeleteVpnGateway(ctx context.Context, vpnGatewayId string)
 	request.VpnGatewayId = &vpnGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4188,7 +4088,6 @@ func (me *VpcService) DescribeCustomerGatewayByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeCustomerGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4222,7 +4121,6 @@ func (me *VpcService) DeleteCustomerGateway(ctx context.Context, customerGateway
 	request.CustomerGatewayId = &customerGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteCustomerGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4248,7 +4146,6 @@ func (me *VpcService) CreateAddressTemplate(ctx context.Context, name string, ad
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4299,7 +4196,6 @@ func (me *VpcService) DescribeAddressTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4333,7 +4229,6 @@ func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId stri
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateAttribute(request)
 	return err
 }
@@ -4348,7 +4243,6 @@ func (me *VpcService) DeleteAddressTemplate(ctx context.Context, templateId stri
 	}()
 	request.AddressTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplate(request)
 	return err
 }
@@ -4369,7 +4263,6 @@ func (me *VpcService) CreateAddressTemplateGroup(ctx context.Context, name strin
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4401,7 +4294,6 @@ func (me *VpcService) ModifyAddressTemplateGroup(ctx context.Context, templateGr
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateGroupAttribute(request)
 	return err
 }
@@ -4442,7 +4334,6 @@ func (me *VpcService) DescribeAddressTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4469,7 +4360,6 @@ func (me *VpcService) DeleteAddressTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.AddressTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplateGroup(request)
 	return err
 }
@@ -4490,7 +4380,6 @@ func (me *VpcService) CreateServiceTemplate(ctx context.Context, name string, se
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4522,7 +4411,6 @@ func (me *VpcService) ModifyServiceTemplate(ctx context.Context, templateId stri
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateAttribute(request)
 	return err
 }
@@ -4563,7 +4451,6 @@ func (me *VpcService) DescribeServiceTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4590,7 +4477,6 @@ func (me *VpcService) DeleteServiceTemplate(ctx context.Context, templateId stri
 	}()
 	request.ServiceTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplate(request)
 	return err
 }
@@ -4611,7 +4497,6 @@ func (me *VpcService) CreateServiceTemplateGroup(ctx context.Context, name strin
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4662,7 +4547,6 @@ func (me *VpcService) DescribeServiceTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4696,7 +4580,6 @@ func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGro
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateGroupAttribute(request)
 	return err
 }
@@ -4711,7 +4594,6 @@ func (me *VpcService) DeleteServiceTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.ServiceTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplateGroup(request)
 	return err
 }
@@ -4729,7 +4611,6 @@ func (me *VpcService) CreateVpnGatewayRoute(ctx context.Context, vpnGatewayId st
 
 	var response *vpc.CreateVpnGatewayRoutesResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateVpnGatewayRoutes(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create vpn gateway route failed, reason: %v", logId, errRet)
@@ -4765,7 +4646,6 @@ func (me *VpcService) ModifyVpnGatewayRoute(ctx context.Context, vpnGatewayId, r
 
 	var response *vpc.ModifyVpnGatewayRoutesResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyVpnGatewayRoutes(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4796,7 +4676,6 @@ func (me *VpcService) DeleteVpnGatewayRoutes(ctx context.Context, vpnGatewayId s
 	request.RouteIds = routeIds
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayRoutes(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4826,7 +4705,6 @@ func (me *VpcService) DescribeVpnGatewayRoutes(ctx context.Context, vpnGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeVpnGatewayRoutesResponse
 		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -4859,7 +4737,6 @@ func (me *VpcService) DescribeVpcTaskResult(ctx context.Context, taskId *string)
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpcTaskResult(request)
 		if err != nil {
 			return retryError(err)
@@ -4886,7 +4763,6 @@ func (me *VpcService) DescribeTaskResult(ctx context.Context, taskId *uint64) (r
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeTaskResult(request)
 		if err != nil {
 			return retryError(err)
@@ -4957,7 +4833,6 @@ func (me *VpcService) DescribeVpnGwSslServerByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4991,7 +4866,6 @@ func (me *VpcService) DeleteVpnGatewaySslServer(ctx context.Context, SslServerId
 	request.SslVpnServerId = &SslServerId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslServer(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5059,7 +4933,6 @@ func (me *VpcService) DescribeVpnGwSslClientByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5093,7 +4966,6 @@ func (me *VpcService) DeleteVpnGatewaySslClient(ctx context.Context, SslClientId
 	request.SslVpnClientId = &SslClientId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslClient(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5117,7 +4989,6 @@ func (me *VpcService) CreateNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.CreateNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create nat gateway source ip translation nat rule failed, reason: %v", logId, errRet)
@@ -5148,7 +5019,6 @@ func (me *VpcService) ModifyNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.ModifyNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5177,7 +5047,6 @@ func (me *VpcService) DeleteNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.NatGatewaySnatIds = []*string{&snatId}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5207,7 +5076,6 @@ func (me *VpcService) DescribeNatGatewaySnats(ctx context.Context, natGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
 		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -5242,7 +5110,6 @@ func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (
 
 	request.VpcIds = []*string{&vpcId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAssistantCidr(request)
 
 	if err != nil {
@@ -5268,7 +5135,6 @@ func (me *VpcService) CheckAssistantCidr(ctx context.Context, request *vpc.Check
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CheckAssistantCidr(request)
 
 	if err != nil {
@@ -5293,7 +5159,6 @@ func (me *VpcService) CreateAssistantCidr(ctx context.Context, request *vpc.Crea
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAssistantCidr(request)
 
 	if err != nil {
@@ -5318,7 +5183,6 @@ func (me *VpcService) ModifyAssistantCidr(ctx context.Context, request *vpc.Modi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAssistantCidr(request)
 
 	if err != nil {
@@ -5341,7 +5205,6 @@ func (me *VpcService) DeleteAssistantCidr(ctx context.Context, request *vpc.Dele
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteAssistantCidr(request)
 
 	if err != nil {
@@ -5376,7 +5239,6 @@ func (me *VpcService) DescribeVpcBandwidthPackage(ctx context.Context, bandwidth
 	//		Values: []*string{&bandwidthPackageId},
 	//	},
 	//)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5408,7 +5270,6 @@ func (me *VpcService) DeleteVpcBandwidthPackageById(ctx context.Context, bandwid
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteBandwidthPackage(request)
 	if err != nil {
 		errRet = err
@@ -5442,7 +5303,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageAttachment(ctx context.Context,
 		},
 	)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageResources(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5483,7 +5343,6 @@ func (me *VpcService) DeleteVpcBandwidthPackageAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RemoveBandwidthPackageResources(request)
 	if err != nil {
 		errRet = err
@@ -5504,7 +5363,6 @@ func (me *VpcService) DescribeFlowLogs(ctx context.Context, request *vpc.Describ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeFlowLogs(request)
 
 	if err != nil {
@@ -5536,8 +5394,6 @@ func (me *VpcService) DescribeVpcFlowLogById(ctx context.Context, flowLogId, vpc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeFlowLog(request)
 	if err != nil {
 		errRet = err
@@ -5568,8 +5424,6 @@ func (me *VpcService) DeleteVpcFlowLogById(ctx context.Context, flowLogId, vpcId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteFlowLog(request)
 	if err != nil {
 		errRet = err
@@ -5592,8 +5446,6 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5639,8 +5491,6 @@ func (me *VpcService) DeleteVpcEndPointServiceById(ctx context.Context, endPoint
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointService(request)
 	if err != nil {
 		errRet = err
@@ -5663,8 +5513,6 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5710,8 +5558,6 @@ func (me *VpcService) DeleteVpcEndPointById(ctx context.Context, endPointId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPoint(request)
 	if err != nil {
 		errRet = err
@@ -5749,8 +5595,6 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5797,8 +5641,6 @@ func (me *VpcService) DeleteVpcEndPointServiceWhiteListById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWhiteList(request)
 	if err != nil {
 		errRet = err
@@ -5829,7 +5671,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageByEip(ctx context.Context, eipI
 			Values: []*string{&eipId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5859,8 +5700,6 @@ func (me *VpcService) DescribeVpcCcnRoutesById(ctx context.Context, ccnId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCcnRoutes(request)
 	if err != nil {
 		errRet = err
@@ -5945,8 +5784,6 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5996,8 +5833,6 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -6064,8 +5899,6 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitor(request)
 	if err != nil {
 		errRet = err
@@ -6094,8 +5927,6 @@ func (me *VpcService) DescribeVpnCustomerGatewayVendors(ctx context.Context) (vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCustomerGatewayVendors(request)
 	if err != nil {
 		errRet = err
@@ -6123,8 +5954,6 @@ func (me *VpcService) DescribeVpcVpnGatewayCcnRoutesById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpnGatewayCcnRoutes(request)
 	if err != nil {
 		errRet = err
@@ -6157,8 +5986,6 @@ func (me *VpcService) DescribeVpcIpv6AddressById(ctx context.Context, ip6Address
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeIp6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -6186,8 +6013,6 @@ func (me *VpcService) DeleteVpcIpv6AddressById(ctx context.Context, ip6AddressId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().ReleaseIp6AddressesBandwidth(request)
 	if err != nil {
 		errRet = err
@@ -6232,8 +6057,6 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -6283,8 +6106,6 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6325,8 +6146,6 @@ func (me *VpcService) DescribeEipAddressQuota(ctx context.Context) (addressQuota
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeAddressQuota(request)
 	if err != nil {
 		errRet = err
@@ -6351,8 +6170,6 @@ func (me *VpcService) DescribeEipNetworkAccountType(ctx context.Context) (networ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkAccountType(request)
 	if err != nil {
 		errRet = err
@@ -6377,8 +6194,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageQuota(ctx context.Context) (ban
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageQuota(request)
 	if err != nil {
 		errRet = err
@@ -6409,8 +6224,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageBillUsageByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageBillUsage(request)
 	if err != nil {
 		errRet = err
@@ -6435,8 +6248,6 @@ func (me *VpcService) DescribeVpcTrafficPackageById(ctx context.Context, traffic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeTrafficPackages(request)
 	if err != nil {
 		errRet = err
@@ -6464,8 +6275,6 @@ func (me *VpcService) DeleteVpcTrafficPackageById(ctx context.Context, trafficPa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteTrafficPackages(request)
 	if err != nil {
 		errRet = err
@@ -6488,8 +6297,6 @@ func (me *VpcService) DescribeVpcSnapshotPoliciesById(ctx context.Context, snaps
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
@@ -6513,8 +6320,6 @@ func (me *VpcService) DeleteVpcSnapshotPoliciesById(ctx context.Context, snapsho
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
@@ -6537,8 +6342,6 @@ func (me *VpcService) DescribeVpcSnapshotPolicyAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSnapshotAttachedInstances(request)
 	if err != nil {
 		errRet = err
@@ -6573,8 +6376,6 @@ func (me *VpcService) DeleteVpcSnapshotPolicyAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DetachSnapshotInstances(request)
 	if err != nil {
 		errRet = err
@@ -6597,8 +6398,6 @@ func (me *VpcService) DescribeVpcNetDetectById(ctx context.Context, netDetectId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetDetects(request)
 	if err != nil {
 		errRet = err
@@ -6626,8 +6425,6 @@ func (me *VpcService) DeleteVpcNetDetectById(ctx context.Context, netDetectId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteNetDetect(request)
 	if err != nil {
 		errRet = err
@@ -6654,8 +6451,6 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6707,8 +6502,6 @@ func (me *VpcService) DeleteVpcClassicLinkAttachmentById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DetachClassicLinkVpc(request)
 	if err != nil {
 		errRet = err
@@ -6731,8 +6524,6 @@ func (me *VpcService) DescribeVpcDhcpIpById(ctx context.Context, dhcpIpId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
@@ -6760,8 +6551,6 @@ func (me *VpcService) DeleteVpcDhcpIpById(ctx context.Context, dhcpIpId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteDhcpIp(request)
 	if err != nil {
 		errRet = err
@@ -6784,8 +6573,6 @@ func (me *VpcService) DescribeVpcDhcpAssociateAddressById(ctx context.Context, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
@@ -6818,8 +6605,6 @@ func (me *VpcService) DeleteVpcDhcpAssociateAddressById(ctx context.Context, dhc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DisassociateDhcpIpWithAddressIp(request)
 	if err != nil {
 		errRet = err
@@ -6842,8 +6627,6 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6889,8 +6672,6 @@ func (me *VpcService) DeleteVpcIpv6CidrBlockById(ctx context.Context, vpcId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6CidrBlock(request)
 	if err != nil {
 		errRet = err
@@ -6913,8 +6694,6 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6964,8 +6743,6 @@ func (me *VpcService) DeleteVpcIpv6SubnetCidrBlockById(ctx context.Context, vpcI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6SubnetCidrBlock(request)
 	if err != nil {
 		errRet = err
@@ -6989,8 +6766,6 @@ func (me *VpcService) DescribeVpcIpv6EniAddressById(ctx context.Context, vpcId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcIpv6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -7021,8 +6796,6 @@ func (me *VpcService) DeleteVpcIpv6EniAddressById(ctx context.Context, networkIn
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -7051,8 +6824,6 @@ func (me *VpcService) DescribeVpcLocalGatewayById(ctx context.Context, localGate
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeLocalGateway(request)
 	if err != nil {
 		errRet = err
@@ -7081,8 +6852,6 @@ func (me *VpcService) DeleteVpcLocalGatewayById(ctx context.Context, cdcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteLocalGateway(request)
 	if err != nil {
 		errRet = err
@@ -7105,8 +6874,6 @@ func (me *VpcService) DescribeVpcAccountAttributes(ctx context.Context) (account
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeAccountAttributes(request)
 	if err != nil {
 		errRet = err
@@ -7137,8 +6904,6 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -7203,8 +6968,6 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7254,8 +7017,6 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7302,8 +7063,6 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7353,8 +7112,6 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7401,8 +7158,6 @@ func (me *VpcService) DescribeVpcNetworkInterfaceLimit(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkInterfaceLimit(request)
 	if err != nil {
 		errRet = err
@@ -7436,8 +7191,6 @@ func (me *VpcService) DescribeVpcPrivateIpAddresses(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcPrivateIpAddresses(request)
 	if err != nil {
 		errRet = err
@@ -7468,8 +7221,6 @@ func (me *VpcService) DescribeVpcProductQuota(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeProductQuota(request)
 	if err != nil {
 		errRet = err
@@ -7500,8 +7251,6 @@ func (me *VpcService) DescribeVpcResourceDashboard(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcResourceDashboard(request)
 	if err != nil {
 		errRet = err
@@ -7535,8 +7284,6 @@ func (me *VpcService) DescribeVpcRouteConflicts(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeRouteConflicts(request)
 	if err != nil {
 		errRet = err
@@ -7561,8 +7308,6 @@ func (me *VpcService) DescribeVpcSecurityGroupLimits(ctx context.Context, param
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupLimits(request)
 	if err != nil {
 		errRet = err
@@ -7593,8 +7338,6 @@ func (me *VpcService) DescribeVpcSecurityGroupReferences(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupReferences(request)
 	if err != nil {
 		errRet = err
@@ -7631,8 +7374,6 @@ func (me *VpcService) DescribeVpcSgSnapshotFileContent(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSgSnapshotFileContent(request)
 	if err != nil {
 		errRet = err
@@ -7672,8 +7413,6 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7720,8 +7459,6 @@ func (me *VpcService) DescribeVpcSubnetResourceDashboardByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSubnetResourceDashboard(request)
 	if err != nil {
 		errRet = err
@@ -7746,8 +7483,6 @@ func (me *VpcService) DescribeVpcTemplateLimits(ctx context.Context) (templateLi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeTemplateLimits(request)
 	if err != nil {
 		errRet = err
@@ -7784,8 +7519,6 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7832,8 +7565,6 @@ func (me *VpcService) DescribeVpcLimitsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcLimits(request)
 	if err != nil {
 		errRet = err
@@ -7858,8 +7589,6 @@ func (me *VpcService) DescribeVpcNetworkAclQuintupleById(ctx context.Context, ne
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
@@ -7887,8 +7616,6 @@ func (me *VpcService) DeleteVpcNetworkAclQuintupleById(ctx context.Context, netw
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
@@ -7912,8 +7639,6 @@ func (me *VpcService) DeleteVpcEniSgAttachmentById(ctx context.Context, networkI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DisassociateNetworkInterfaceSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -7960,8 +7685,6 @@ func (me *VpcService) DescribeVpcNetDetectStateCheck(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().CheckNetDetectState(request)
 	if err != nil {
 		errRet = err
@@ -7986,8 +7709,6 @@ func (me *VpcService) DescribeVpcNotifyRoutesById(ctx context.Context, routeTabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
 		errRet = err
@@ -8023,8 +7744,6 @@ func (me *VpcService) DeleteVpcNotifyRoutesById(ctx context.Context, routeTableI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().WithdrawNotifyRoutes(request)
 	if err != nil {
 		errRet = err
@@ -8053,8 +7772,6 @@ func (me *VpcService) DescribeVpnDefaultHealthCheckIp(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().GenerateVpnConnectionDefaultHealthCheckIp(request)
 	if err != nil {
 		errRet = err
@@ -7,7 +7,6 @@ import (
 	wedata "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wedata/v20210820"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type WedataService struct {
@@ -27,8 +26,6 @@ func (me *WedataService) DescribeWedataRuleTemplateById(ctx context.Context, pro
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseWedataClient().DescribeRuleTemplate(request)
 	if err != nil {
 		errRet = err
@@ -57,8 +54,6 @@ func (me *WedataService) DeleteWedataRuleTemplateById(ctx context.Context, proje
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseWedataClient().DeleteRuleTemplate(request)
 	if err != nil {
 		errRet = err
@@ -96,8 +91,6 @@ func (me *WedataService) DescribeWedataRuleTemplatesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseWedataClient().DescribeRuleTemplates(request)
 	if err != nil {
 		errRet = err
@@ -0,0 +1,432 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/fakeapi"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// The tag sweeper deletes the resources bound with a tag through the registered resources, it's run by
+// `make sweep TEST=./tencentcloud SWEEP=ap-guangzhou SWEEPARGS=-sweep-run=tencentcloud_tag_resources` with the
+// following environments.
+const (
+	// SWEEP_TAG is the tag of the resources to sweep in the format `key=value`, nothing is swept if it's empty
+	SWEEP_TAG = "TENCENTCLOUD_SWEEP_TAG"
+	// SWEEP_TAG_DRY_RUN only logs the resources to sweep without deleting them if it's `true`
+	SWEEP_TAG_DRY_RUN = "TENCENTCLOUD_SWEEP_TAG_DRY_RUN"
+	// SWEEP_TAG_SERVICES is the comma separated service types allowed to sweep, e.g. `cvm,vpc`, all of them
+	// are allowed if it's empty
+	SWEEP_TAG_SERVICES = "TENCENTCLOUD_SWEEP_TAG_SERVICES"
+)
+
+// tagSweeperResourceTypes are the resource types keyed by `service_type:resource_type` of the tag resource names
+// they build, the swept resources are read and deleted by them. The resource owning the remote object is listed
+// if several resources build the same name, like `tencentcloud_kubernetes_cluster` of `ccs:cluster`.
+var tagSweeperResourceTypes = map[string]string{
+	"apigateway:apiAppId":   "tencentcloud_api_gateway_api_app",
+	"apigateway:upstreamId": "tencentcloud_api_gateway_upstream",
+	"apigw:service":         "tencentcloud_api_gateway_service",
+	"apm:apm-instance":      "tencentcloud_apm_instance",
+	"as:auto-scaling-group": "tencentcloud_as_scaling_group",
+	"cam:RoleId":            "tencentcloud_cam_service_linked_role",
+	"cam:role":              "tencentcloud_cam_role",
+	"cam:uin":               "tencentcloud_cam_user",
+	"cat:TaskId":            "tencentcloud_cat_task_set",
+	"ccs:cluster":           "tencentcloud_kubernetes_cluster",
+	"cdb:instanceId":        "tencentcloud_mysql_instance",
+	"cdn:domain":            "tencentcloud_cdn_domain",
+	"cdwch:cdwchInstance":   "tencentcloud_clickhouse_instance",
+	"cfs:filesystem":        "tencentcloud_cfs_file_system",
+	"cfs:snap":              "tencentcloud_cfs_snapshot",
+	"ckafka:ckafkaId":       "tencentcloud_ckafka_instance",
+	"ckafka:dipTopic":       "tencentcloud_ckafka_datahub_topic",
+	"clb:clb":               "tencentcloud_clb_instance",
+	"cls:alarm":             "tencentcloud_cls_alarm",
+	"cls:alarmNotice":       "tencentcloud_cls_alarm_notice",
+	"cls:logset":            "tencentcloud_cls_logset",
+	"cvm:image":             "tencentcloud_image",
+	"cvm:instance":          "tencentcloud_instance",
+	"cvm:keypair":           "tencentcloud_key_pair",
+	"cvm:sg":                "tencentcloud_security_group",
+	"cvm:volume":            "tencentcloud_cbs_storage",
+	"cynosdb:cluster":       "tencentcloud_cynosdb_cluster",
+	"eb:eventbusid":         "tencentcloud_eb_event_bus",
+	"eb:ruleid":             "tencentcloud_eb_event_rule",
+	"es:instance":           "tencentcloud_elasticsearch_instance",
+	"gaap:proxy":            "tencentcloud_gaap_proxy",
+	"gaap:realServer":       "tencentcloud_gaap_realserver",
+	"kms:key":               "tencentcloud_kms_key",
+	"mariadb:instance":      "tencentcloud_mariadb_instance",
+	"mariadb:mariadb-dedicatedcluster-instance": "tencentcloud_mariadb_dedicatedcluster_db_instance",
+	"mariadb:mariadb-hour-instance":             "tencentcloud_mariadb_hour_db_instance",
+	"mongodb:instance":                          "tencentcloud_mongodb_instance",
+	"monitor:grafana-instance":                  "tencentcloud_monitor_grafana_instance",
+	"monitor:prom-instance":                     "tencentcloud_monitor_tmp_instance",
+	"postgres:DBInstanceId":                     "tencentcloud_postgresql_instance",
+	"postgres:dbInstanceId":                     "tencentcloud_postgresql_base_backup",
+	"privatedns:zone":                           "tencentcloud_private_dns_zone",
+	"redis:instance":                            "tencentcloud_redis_instance",
+	"rum:Instance":                              "tencentcloud_rum_taw_instance",
+	"scf:lam":                                   "tencentcloud_scf_function",
+	"sqlserver:instance":                        "tencentcloud_sqlserver_instance",
+	"ssl:certificate":                           "tencentcloud_ssl_certificate",
+	"ssm:secret":                                "tencentcloud_ssm_secret",
+	"tcr:instance":                              "tencentcloud_tcr_instance",
+	"tcr:repository":                            "tencentcloud_tcr_webhook_trigger",
+	"tdmq:cluster":                              "tencentcloud_tdmq_instance",
+	"tem:application":                           "tencentcloud_tem_application",
+	"tem:environment":                           "tencentcloud_tem_environment",
+	"teo:zone":                                  "tencentcloud_teo_zone",
+	"tse:cngw_canary_rule":                      "tencentcloud_tse_cngw_canary_rule",
+	"tse:cngw_service":                          "tencentcloud_tse_cngw_service",
+	"tse:gateway":                               "tencentcloud_tse_cngw_gateway",
+	"tse:instance":                              "tencentcloud_tse_instance",
+	"tsf:cluster":                               "tencentcloud_tsf_cluster",
+	"tsf:group":                                 "tencentcloud_tsf_group",
+	"tsf:microservice":                          "tencentcloud_tsf_microservice",
+	"vpc:acl":                                   "tencentcloud_vpc_acl",
+	"vpc:bandwidthPackage":                      "tencentcloud_vpc_bandwidth_package",
+	"vpc:ccn":                                   "tencentcloud_ccn",
+	"vpc:cgw":                                   "tencentcloud_vpn_customer_gateway",
+	"vpc:eip":                                   "tencentcloud_eip",
+	"vpc:eni":                                   "tencentcloud_eni",
+	"vpc:fl":                                    "tencentcloud_vpc_flow_log",
+	"vpc:nat":                                   "tencentcloud_nat_gateway",
+	"vpc:rtb":                                   "tencentcloud_route_table",
+	"vpc:subnet":                                "tencentcloud_subnet",
+	"vpc:vpc":                                   "tencentcloud_vpc",
+	"vpc:vpngw":                                 "tencentcloud_vpn_gateway",
+	"vpc:vpnx":                                  "tencentcloud_vpn_connection",
+}
+
+// tagSweeperDeleteRanks orders the deletion of the resources depended on by others, the resources of lower
+// ranks are deleted first and the rank of those not listed is 0.
+var tagSweeperDeleteRanks = map[string]int{
+	"vpc:subnet": 1,
+	"vpc:rtb":    1,
+	"vpc:acl":    1,
+	"vpc:nat":    1,
+	"vpc:vpngw":  1,
+	"cvm:sg":     1,
+	"cls:logset": 1,
+	"vpc:vpc":    2,
+	"vpc:ccn":    2,
+}
+
+func init() {
+	resource.AddTestSweepers("tencentcloud_tag_resources", &resource.Sweeper{
+		Name: "tencentcloud_tag_resources",
+		F:    testSweepTagResources,
+	})
+}
+
+func testSweepTagResources(region string) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	sweeper, err := newTagSweeperFromEnv()
+	if err != nil {
+		return err
+	}
+	if sweeper == nil {
+		log.Printf("[INFO] skip sweeping tag resources, %s is not set", SWEEP_TAG)
+		return nil
+	}
+
+	sharedClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
+	}
+	client := sharedClient.(*TencentCloudClient)
+
+	_, err = sweeper.sweep(ctx, client, region)
+	return err
+}
+
+// tagSweeper sweeps the resources bound with the tag, the resources are read and deleted by the registered
+// resource types building their tag resource names.
+type tagSweeper struct {
+	tagKey   string
+	tagValue string
+	dryRun   bool
+	services []string
+	// resourceTypes are the resource types keyed by `service_type:resource_type` of the tag resource names
+	resourceTypes map[string]string
+	resources     map[string]*schema.Resource
+}
+
+func newTagSweeperFromEnv() (*tagSweeper, error) {
+	tagValue := os.Getenv(SWEEP_TAG)
+	if tagValue == "" {
+		return nil, nil
+	}
+	kv := strings.SplitN(tagValue, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return nil, fmt.Errorf("%s must be in the format `key=value`, got %s", SWEEP_TAG, tagValue)
+	}
+
+	sweeper := &tagSweeper{
+		tagKey:        kv[0],
+		tagValue:      kv[1],
+		dryRun:        os.Getenv(SWEEP_TAG_DRY_RUN) == "true",
+		resourceTypes: tagSweeperResourceTypes,
+		resources:     Provider().ResourcesMap,
+	}
+	if services := os.Getenv(SWEEP_TAG_SERVICES); services != "" {
+		sweeper.services = strings.Split(services, ",")
+	}
+	return sweeper, nil
+}
+
+// tagSweeperResource is a tag resource to sweep
+type tagSweeperResource struct {
+	name         string
+	region       string
+	id           string
+	resourceType string
+	rank         int
+}
+
+// sweep deletes the resources bound with the tag in region and those without region, it returns the names of
+// the resources deleted, or to delete in dry run. The resources failed to delete are retried in the following
+// passes until none of them is deleted in a pass, which leaves the dependencies not ordered by the ranks.
+func (me *tagSweeper) sweep(ctx context.Context, meta *TencentCloudClient, region string) (swept []string, errRet error) {
+	logId := getLogId(ctx)
+	service := TagService{client: meta.apiV3Conn}
+
+	var mappings []*tag.ResourceTagMapping
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, e := service.DescribeTagResourcesByFilter(ctx, map[string]interface{}{
+			"TagFilters": []*tag.TagFilter{{TagKey: &me.tagKey, TagValue: []*string{&me.tagValue}}},
+			"MaxResults": helper.Uint64(200),
+		})
+		if e != nil {
+			return retryError(e)
+		}
+		mappings = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*tagSweeperResource, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping.Resource == nil {
+			continue
+		}
+		name, err := ParseTagResourceName(*mapping.Resource)
+		if err != nil {
+			log.Printf("[WARN]%s skip sweeping %s: %s", logId, *mapping.Resource, err.Error())
+			continue
+		}
+		if name.Region != "" && name.Region != region {
+			continue
+		}
+		if len(me.services) > 0 && !IsContains(me.services, name.ServiceType) {
+			continue
+		}
+		key := name.ServiceType + ":" + name.ResourceType
+		resourceType, ok := me.resourceTypes[key]
+		if !ok {
+			log.Printf("[WARN]%s skip sweeping %s: no resource type builds the tag resource name of %s", logId, *mapping.Resource, key)
+			continue
+		}
+		pending = append(pending, &tagSweeperResource{
+			name:         *mapping.Resource,
+			region:       name.Region,
+			id:           name.ResourceId,
+			resourceType: resourceType,
+			rank:         tagSweeperDeleteRanks[key],
+		})
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].rank < pending[j].rank
+	})
+
+	for len(pending) > 0 {
+		var failed []*tagSweeperResource
+		var errs *multierror.Error
+		for _, item := range pending {
+			if me.dryRun {
+				log.Printf("[INFO]%s [DRY RUN] sweep %s %s: %s", logId, item.resourceType, item.id, item.name)
+				swept = append(swept, item.name)
+				continue
+			}
+			log.Printf("[INFO]%s sweep %s %s: %s", logId, item.resourceType, item.id, item.name)
+			if err := me.delete(ctx, regionalMeta(meta, item.region), item); err != nil {
+				failed = append(failed, item)
+				errs = multierror.Append(errs, fmt.Errorf("sweep %s %s failed: %s", item.resourceType, item.id, err.Error()))
+				continue
+			}
+			swept = append(swept, item.name)
+		}
+		if len(failed) == len(pending) {
+			errRet = errs.ErrorOrNil()
+			return
+		}
+		pending = failed
+	}
+	return
+}
+
+// delete reads the resource before deleting it, so the arguments needed by the deletion are set, and the
+// resources already gone are skipped. The resource is not deleted if it fails to read, as the deletion may
+// depend on the arguments set by the read.
+func (me *tagSweeper) delete(ctx context.Context, meta interface{}, item *tagSweeperResource) error {
+	r, ok := me.resources[item.resourceType]
+	if !ok {
+		return fmt.Errorf("resource type %s is not registered", item.resourceType)
+	}
+
+	d := r.Data(nil)
+	d.SetId(item.id)
+	var err error
+	switch {
+	case r.ReadContext != nil:
+		err = diagnosticsError(r.ReadContext(ctx, d, meta))
+	case r.ReadWithoutTimeout != nil:
+		err = diagnosticsError(r.ReadWithoutTimeout(ctx, d, meta))
+	default:
+		err = r.Read(d, meta)
+	}
+	if err != nil {
+		return fmt.Errorf("read failed, skip deleting it: %s", err.Error())
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	switch {
+	case r.DeleteContext != nil:
+		return diagnosticsError(r.DeleteContext(ctx, d, meta))
+	case r.DeleteWithoutTimeout != nil:
+		return diagnosticsError(r.DeleteWithoutTimeout(ctx, d, meta))
+	default:
+		return r.Delete(d, meta)
+	}
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s %s", d.Summary, d.Detail)
+		}
+	}
+	return nil
+}
+
+func TestUnitTagSweeperResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	resources := Provider().ResourcesMap
+	for key, resourceType := range tagSweeperResourceTypes {
+		r, ok := resources[resourceType]
+		if !assert.Truef(t, ok, "resource type %s of %s is not registered", resourceType, key) {
+			continue
+		}
+		assert.Containsf(t, r.Schema, "tags", "resource type %s of %s has no tags", resourceType, key)
+		assert.Truef(t, r.Read != nil || r.ReadContext != nil || r.ReadWithoutTimeout != nil, "resource type %s of %s has no read", resourceType, key)
+		assert.Truef(t, r.Delete != nil || r.DeleteContext != nil || r.DeleteWithoutTimeout != nil, "resource type %s of %s has no delete", resourceType, key)
+	}
+	for key := range tagSweeperDeleteRanks {
+		assert.Containsf(t, tagSweeperResourceTypes, key, "ranked %s has no resource type", key)
+	}
+}
+
+func TestUnitTagSweeperSweep(t *testing.T) {
+	t.Parallel()
+
+	server, meta := testFakeApiMeta(t)
+	meta.apiV3Conn.Endpoints["tag"] = server.URL
+	ctx := context.WithValue(context.TODO(), logIdKey, getLogId(contextNil))
+
+	service := VpcService{client: meta.apiV3Conn}
+	vpcId, _, err := service.CreateVpc(ctx, "tf-sweep", "10.0.0.0/16", false, nil, nil)
+	assert.Nil(t, err)
+	subnetId, err := service.CreateSubnet(ctx, vpcId, "tf-sweep", "10.0.0.0/24", "ap-guangzhou-3", nil)
+	assert.Nil(t, err)
+	shanghaiService := VpcService{client: meta.withRegion("ap-shanghai").apiV3Conn}
+	shanghaiVpcId, _, err := shanghaiService.CreateVpc(ctx, "tf-sweep", "10.0.0.0/16", false, nil, nil)
+	assert.Nil(t, err)
+
+	// the vpc is listed before its subnet, and the resources of the other regions are swept in their regions
+	names := []string{
+		BuildTagResourceName("vpc", "vpc", "ap-guangzhou", vpcId),
+		BuildTagResourceName("vpc", "subnet", "ap-guangzhou", subnetId),
+		BuildTagResourceName("vpc", "vpc", "ap-shanghai", shanghaiVpcId),
+		BuildTagResourceName("cvm", "instance", "ap-guangzhou", "ins-00000001"),
+		BuildTagResourceName("unknown", "thing", "ap-guangzhou", "thing-00000001"),
+	}
+	server.Handle("tag", "GetResources", func(request *fakeapi.Request) (interface{}, error) {
+		var params tag.GetResourcesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		assert.Equal(t, "created_by", *params.TagFilters[0].TagKey)
+		assert.Equal(t, "tf-acc", *params.TagFilters[0].TagValue[0])
+		mappings := make([]*tag.ResourceTagMapping, 0, len(names))
+		for _, name := range names {
+			mappings = append(mappings, &tag.ResourceTagMapping{Resource: helper.String(name)})
+		}
+		return &tag.GetResourcesResponseParams{ResourceTagMappingList: mappings}, nil
+	})
+	server.Handle("tag", "DescribeResourceTagsByResourceIds", func(request *fakeapi.Request) (interface{}, error) {
+		return &tag.DescribeResourceTagsByResourceIdsResponseParams{TotalCount: helper.Uint64(0)}, nil
+	})
+
+	sweeper := &tagSweeper{
+		tagKey:        "created_by",
+		tagValue:      "tf-acc",
+		dryRun:        true,
+		resourceTypes: tagSweeperResourceTypes,
+		resources:     Provider().ResourcesMap,
+	}
+
+	swept, err := sweeper.sweep(ctx, meta, "ap-guangzhou")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{names[3], names[1], names[0]}, swept)
+	assert.Equal(t, 0, server.Calls("vpc", "DeleteSubnet"))
+
+	// the vpc failed to read is not deleted
+	sweeper.dryRun = false
+	sweeper.services = []string{"vpc"}
+	server.InjectFault("vpc", "DescribeVpcs", fakeapi.Fault{Code: "AuthFailure.UnauthorizedOperation"})
+	swept, err = sweeper.sweep(ctx, meta, "ap-guangzhou")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "read failed, skip deleting it")
+	}
+	assert.Equal(t, []string{names[1]}, swept)
+	assert.Equal(t, 0, server.Calls("vpc", "DeleteVpc"))
+	server.ClearFaults()
+
+	swept, err = sweeper.sweep(ctx, meta, "ap-guangzhou")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{names[1], names[0]}, swept)
+	_, has, err := service.DescribeVpc(ctx, vpcId, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, has)
+	_, has, err = shanghaiService.DescribeVpc(ctx, shanghaiVpcId, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, has)
+
+	swept, err = sweeper.sweep(ctx, meta, "ap-shanghai")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{names[2]}, swept)
+	_, has, err = shanghaiService.DescribeVpc(ctx, shanghaiVpcId, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, has)
+}
@@ -446,6 +446,19 @@ func validateTime(layout string) schema.SchemaValidateFunc {
 	}
 }
 
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s cannot be parsed as duration, e.g. `1s`, value: %s", k, value))
+		return
+	}
+	if duration <= 0 {
+		errs = append(errs, fmt.Errorf("%s must be positive, value: %s", k, value))
+	}
+	return
+}
+
 func validateYaml(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if err := yaml.Unmarshal([]byte(value), make(map[interface{}]interface{})); err != nil {